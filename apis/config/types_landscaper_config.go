@@ -88,6 +88,8 @@ type Controllers struct {
 	DeployItems DeployItemsController
 	// Contexts contains the controller config that reconciles context objects.
 	Contexts ContextsController
+	// Targets contains the controller config that checks the health of targets.
+	Targets TargetsController
 }
 
 // InstallationsController contains the controller config that reconciles installations.
@@ -111,6 +113,22 @@ type ContextsController struct {
 	Config ContextControllerConfig
 }
 
+// TargetsController contains the controller config that periodically checks the health of targets.
+// Only targets of types that are known to the landscaper are checked.
+type TargetsController struct {
+	CommonControllerConfig
+	// Disable disables the health checks of targets.
+	Disable bool
+	// CheckInterval defines the interval in which the health of a target is checked.
+	// Defaults to 5 minutes.
+	// +optional
+	CheckInterval *metav1.Duration
+	// CheckTimeout defines how long a health check of a target may take.
+	// Defaults to 10 seconds.
+	// +optional
+	CheckTimeout *metav1.Duration
+}
+
 // ContextControllerConfig contains the context specific configuration.
 type ContextControllerConfig struct {
 	Default ContextControllerDefaultConfig
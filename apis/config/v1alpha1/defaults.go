@@ -35,6 +35,13 @@ func SetDefaults_LandscaperConfiguration(obj *LandscaperConfiguration) {
 	SetDefaults_CommonControllerConfig(&obj.Controllers.Executions.CommonControllerConfig)
 	SetDefaults_CommonControllerConfig(&obj.Controllers.DeployItems.CommonControllerConfig)
 	SetDefaults_CommonControllerConfig(&obj.Controllers.Contexts.CommonControllerConfig)
+	SetDefaults_CommonControllerConfig(&obj.Controllers.Targets.CommonControllerConfig)
+	if obj.Controllers.Targets.CheckInterval == nil {
+		obj.Controllers.Targets.CheckInterval = &metav1.Duration{Duration: 5 * time.Minute}
+	}
+	if obj.Controllers.Targets.CheckTimeout == nil {
+		obj.Controllers.Targets.CheckTimeout = &metav1.Duration{Duration: 10 * time.Second}
+	}
 
 	if len(obj.DeployerManagement.Namespace) == 0 {
 		obj.DeployerManagement.Namespace = "ls-system"
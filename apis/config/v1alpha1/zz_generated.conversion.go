@@ -250,6 +250,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TargetsController)(nil), (*config.TargetsController)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetsController_To_config_TargetsController(a.(*TargetsController), b.(*config.TargetsController), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TargetsController)(nil), (*TargetsController)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TargetsController_To_v1alpha1_TargetsController(a.(*config.TargetsController), b.(*TargetsController), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -425,6 +435,9 @@ func autoConvert_v1alpha1_Controllers_To_config_Controllers(in *Controllers, out
 	if err := Convert_v1alpha1_ContextsController_To_config_ContextsController(&in.Contexts, &out.Contexts, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_TargetsController_To_config_TargetsController(&in.Targets, &out.Targets, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -447,6 +460,9 @@ func autoConvert_config_Controllers_To_v1alpha1_Controllers(in *config.Controlle
 	if err := Convert_config_ContextsController_To_v1alpha1_ContextsController(&in.Contexts, &out.Contexts, s); err != nil {
 		return err
 	}
+	if err := Convert_config_TargetsController_To_v1alpha1_TargetsController(&in.Targets, &out.Targets, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -848,3 +864,33 @@ func autoConvert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(
 func Convert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(in *config.RegistryConfiguration, out *RegistryConfiguration, s conversion.Scope) error {
 	return autoConvert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(in, out, s)
 }
+
+func autoConvert_v1alpha1_TargetsController_To_config_TargetsController(in *TargetsController, out *config.TargetsController, s conversion.Scope) error {
+	if err := Convert_v1alpha1_CommonControllerConfig_To_config_CommonControllerConfig(&in.CommonControllerConfig, &out.CommonControllerConfig, s); err != nil {
+		return err
+	}
+	out.Disable = in.Disable
+	out.CheckInterval = (*v1.Duration)(unsafe.Pointer(in.CheckInterval))
+	out.CheckTimeout = (*v1.Duration)(unsafe.Pointer(in.CheckTimeout))
+	return nil
+}
+
+// Convert_v1alpha1_TargetsController_To_config_TargetsController is an autogenerated conversion function.
+func Convert_v1alpha1_TargetsController_To_config_TargetsController(in *TargetsController, out *config.TargetsController, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetsController_To_config_TargetsController(in, out, s)
+}
+
+func autoConvert_config_TargetsController_To_v1alpha1_TargetsController(in *config.TargetsController, out *TargetsController, s conversion.Scope) error {
+	if err := Convert_config_CommonControllerConfig_To_v1alpha1_CommonControllerConfig(&in.CommonControllerConfig, &out.CommonControllerConfig, s); err != nil {
+		return err
+	}
+	out.Disable = in.Disable
+	out.CheckInterval = (*v1.Duration)(unsafe.Pointer(in.CheckInterval))
+	out.CheckTimeout = (*v1.Duration)(unsafe.Pointer(in.CheckTimeout))
+	return nil
+}
+
+// Convert_config_TargetsController_To_v1alpha1_TargetsController is an autogenerated conversion function.
+func Convert_config_TargetsController_To_v1alpha1_TargetsController(in *config.TargetsController, out *TargetsController, s conversion.Scope) error {
+	return autoConvert_config_TargetsController_To_v1alpha1_TargetsController(in, out, s)
+}
@@ -165,6 +165,7 @@ func (in *Controllers) DeepCopyInto(out *Controllers) {
 	in.Executions.DeepCopyInto(&out.Executions)
 	in.DeployItems.DeepCopyInto(&out.DeployItems)
 	in.Contexts.DeepCopyInto(&out.Contexts)
+	in.Targets.DeepCopyInto(&out.Targets)
 	return
 }
 
@@ -505,3 +506,30 @@ func (in *RegistryConfiguration) DeepCopy() *RegistryConfiguration {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetsController) DeepCopyInto(out *TargetsController) {
+	*out = *in
+	in.CommonControllerConfig.DeepCopyInto(&out.CommonControllerConfig)
+	if in.CheckInterval != nil {
+		in, out := &in.CheckInterval, &out.CheckInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.CheckTimeout != nil {
+		in, out := &in.CheckTimeout, &out.CheckTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetsController.
+func (in *TargetsController) DeepCopy() *TargetsController {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetsController)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -81,6 +81,10 @@ type Blueprint struct {
 	// +optional
 	DeployExecutions []TemplateExecutor `json:"deployExecutions,omitempty"`
 
+	// RolloutStrategy defines how the deploy items of the deploy executions are rolled out.
+	// +optional
+	RolloutStrategy *RolloutStrategy `json:"rolloutStrategy,omitempty"`
+
 	// ExportExecutions defines the templating executors that are used to generate the exports.
 	// +optional
 	ExportExecutions []TemplateExecutor `json:"exportExecutions,omitempty"`
@@ -125,6 +129,12 @@ type ExportDefinition struct {
 	// This field should be set and will likely be mandatory in future.
 	// +optional
 	Type ExportType `json:"type,omitempty"`
+
+	// Sensitive marks the exported data as sensitive.
+	// The data of sensitive exports is not stored in the data object itself but in a secret that is referenced by the data object.
+	// Only data exports can be sensitive.
+	// +optional
+	Sensitive bool `json:"sensitive,omitempty"`
 }
 
 // FieldValueDefinition defines a im- or exported field.
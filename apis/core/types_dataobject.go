@@ -25,5 +25,10 @@ type DataObject struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	// Data contains the data of the object as string.
+	// The data is empty if the data object is sensitive.
+	// +optional
 	Data AnyJSON `json:"data"`
+	// SecretRef references the secret in the namespace of the data object that holds the data of a sensitive data object.
+	// +optional
+	SecretRef *LocalSecretReference `json:"secretRef,omitempty"`
 }
@@ -63,6 +63,24 @@ type ExecutionSpec struct {
 	// Note that the type information is used to determine the secret key and the type of the secret.
 	// +optional
 	RegistryPullSecrets []ObjectReference `json:"registryPullSecrets,omitempty"`
+
+	// RolloutStrategy defines how the deploy items of the execution are rolled out.
+	// If not set, all deploy items are started as soon as their dependencies are fulfilled.
+	// +optional
+	RolloutStrategy *RolloutStrategy `json:"rolloutStrategy,omitempty"`
+}
+
+// RolloutStrategy defines how the deploy items of an execution are rolled out.
+type RolloutStrategy struct {
+	// MaxInProgress is the maximal number of deploy items of the execution that are processed at the same time.
+	// All deploy items are processed in parallel if it is not set or 0.
+	// +optional
+	MaxInProgress int32 `json:"maxInProgress,omitempty"`
+
+	// PauseOnFailure pauses the rollout if a deploy item has failed instead of failing the execution.
+	// A paused rollout is continued with the "continue" operation annotation.
+	// +optional
+	PauseOnFailure bool `json:"pauseOnFailure,omitempty"`
 }
 
 // ExecutionStatus contains the current status of a execution.
@@ -95,8 +113,27 @@ type ExecutionStatus struct {
 	// JobIDFinished is the ID of the finished working request.
 	JobIDFinished string `json:"jobIDFinished,omitempty"`
 
+	// JobIDGenerationTime is the timestamp when the JobID was set.
+	// +optional
+	JobIDGenerationTime *metav1.Time `json:"jobIDGenerationTime,omitempty"`
+
 	// ExecutionPhase is the current phase of the execution.
 	ExecutionPhase ExecPhase `json:"phase,omitempty"`
+
+	// Rollout contains the state of the rollout of the current job if a rollout strategy is defined.
+	// +optional
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+}
+
+// RolloutStatus contains the state of the rollout of the deploy items of an execution.
+type RolloutStatus struct {
+	// Paused is true if the rollout has been paused because of failed deploy items.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// ContinuedFailedItems contains the names of the failed deploy items for which the rollout has been continued.
+	// +optional
+	ContinuedFailedItems []string `json:"continuedFailedItems,omitempty"`
 }
 
 // ExecutionGeneration links a deployitem to the generation of the execution when it was applied.
@@ -133,6 +170,11 @@ type DeployItemTemplate struct {
 	// DependsOn lists deploy items that need to be executed before this one
 	DependsOn []string `json:"dependsOn,omitempty"`
 
+	// Wave is the rollout wave of the deploy item.
+	// A deploy item is only started after all deploy items of lower waves have finished.
+	// +optional
+	Wave int32 `json:"wave,omitempty"`
+
 	// UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.
 	// +optional
 	UpdateOnChangeOnly bool `json:"updateOnChangeOnly,omitempty"`
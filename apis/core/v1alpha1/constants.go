@@ -31,6 +31,10 @@ const (
 	// uninstalling the deployed artifacts
 	DeleteWithoutUninstallAnnotation = LandscaperDomain + "/delete-without-uninstall"
 
+	// SuspendedAnnotation is set by the landscaper at the subinstallations, executions and deploy items
+	// of a suspended installation. Objects with this annotation do not start new jobs.
+	SuspendedAnnotation = LandscaperDomain + "/suspended"
+
 	// DeleteIgnoreSuccessors is the annotation that specifies that an installation is deleted even if there
 	// are dependent installations.
 	DeleteIgnoreSuccessors = LandscaperDomain + "/delete-ignore-successors"
@@ -38,6 +42,19 @@ const (
 	// ReconcileReasonAnnotation can be used to specify a reason for a reconcile operation, for example a retry.
 	ReconcileReasonAnnotation = LandscaperDomain + "/reconcile-reason"
 
+	// RollbackRevisionAnnotation specifies the number of the revision an installation or a deploy item is rolled back to
+	// by the rollback operation. For helm deploy items, it is the revision of the helm release.
+	RollbackRevisionAnnotation = LandscaperDomain + "/rollback-revision"
+
+	// RolledBackGenerationAnnotation is set by the deployer library at a deploy item after a successful rollback.
+	// It contains the generation of the rolled back deploy item. Further jobs keep the rolled back revision
+	// until the generation of the deploy item changes.
+	RolledBackGenerationAnnotation = LandscaperDomain + "/rolled-back-generation"
+
+	// IgnoreMaintenanceWindowAnnotation can be set to "true" together with a reconcile operation to start
+	// the reconciliation of an installation immediately, even outside its maintenance window.
+	IgnoreMaintenanceWindowAnnotation = LandscaperDomain + "/ignore-maintenance-window"
+
 	// ReconcileTimestampAnnotation is used to recognize timeouts in deployitems
 	ReconcileTimestampAnnotation = LandscaperDomain + "/reconcile-time"
 
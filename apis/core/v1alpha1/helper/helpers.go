@@ -261,3 +261,34 @@ func HasDeleteWithoutUninstallAnnotation(obj metav1.ObjectMeta) bool {
 	v, ok := obj.GetAnnotations()[v1alpha1.DeleteWithoutUninstallAnnotation]
 	return ok && v == "true"
 }
+
+// IsSuspended returns true only if the given object
+// has the 'landscaper.gardener.cloud/suspended' annotation
+// and its value is 'true'.
+func IsSuspended(obj metav1.ObjectMeta) bool {
+	v, ok := obj.GetAnnotations()[v1alpha1.SuspendedAnnotation]
+	return ok && v == "true"
+}
+
+// SetSuspended adds or removes the 'landscaper.gardener.cloud/suspended' annotation.
+// It returns true if the annotations of the object have been changed.
+func SetSuspended(obj *metav1.ObjectMeta, suspended bool) bool {
+	if IsSuspended(*obj) == suspended {
+		return false
+	}
+	if suspended {
+		metav1.SetMetaDataAnnotation(obj, v1alpha1.SuspendedAnnotation, "true")
+	} else {
+		delete(obj.Annotations, v1alpha1.SuspendedAnnotation)
+	}
+	return true
+}
+
+// IsTargetUnhealthy returns true if the last health check of the current generation of the target failed
+// and is not older than the given maximal age.
+func IsTargetUnhealthy(target *v1alpha1.Target, maxAge time.Duration) bool {
+	return target.Status.LastCheckTime != nil &&
+		target.Status.ObservedGeneration == target.Generation &&
+		!target.Status.Reachable &&
+		time.Since(target.Status.LastCheckTime.Time) <= maxAge
+}
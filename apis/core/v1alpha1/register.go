@@ -63,6 +63,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&DeployerRegistrationList{},
 		&TargetSync{},
 		&TargetSyncList{},
+		&ImportGrant{},
+		&ImportGrantList{},
 	)
 	if err := RegisterConversions(scheme); err != nil {
 		return err
@@ -90,6 +92,7 @@ var ResourceDefinition = func() lsschema.CustomResourceDefinitions {
 			EnvironmentDefinition,
 			ComponentVersionOverwritesDefinition,
 			TargetSyncDefinition,
+			ImportGrantDefinition,
 		},
 	}
 }()
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targettypes
+
+import (
+	"github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// KubernetesClusterTokenRequestTargetType defines the landscaper kubernetes cluster target
+// that is accessed with short-lived service account tokens instead of a static kubeconfig.
+const KubernetesClusterTokenRequestTargetType v1alpha1.TargetType = core.GroupName + "/kubernetes-cluster-token-request"
+
+// AllowedTokenRequestTargetsAnnotation is the annotation of a service account that contains the comma-separated names
+// of the kubernetes cluster token request targets in its namespace for which tokens of the service account may be requested.
+// Tokens are only requested for service accounts that allow the target, so that a target cannot be used
+// to act as an arbitrary service account of its namespace.
+const AllowedTokenRequestTargetsAnnotation = core.GroupName + "/allowed-token-request-targets"
+
+// DefaultTokenExpirationSeconds is the default duration of validity of the tokens that are requested for
+// kubernetes cluster token request targets.
+const DefaultTokenExpirationSeconds int64 = 3600
+
+// KubernetesClusterTokenRequestTargetConfig defines the landscaper kubernetes cluster token request target config.
+// The tokens to access the cluster are requested with the TokenRequest API for a service account
+// in the namespace of the target when the target is resolved. The service account has to allow the target
+// with the AllowedTokenRequestTargetsAnnotation.
+// The api server of the cluster has to trust the issuer of the service account tokens.
+type KubernetesClusterTokenRequestTargetConfig struct {
+	// Server is the address of the api server of the cluster.
+	Server string `json:"server"`
+	// CAData contains the PEM-encoded certificate authority certificates of the api server.
+	// +optional
+	CAData []byte `json:"caData,omitempty"`
+	// ServiceAccount references the service account in the namespace of the target for which the tokens are requested.
+	ServiceAccount ServiceAccountReference `json:"serviceAccount"`
+	// Audiences are the intended audiences of the requested tokens.
+	// Defaults to the audiences of the api server that issues the tokens.
+	// +optional
+	Audiences []string `json:"audiences,omitempty"`
+	// ExpirationSeconds is the requested duration of validity of the tokens.
+	// Defaults to DefaultTokenExpirationSeconds.
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+}
+
+// ServiceAccountReference is a reference to a service account in the namespace of the target.
+type ServiceAccountReference struct {
+	// Name is the name of the service account.
+	Name string `json:"name"`
+}
@@ -74,4 +74,8 @@ type Context struct {
 	// If the string is empty, no overwrites will be used.
 	// +optional
 	ComponentVersionOverwritesReference string `json:"componentVersionOverwrites"`
+	// MaintenanceWindow restricts the start of new reconciliations of the installations that use this context
+	// to the given time window. The maintenance window of an installation takes precedence.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
 }
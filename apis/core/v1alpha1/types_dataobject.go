@@ -41,6 +41,9 @@ const DataObjectIndexLabel = "data.landscaper.gardener.cloud/index"
 // DataObjectHashAnnotation defines the name of the annotation that specifies the hash of the data.
 const DataObjectHashAnnotation = "data.landscaper.gardener.cloud/hash"
 
+// SensitiveDataObjectSecretKey defines the key of the secret that holds the data of a sensitive data object.
+const SensitiveDataObjectSecretKey = "data"
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // DataObjectList contains a list of DataObject
@@ -75,6 +78,11 @@ var DataObjectDefinition = lsschema.CustomResourceDefinition{
 			Type:     "string",
 			JSONPath: ".metadata.labels['data\\.landscaper\\.gardener\\.cloud\\/key']",
 		},
+		{
+			Name:     "Secret",
+			Type:     "string",
+			JSONPath: ".secretRef.name",
+		},
 		{
 			Name:     "Age",
 			Type:     "date",
@@ -91,5 +99,10 @@ type DataObject struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	// Data contains the data of the object as string.
+	// The data is empty if the data object is sensitive.
+	// +optional
 	Data AnyJSON `json:"data"`
+	// SecretRef references the secret in the namespace of the data object that holds the data of a sensitive data object.
+	// +optional
+	SecretRef *LocalSecretReference `json:"secretRef,omitempty"`
 }
@@ -15,6 +15,9 @@ import (
 // DeployItemValidationCondition is the Conditions type to indicate the deploy items configuration validation status.
 const DeployItemValidationCondition ConditionType = "DeployItemValidation"
 
+// DriftDetectionCondition is the Conditions type to indicate whether the last drift detection of a deploy item succeeded.
+const DriftDetectionCondition ConditionType = "DriftDetection"
+
 // DeployItemType defines the type of the deploy item
 type DeployItemType string
 
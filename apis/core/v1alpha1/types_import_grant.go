@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsschema "github.com/gardener/landscaper/apis/schema"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ImportGrantList contains a list of ImportGrant objects
+type ImportGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImportGrant `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// The ImportGrant allows installations in other namespaces to import data objects and targets of its namespace.
+// Installations reference granted objects with "<namespace>/<name>" in their data and target imports.
+type ImportGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec contains the specification
+	Spec ImportGrantSpec `json:"spec"`
+}
+
+// ImportGrantSpec contains the specification for an ImportGrant.
+type ImportGrantSpec struct {
+	// From defines the namespaces whose installations are allowed to import the granted objects.
+	From []ImportGrantFrom `json:"from"`
+
+	// To defines the data objects and targets of the namespace of the grant that can be imported.
+	To []ImportGrantTo `json:"to"`
+}
+
+// ImportGrantFrom describes the namespace of installations that are allowed to import.
+type ImportGrantFrom struct {
+	// Namespace is the namespace of the importing installations.
+	Namespace string `json:"namespace"`
+}
+
+// ImportGrantKind describes the kind of objects that are granted.
+type ImportGrantKind string
+
+const (
+	// DataObjectImportGrantKind grants the import of data objects.
+	DataObjectImportGrantKind ImportGrantKind = "DataObject"
+	// TargetImportGrantKind grants the import of targets.
+	TargetImportGrantKind ImportGrantKind = "Target"
+)
+
+// ImportGrantTo describes the objects that can be imported.
+type ImportGrantTo struct {
+	// Kind is the kind of the granted objects, either DataObject or Target.
+	Kind ImportGrantKind `json:"kind"`
+
+	// Name is the name of the granted object.
+	// All objects of the kind are granted if no name is given.
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// ImportGrantDefinition defines the ImportGrant resource CRD.
+var ImportGrantDefinition = lsschema.CustomResourceDefinition{
+	Names: lsschema.CustomResourceDefinitionNames{
+		Plural:   "importgrants",
+		Singular: "importgrant",
+		ShortNames: []string{
+			"igrant",
+		},
+		Kind: "ImportGrant",
+	},
+	Scope:   lsschema.NamespaceScoped,
+	Storage: true,
+	Served:  true,
+	AdditionalPrinterColumns: []lsschema.CustomResourceColumnDefinition{
+		{
+			Name:     "Age",
+			Type:     "date",
+			JSONPath: ".metadata.creationTimestamp",
+		},
+	},
+}
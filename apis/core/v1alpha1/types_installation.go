@@ -26,6 +26,20 @@ const SubinstallationNameAnnotation = "landscaper.gardener.cloud/subinstallation
 // todo: keep only subinstallations?
 const KeepChildrenAnnotation = "landscaper.gardener.cloud/keep-children"
 
+// InstallationRevisionInstallationLabel is the label of the secrets of the revision history
+// that contains the name of the installation.
+const InstallationRevisionInstallationLabel = "revision.landscaper.gardener.cloud/installation"
+
+// InstallationRevisionLabel is the label of the secrets of the revision history that contains the number of the revision.
+const InstallationRevisionLabel = "revision.landscaper.gardener.cloud/revision"
+
+// InstallationRevisionJobIDLabel is the label of the secrets of the revision history
+// that contains the ID of the job that applied the revision.
+const InstallationRevisionJobIDLabel = "revision.landscaper.gardener.cloud/job-id"
+
+// InstallationRevisionDataKey is the key of the secrets of the revision history that contains the revision.
+const InstallationRevisionDataKey = "revision"
+
 // EnsureSubInstallationsCondition is the Conditions type to indicate the sub installation status.
 const EnsureSubInstallationsCondition ConditionType = "EnsureSubInstallations"
 
@@ -50,6 +64,9 @@ const ValidateExportCondition ConditionType = "ValidateExport"
 // ComponentReferenceOverwriteCondition is the Conditions type to indicate that the component reference was overwritten.
 const ComponentReferenceOverwriteCondition ConditionType = "ComponentReferenceOverwrite"
 
+// RollbackCondition is the Conditions type to indicate the status of an automatic rollback of the installation.
+const RollbackCondition ConditionType = "Rollback"
+
 type ComponentInstallationPhase string
 
 type InstallationPhase string
@@ -172,6 +189,56 @@ type InstallationSpec struct {
 	// AutomaticReconcile allows to configure automatically repeated reconciliations.
 	// +optional
 	AutomaticReconcile *AutomaticReconcile `json:"automaticReconcile,omitempty"`
+
+	// RollbackPolicy allows to configure an automatic rollback to the last succeeded revision
+	// of the installation if a reconciliation fails or times out.
+	// +optional
+	RollbackPolicy *RollbackPolicy `json:"rollbackPolicy,omitempty"`
+
+	// RevisionHistoryLimit is the number of applied revisions of the installation that are kept in its revision history.
+	// If not set or 0, no revision history is recorded.
+	// +optional
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+
+	// MaintenanceWindow restricts the start of new reconciliations of the installation to the given time window.
+	// Reconciliations that are requested outside the window are deferred until the window begins.
+	// If not set, the maintenance window of the context of the installation is used.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// Suspend suspends the installation and all its subinstallations, executions and deploy items.
+	// Suspended installations do not start new jobs, and automatic and continuous reconciliations are paused.
+	// Running jobs are not interrupted.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// MaintenanceWindow defines a recurring time window in which new reconciliations of an installation are started.
+type MaintenanceWindow struct {
+	// Begin is a cron schedule in the standard format that defines when the window opens, e.g. "0 22 * * *".
+	Begin string `json:"begin"`
+
+	// End is a cron schedule in the standard format that defines when the window closes, e.g. "0 4 * * *".
+	End string `json:"end"`
+
+	// Timezone is the IANA name of the time zone in which the schedules are evaluated, e.g. "Europe/Berlin".
+	// If not set, UTC is used.
+	// +optional
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// RollbackPolicy defines if and when an installation is rolled back to its last succeeded revision.
+type RollbackPolicy struct {
+	// Enabled activates the automatic rollback.
+	// If enabled, the landscaper remembers the last succeeded revision of the installation
+	// and re-applies it if a reconciliation fails.
+	Enabled bool `json:"enabled"`
+
+	// Timeout specifies the maximal duration of a reconciliation.
+	// Reconciliations that take longer are interrupted and rolled back.
+	// If not set, reconciliations are only rolled back if they fail.
+	// +optional
+	Timeout *Duration `json:"timeout,omitempty"`
 }
 
 // AutomaticReconcile allows to configure automatically repeated reconciliations.
@@ -239,6 +306,10 @@ type InstallationStatus struct {
 	// JobIDFinished is the ID of the finished working request.
 	JobIDFinished string `json:"jobIDFinished,omitempty"`
 
+	// JobIDGenerationTime is the timestamp when the JobID was set.
+	// +optional
+	JobIDGenerationTime *metav1.Time `json:"jobIDGenerationTime,omitempty"`
+
 	// InstallationPhase is the current phase of the installation.
 	InstallationPhase InstallationPhase `json:"phase,omitempty"`
 
@@ -248,6 +319,113 @@ type InstallationStatus struct {
 	// AutomaticReconcileStatus describes the status of automatically triggered reconciles.
 	// +optional
 	AutomaticReconcileStatus *AutomaticReconcileStatus `json:"automaticReconcileStatus,omitempty"`
+
+	// Plan describes the changes that a reconciliation of the installation would apply.
+	// It is computed if the installation is annotated with the plan operation.
+	// +optional
+	Plan *InstallationPlan `json:"plan,omitempty"`
+
+	// LastSucceededRevision is the configuration of the installation that was applied by the last succeeded reconciliation.
+	// It is only recorded if the rollback policy of the installation is enabled.
+	// +optional
+	LastSucceededRevision *InstallationRevision `json:"lastSucceededRevision,omitempty"`
+
+	// Rollback describes the last automatic rollback of the installation.
+	// +optional
+	Rollback *RollbackStatus `json:"rollback,omitempty"`
+
+	// LatestRevision is the number of the latest revision in the revision history of the installation.
+	// +optional
+	LatestRevision int64 `json:"latestRevision,omitempty"`
+
+	// DeferredUntil is the begin of the next maintenance window if a requested reconciliation
+	// has been deferred because it was requested outside the maintenance window of the installation.
+	// +optional
+	DeferredUntil *metav1.Time `json:"deferredUntil,omitempty"`
+}
+
+// InstallationRevision describes a configuration of an installation that has been applied successfully.
+type InstallationRevision struct {
+	// Revision is the number of the revision in the revision history of the installation.
+	// +optional
+	Revision int64 `json:"revision,omitempty"`
+
+	// JobID is the ID of the job that applied the revision.
+	JobID string `json:"jobID"`
+
+	// ObservedGeneration is the generation of the installation that was applied.
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// AppliedTime is the time when the revision was applied successfully.
+	AppliedTime metav1.Time `json:"appliedTime"`
+
+	// ComponentDescriptor is the reference to the component descriptor of the revision.
+	// +optional
+	ComponentDescriptor *ComponentDescriptorDefinition `json:"componentDescriptor,omitempty"`
+
+	// Blueprint is the reference to the blueprint of the revision.
+	Blueprint BlueprintDefinition `json:"blueprint"`
+
+	// ImportsHash is the hash of the import data of the revision.
+	// +optional
+	ImportsHash string `json:"importsHash,omitempty"`
+
+	// DeployItems are the rendered deploy item templates of the execution of the revision.
+	// +optional
+	DeployItems DeployItemTemplateList `json:"deployItems,omitempty"`
+
+	// RolloutStrategy is the rollout strategy of the execution of the revision.
+	// +optional
+	RolloutStrategy *RolloutStrategy `json:"rolloutStrategy,omitempty"`
+
+	// Subinstallations are the subinstallations of the revision.
+	// +optional
+	Subinstallations []SubinstallationRevision `json:"subinstallations,omitempty"`
+}
+
+// SubinstallationRevision describes a subinstallation of a revision of an installation.
+type SubinstallationRevision struct {
+	// Name is the name of the installation template of the subinstallation.
+	Name string `json:"name"`
+
+	// Spec is the applied spec of the subinstallation.
+	Spec InstallationSpec `json:"spec"`
+}
+
+// RollbackReason describes why an installation has been rolled back.
+type RollbackReason string
+
+const (
+	// RollbackReasonFailed indicates that the installation has been rolled back because a reconciliation failed.
+	RollbackReasonFailed RollbackReason = "Failed"
+	// RollbackReasonTimeout indicates that the installation has been rolled back because a reconciliation timed out.
+	RollbackReasonTimeout RollbackReason = "Timeout"
+	// RollbackReasonOperation indicates that the installation has been rolled back by the rollback operation.
+	RollbackReasonOperation RollbackReason = "Operation"
+)
+
+// RollbackStatus describes an automatic rollback of an installation.
+type RollbackStatus struct {
+	// JobID is the ID of the job that re-applies the last succeeded revision.
+	JobID string `json:"jobID"`
+
+	// FailedJobID is the ID of the job that failed or timed out.
+	// It is not set if the rollback has been triggered by the rollback operation.
+	// +optional
+	FailedJobID string `json:"failedJobID,omitempty"`
+
+	// RevisionJobID is the ID of the job that applied the revision which is re-applied.
+	RevisionJobID string `json:"revisionJobID"`
+
+	// Revision is the number of the revision which is re-applied.
+	// +optional
+	Revision int64 `json:"revision,omitempty"`
+
+	// Reason describes why the installation has been rolled back.
+	Reason RollbackReason `json:"reason"`
+
+	// StartTime is the time when the rollback has been started.
+	StartTime metav1.Time `json:"startTime"`
 }
 
 // AutomaticReconcileStatus describes the status of automatically triggered reconciles.
@@ -266,6 +444,70 @@ type AutomaticReconcileStatus struct {
 	OnFailed bool `json:"onFailed,omitempty"`
 }
 
+// InstallationPlan describes the changes that a reconciliation of an installation would apply
+// to its subinstallations, deploy items and imported data objects and targets.
+type InstallationPlan struct {
+	// ObservedGeneration is the generation of the installation the plan was computed for.
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// PlanTime is the time when the plan was computed.
+	PlanTime metav1.Time `json:"planTime"`
+
+	// ImportsHash is the hash of the import data the plan was computed with.
+	// +optional
+	ImportsHash string `json:"importsHash,omitempty"`
+
+	// Imports describes the planned changes of the data objects and targets that hold the imports of the installation.
+	// +optional
+	Imports []PlannedChange `json:"imports,omitempty"`
+
+	// Subinstallations describes the planned changes of the subinstallations.
+	// +optional
+	Subinstallations []PlannedChange `json:"subinstallations,omitempty"`
+
+	// DeployItems describes the planned changes of the deploy item templates of the execution.
+	// +optional
+	DeployItems []PlannedChange `json:"deployItems,omitempty"`
+
+	// Error describes the error that occurred while the plan was computed.
+	// +optional
+	Error *Error `json:"error,omitempty"`
+}
+
+// PlannedChange describes the planned change of a single object.
+type PlannedChange struct {
+	// Name is the logical name of the object, e.g. the name of the deploy item template or the subinstallation template.
+	Name string `json:"name"`
+
+	// Kind is the kind of the object.
+	Kind string `json:"kind"`
+
+	// Action is the action that would be applied to the object.
+	Action PlanAction `json:"action"`
+
+	// Reference is the reference to the existing object.
+	// +optional
+	Reference *ObjectReference `json:"reference,omitempty"`
+
+	// ChangedFields contains the fields of the object that would change.
+	// +optional
+	ChangedFields []string `json:"changedFields,omitempty"`
+}
+
+// PlanAction is the type of action that would be applied to an object.
+type PlanAction string
+
+const (
+	// PlanActionCreate describes that an object would be created.
+	PlanActionCreate PlanAction = "Create"
+	// PlanActionUpdate describes that an existing object would be updated.
+	PlanActionUpdate PlanAction = "Update"
+	// PlanActionDelete describes that an existing object would be deleted.
+	PlanActionDelete PlanAction = "Delete"
+	// PlanActionUnchanged describes that an existing object would not change.
+	PlanActionUnchanged PlanAction = "Unchanged"
+)
+
 // InstallationImports defines import of data objects and targets.
 type InstallationImports struct {
 	// Data defines all data object imports.
@@ -286,6 +528,18 @@ type InstallationExports struct {
 	// Targets defines all target exports.
 	// +optional
 	Targets []TargetExport `json:"targets,omitempty"`
+
+	// Secrets defines exports that are written into a key of a secret
+	// in the namespace of the installation.
+	// This method is not allowed in installation templates.
+	// +optional
+	Secrets []SecretExport `json:"secrets,omitempty"`
+
+	// ConfigMaps defines exports that are written into a key of a configmap
+	// in the namespace of the installation.
+	// This method is not allowed in installation templates.
+	// +optional
+	ConfigMaps []ConfigMapExport `json:"configMaps,omitempty"`
 }
 
 // DataImport is a data object import.
@@ -323,6 +577,48 @@ type DataExport struct {
 	DataRef string `json:"dataRef"`
 }
 
+// SecretExport writes an export into a key of a secret.
+type SecretExport struct {
+	// Name the internal name of the exported data.
+	Name string `json:"name"`
+
+	// SecretRef defines the secret and the key the exported data is written to.
+	// The secret is created in the namespace of the installation and is owned by the installation.
+	SecretRef LocalSecretReference `json:"secretRef"`
+
+	// Transformation optionally transforms the exported data before it is written.
+	// +optional
+	Transformation *ExportTransformation `json:"transformation,omitempty"`
+}
+
+// ConfigMapExport writes an export into a key of a configmap.
+type ConfigMapExport struct {
+	// Name the internal name of the exported data.
+	Name string `json:"name"`
+
+	// ConfigMapRef defines the configmap and the key the exported data is written to.
+	// The configmap is created in the namespace of the installation and is owned by the installation.
+	ConfigMapRef LocalConfigMapReference `json:"configMapRef"`
+
+	// Transformation optionally transforms the exported data before it is written.
+	// +optional
+	Transformation *ExportTransformation `json:"transformation,omitempty"`
+}
+
+// ExportTransformation defines how exported data is transformed before it is written into a secret or configmap.
+// Exactly one of JSONPath and Template has to be defined.
+// String results are written as they are, all other results are written as json.
+type ExportTransformation struct {
+	// JSONPath selects a value of the exported data, e.g. ".spec.host".
+	// +optional
+	JSONPath string `json:"jsonPath,omitempty"`
+
+	// Template is a go template that is rendered with the exported data as its root value.
+	// The sprig functions are available in the template.
+	// +optional
+	Template string `json:"template,omitempty"`
+}
+
 // TargetImport is either a single target or a target list import.
 type TargetImport struct {
 	// Name the internal name of the imported target.
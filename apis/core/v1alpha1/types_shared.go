@@ -167,6 +167,16 @@ const (
 	ErrorWebhook ErrorCode = "ERR_WEBHOOK"
 	// ErrorUnfinished indicates that there are unfinished sub-objects.
 	ErrorUnfinished ErrorCode = "ERR_UNFINISHED"
+	// ErrorServerSideApplyConflict indicates that a server-side apply failed due to fields that are managed by another field manager.
+	ErrorServerSideApplyConflict ErrorCode = "ERR_SERVER_SIDE_APPLY_CONFLICT"
+	// ErrorHelmTestFailed indicates that a test of a helm release failed.
+	ErrorHelmTestFailed ErrorCode = "ERR_HELM_TEST_FAILED"
+	// ErrorJobFailed indicates that a job of the job deployer failed.
+	ErrorJobFailed ErrorCode = "ERR_JOB_FAILED"
+	// ErrorTerraformFailed indicates that a terraform run of the terraform deployer failed.
+	ErrorTerraformFailed ErrorCode = "ERR_TERRAFORM_FAILED"
+	// ErrorTargetUnhealthy indicates that the target of a deploy item failed its last health check.
+	ErrorTargetUnhealthy ErrorCode = "ERR_TARGET_UNHEALTHY"
 )
 
 // UnrecoverableErrorCodes defines unrecoverable error codes
@@ -176,6 +186,9 @@ var UnrecoverableErrorCodes = []ErrorCode{
 	ErrorReadinessCheckTimeout,
 	ErrorTimeout,
 	ErrorCyclicDependencies,
+	ErrorHelmTestFailed,
+	ErrorJobFailed,
+	ErrorTerraformFailed,
 }
 
 // Condition holds the information about the state of a resource.
@@ -232,6 +245,20 @@ const (
 	// deployer could do some cleanup.
 	InterruptOperation Operation = "interrupt"
 
+	// PlanOperation is the annotation to let the landscaper compute the changes that a reconciliation of an installation
+	// would apply without applying them. The result is written to the plan in the status of the installation.
+	PlanOperation Operation = "plan"
+
+	// ContinueOperation is the annotation to let the landscaper continue the paused rollout of the deploy items of an
+	// execution. The failed deploy items are ignored for the remaining rollout but the execution still fails in the end.
+	ContinueOperation Operation = "continue"
+
+	// RollbackOperation is the annotation to let the landscaper roll back a root installation to a revision of its
+	// revision history. The number of the revision is specified by the rollback revision annotation.
+	// If set at a DeployItem of a deployer that supports rollbacks, the next job of the DeployItem rolls back
+	// the deployed state, e.g. the helm release, instead of reconciling it.
+	RollbackOperation Operation = "rollback"
+
 	// TestReconcileOperation is only used for test purposes. If set at a DeployItem, it triggers a reconciliation
 	// of that DeployItem. It must not be used in a productive scenario.
 	TestReconcileOperation Operation = "test-reconcile"
@@ -313,6 +340,15 @@ type LocalSecretReference struct {
 	Key string `json:"key"`
 }
 
+// LocalConfigMapReference is a reference to data in a configmap.
+type LocalConfigMapReference struct {
+	// Name is the name of the configmap
+	Name string `json:"name"`
+	// Key is the name of the key in the configmap that holds the data.
+	// +optional
+	Key string `json:"key"`
+}
+
 // ConfigMapReference is reference to data in a configmap.
 // The configmap can also be in a different namespace.
 type ConfigMapReference struct {
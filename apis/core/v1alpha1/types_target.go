@@ -36,7 +36,7 @@ var TargetDefinition = lsschema.CustomResourceDefinition{
 	Scope:             lsschema.NamespaceScoped,
 	Storage:           true,
 	Served:            true,
-	SubresourceStatus: false,
+	SubresourceStatus: true,
 	AdditionalPrinterColumns: []lsschema.CustomResourceColumnDefinition{
 		{
 			Name:     "Type",
@@ -58,6 +58,16 @@ var TargetDefinition = lsschema.CustomResourceDefinition{
 			Type:     "string",
 			JSONPath: ".metadata.labels['data\\.landscaper\\.gardener\\.cloud\\/index']",
 		},
+		{
+			Name:     "Reachable",
+			Type:     "boolean",
+			JSONPath: ".status.reachable",
+		},
+		{
+			Name:     "Version",
+			Type:     "string",
+			JSONPath: ".status.serverVersion",
+		},
 		{
 			Name:     "Age",
 			Type:     "date",
@@ -76,6 +86,10 @@ type Target struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
 	Spec TargetSpec `json:"spec"`
+
+	// Status contains the result of the last health check of the target.
+	// +optional
+	Status TargetStatus `json:"status,omitempty"`
 }
 
 // TargetSpec contains the definition of a target.
@@ -95,6 +109,35 @@ type TargetSpec struct {
 	SecretRef *LocalSecretReference `json:"secretRef,omitempty"`
 }
 
+// TargetStatus contains the result of the last health check of a target.
+// The status is only maintained for targets of types that are known to the landscaper.
+type TargetStatus struct {
+	// ObservedGeneration is the generation of the target that was checked.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Reachable indicates whether the target environment could be accessed with the configuration of the target.
+	// +optional
+	Reachable bool `json:"reachable,omitempty"`
+
+	// ServerVersion is the version of the target environment that was reported by the last successful check.
+	// +optional
+	ServerVersion string `json:"serverVersion,omitempty"`
+
+	// CredentialExpirationTime is the time when the credentials of the target expire.
+	// It is only set if the expiration time can be determined from the credentials.
+	// +optional
+	CredentialExpirationTime *metav1.Time `json:"credentialExpirationTime,omitempty"`
+
+	// LastCheckTime is the time of the last health check of the target.
+	// +optional
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
+
+	// Message describes the reason why the target is not reachable.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // TargetTemplate exposes specific parts of a target that are used in the exports
 // to export a target
 type TargetTemplate struct {
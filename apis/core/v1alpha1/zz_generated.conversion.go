@@ -182,6 +182,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ConfigMapExport)(nil), (*core.ConfigMapExport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ConfigMapExport_To_core_ConfigMapExport(a.(*ConfigMapExport), b.(*core.ConfigMapExport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ConfigMapExport)(nil), (*ConfigMapExport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ConfigMapExport_To_v1alpha1_ConfigMapExport(a.(*core.ConfigMapExport), b.(*ConfigMapExport), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ConfigMapReference)(nil), (*core.ConfigMapReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ConfigMapReference_To_core_ConfigMapReference(a.(*ConfigMapReference), b.(*core.ConfigMapReference), scope)
 	}); err != nil {
@@ -472,6 +482,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ExportTransformation)(nil), (*core.ExportTransformation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExportTransformation_To_core_ExportTransformation(a.(*ExportTransformation), b.(*core.ExportTransformation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ExportTransformation)(nil), (*ExportTransformation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ExportTransformation_To_v1alpha1_ExportTransformation(a.(*core.ExportTransformation), b.(*ExportTransformation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*FailedReconcile)(nil), (*core.FailedReconcile)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_FailedReconcile_To_core_FailedReconcile(a.(*FailedReconcile), b.(*core.FailedReconcile), scope)
 	}); err != nil {
@@ -502,6 +522,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ImportGrant)(nil), (*core.ImportGrant)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImportGrant_To_core_ImportGrant(a.(*ImportGrant), b.(*core.ImportGrant), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ImportGrant)(nil), (*ImportGrant)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ImportGrant_To_v1alpha1_ImportGrant(a.(*core.ImportGrant), b.(*ImportGrant), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ImportGrantFrom)(nil), (*core.ImportGrantFrom)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImportGrantFrom_To_core_ImportGrantFrom(a.(*ImportGrantFrom), b.(*core.ImportGrantFrom), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ImportGrantFrom)(nil), (*ImportGrantFrom)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ImportGrantFrom_To_v1alpha1_ImportGrantFrom(a.(*core.ImportGrantFrom), b.(*ImportGrantFrom), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ImportGrantList)(nil), (*core.ImportGrantList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImportGrantList_To_core_ImportGrantList(a.(*ImportGrantList), b.(*core.ImportGrantList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ImportGrantList)(nil), (*ImportGrantList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ImportGrantList_To_v1alpha1_ImportGrantList(a.(*core.ImportGrantList), b.(*ImportGrantList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ImportGrantSpec)(nil), (*core.ImportGrantSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImportGrantSpec_To_core_ImportGrantSpec(a.(*ImportGrantSpec), b.(*core.ImportGrantSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ImportGrantSpec)(nil), (*ImportGrantSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ImportGrantSpec_To_v1alpha1_ImportGrantSpec(a.(*core.ImportGrantSpec), b.(*ImportGrantSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ImportGrantTo)(nil), (*core.ImportGrantTo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImportGrantTo_To_core_ImportGrantTo(a.(*ImportGrantTo), b.(*core.ImportGrantTo), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ImportGrantTo)(nil), (*ImportGrantTo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ImportGrantTo_To_v1alpha1_ImportGrantTo(a.(*core.ImportGrantTo), b.(*ImportGrantTo), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ImportStatus)(nil), (*core.ImportStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ImportStatus_To_core_ImportStatus(a.(*ImportStatus), b.(*core.ImportStatus), scope)
 	}); err != nil {
@@ -562,6 +632,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*InstallationPlan)(nil), (*core.InstallationPlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstallationPlan_To_core_InstallationPlan(a.(*InstallationPlan), b.(*core.InstallationPlan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstallationPlan)(nil), (*InstallationPlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstallationPlan_To_v1alpha1_InstallationPlan(a.(*core.InstallationPlan), b.(*InstallationPlan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InstallationRevision)(nil), (*core.InstallationRevision)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstallationRevision_To_core_InstallationRevision(a.(*InstallationRevision), b.(*core.InstallationRevision), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstallationRevision)(nil), (*InstallationRevision)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstallationRevision_To_v1alpha1_InstallationRevision(a.(*core.InstallationRevision), b.(*InstallationRevision), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InstallationSpec)(nil), (*core.InstallationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InstallationSpec_To_core_InstallationSpec(a.(*InstallationSpec), b.(*core.InstallationSpec), scope)
 	}); err != nil {
@@ -612,6 +702,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LocalConfigMapReference)(nil), (*core.LocalConfigMapReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LocalConfigMapReference_To_core_LocalConfigMapReference(a.(*LocalConfigMapReference), b.(*core.LocalConfigMapReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.LocalConfigMapReference)(nil), (*LocalConfigMapReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_LocalConfigMapReference_To_v1alpha1_LocalConfigMapReference(a.(*core.LocalConfigMapReference), b.(*LocalConfigMapReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LocalSecretReference)(nil), (*core.LocalSecretReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_LocalSecretReference_To_core_LocalSecretReference(a.(*LocalSecretReference), b.(*core.LocalSecretReference), scope)
 	}); err != nil {
@@ -642,6 +742,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceWindow)(nil), (*core.MaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow(a.(*MaintenanceWindow), b.(*core.MaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceWindow)(nil), (*MaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(a.(*core.MaintenanceWindow), b.(*MaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NamedObjectReference)(nil), (*core.NamedObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NamedObjectReference_To_core_NamedObjectReference(a.(*NamedObjectReference), b.(*core.NamedObjectReference), scope)
 	}); err != nil {
@@ -662,6 +772,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PlannedChange)(nil), (*core.PlannedChange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PlannedChange_To_core_PlannedChange(a.(*PlannedChange), b.(*core.PlannedChange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PlannedChange)(nil), (*PlannedChange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PlannedChange_To_v1alpha1_PlannedChange(a.(*core.PlannedChange), b.(*PlannedChange), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RemoteBlueprintReference)(nil), (*core.RemoteBlueprintReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RemoteBlueprintReference_To_core_RemoteBlueprintReference(a.(*RemoteBlueprintReference), b.(*core.RemoteBlueprintReference), scope)
 	}); err != nil {
@@ -702,6 +822,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RollbackPolicy)(nil), (*core.RollbackPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RollbackPolicy_To_core_RollbackPolicy(a.(*RollbackPolicy), b.(*core.RollbackPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RollbackPolicy)(nil), (*RollbackPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RollbackPolicy_To_v1alpha1_RollbackPolicy(a.(*core.RollbackPolicy), b.(*RollbackPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RollbackStatus)(nil), (*core.RollbackStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RollbackStatus_To_core_RollbackStatus(a.(*RollbackStatus), b.(*core.RollbackStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RollbackStatus)(nil), (*RollbackStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RollbackStatus_To_v1alpha1_RollbackStatus(a.(*core.RollbackStatus), b.(*RollbackStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutStatus)(nil), (*core.RolloutStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutStatus_To_core_RolloutStatus(a.(*RolloutStatus), b.(*core.RolloutStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RolloutStatus)(nil), (*RolloutStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RolloutStatus_To_v1alpha1_RolloutStatus(a.(*core.RolloutStatus), b.(*RolloutStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutStrategy)(nil), (*core.RolloutStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutStrategy_To_core_RolloutStrategy(a.(*RolloutStrategy), b.(*core.RolloutStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RolloutStrategy)(nil), (*RolloutStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RolloutStrategy_To_v1alpha1_RolloutStrategy(a.(*core.RolloutStrategy), b.(*RolloutStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecretExport)(nil), (*core.SecretExport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecretExport_To_core_SecretExport(a.(*SecretExport), b.(*core.SecretExport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SecretExport)(nil), (*SecretExport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SecretExport_To_v1alpha1_SecretExport(a.(*core.SecretExport), b.(*SecretExport), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SecretLabelSelectorRef)(nil), (*core.SecretLabelSelectorRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SecretLabelSelectorRef_To_core_SecretLabelSelectorRef(a.(*SecretLabelSelectorRef), b.(*core.SecretLabelSelectorRef), scope)
 	}); err != nil {
@@ -742,6 +912,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SubinstallationRevision)(nil), (*core.SubinstallationRevision)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SubinstallationRevision_To_core_SubinstallationRevision(a.(*SubinstallationRevision), b.(*core.SubinstallationRevision), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SubinstallationRevision)(nil), (*SubinstallationRevision)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SubinstallationRevision_To_v1alpha1_SubinstallationRevision(a.(*core.SubinstallationRevision), b.(*SubinstallationRevision), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SubinstallationTemplate)(nil), (*core.SubinstallationTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SubinstallationTemplate_To_core_SubinstallationTemplate(a.(*SubinstallationTemplate), b.(*core.SubinstallationTemplate), scope)
 	}); err != nil {
@@ -842,6 +1022,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TargetStatus)(nil), (*core.TargetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetStatus_To_core_TargetStatus(a.(*TargetStatus), b.(*core.TargetStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TargetStatus)(nil), (*TargetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TargetStatus_To_v1alpha1_TargetStatus(a.(*core.TargetStatus), b.(*TargetStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TargetSync)(nil), (*core.TargetSync)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TargetSync_To_core_TargetSync(a.(*TargetSync), b.(*core.TargetSync), scope)
 	}); err != nil {
@@ -1053,6 +1243,7 @@ func autoConvert_v1alpha1_Blueprint_To_core_Blueprint(in *Blueprint, out *core.B
 	out.Subinstallations = *(*core.SubinstallationTemplateList)(unsafe.Pointer(&in.Subinstallations))
 	out.SubinstallationExecutions = *(*[]core.TemplateExecutor)(unsafe.Pointer(&in.SubinstallationExecutions))
 	out.DeployExecutions = *(*[]core.TemplateExecutor)(unsafe.Pointer(&in.DeployExecutions))
+	out.RolloutStrategy = (*core.RolloutStrategy)(unsafe.Pointer(in.RolloutStrategy))
 	out.ExportExecutions = *(*[]core.TemplateExecutor)(unsafe.Pointer(&in.ExportExecutions))
 	return nil
 }
@@ -1072,6 +1263,7 @@ func autoConvert_core_Blueprint_To_v1alpha1_Blueprint(in *core.Blueprint, out *B
 	out.Subinstallations = *(*SubinstallationTemplateList)(unsafe.Pointer(&in.Subinstallations))
 	out.SubinstallationExecutions = *(*[]TemplateExecutor)(unsafe.Pointer(&in.SubinstallationExecutions))
 	out.DeployExecutions = *(*[]TemplateExecutor)(unsafe.Pointer(&in.DeployExecutions))
+	out.RolloutStrategy = (*RolloutStrategy)(unsafe.Pointer(in.RolloutStrategy))
 	out.ExportExecutions = *(*[]TemplateExecutor)(unsafe.Pointer(&in.ExportExecutions))
 	return nil
 }
@@ -1353,6 +1545,34 @@ func Convert_core_Condition_To_v1alpha1_Condition(in *core.Condition, out *Condi
 	return autoConvert_core_Condition_To_v1alpha1_Condition(in, out, s)
 }
 
+func autoConvert_v1alpha1_ConfigMapExport_To_core_ConfigMapExport(in *ConfigMapExport, out *core.ConfigMapExport, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1alpha1_LocalConfigMapReference_To_core_LocalConfigMapReference(&in.ConfigMapRef, &out.ConfigMapRef, s); err != nil {
+		return err
+	}
+	out.Transformation = (*core.ExportTransformation)(unsafe.Pointer(in.Transformation))
+	return nil
+}
+
+// Convert_v1alpha1_ConfigMapExport_To_core_ConfigMapExport is an autogenerated conversion function.
+func Convert_v1alpha1_ConfigMapExport_To_core_ConfigMapExport(in *ConfigMapExport, out *core.ConfigMapExport, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ConfigMapExport_To_core_ConfigMapExport(in, out, s)
+}
+
+func autoConvert_core_ConfigMapExport_To_v1alpha1_ConfigMapExport(in *core.ConfigMapExport, out *ConfigMapExport, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_core_LocalConfigMapReference_To_v1alpha1_LocalConfigMapReference(&in.ConfigMapRef, &out.ConfigMapRef, s); err != nil {
+		return err
+	}
+	out.Transformation = (*ExportTransformation)(unsafe.Pointer(in.Transformation))
+	return nil
+}
+
+// Convert_core_ConfigMapExport_To_v1alpha1_ConfigMapExport is an autogenerated conversion function.
+func Convert_core_ConfigMapExport_To_v1alpha1_ConfigMapExport(in *core.ConfigMapExport, out *ConfigMapExport, s conversion.Scope) error {
+	return autoConvert_core_ConfigMapExport_To_v1alpha1_ConfigMapExport(in, out, s)
+}
+
 func autoConvert_v1alpha1_ConfigMapReference_To_core_ConfigMapReference(in *ConfigMapReference, out *core.ConfigMapReference, s conversion.Scope) error {
 	if err := Convert_v1alpha1_ObjectReference_To_core_ObjectReference(&in.ObjectReference, &out.ObjectReference, s); err != nil {
 		return err
@@ -1385,6 +1605,7 @@ func autoConvert_v1alpha1_Context_To_core_Context(in *Context, out *core.Context
 	out.RegistryPullSecrets = *(*[]v1.LocalObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
 	out.Configurations = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Configurations))
 	out.ComponentVersionOverwritesReference = in.ComponentVersionOverwritesReference
+	out.MaintenanceWindow = (*core.MaintenanceWindow)(unsafe.Pointer(in.MaintenanceWindow))
 	return nil
 }
 
@@ -1399,6 +1620,7 @@ func autoConvert_core_Context_To_v1alpha1_Context(in *core.Context, out *Context
 	out.RegistryPullSecrets = *(*[]v1.LocalObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
 	out.Configurations = *(*map[string]AnyJSON)(unsafe.Pointer(&in.Configurations))
 	out.ComponentVersionOverwritesReference = in.ComponentVersionOverwritesReference
+	out.MaintenanceWindow = (*MaintenanceWindow)(unsafe.Pointer(in.MaintenanceWindow))
 	return nil
 }
 
@@ -1484,6 +1706,7 @@ func autoConvert_v1alpha1_DataObject_To_core_DataObject(in *DataObject, out *cor
 	if err := Convert_v1alpha1_AnyJSON_To_core_AnyJSON(&in.Data, &out.Data, s); err != nil {
 		return err
 	}
+	out.SecretRef = (*core.LocalSecretReference)(unsafe.Pointer(in.SecretRef))
 	return nil
 }
 
@@ -1497,6 +1720,7 @@ func autoConvert_core_DataObject_To_v1alpha1_DataObject(in *core.DataObject, out
 	if err := Convert_core_AnyJSON_To_v1alpha1_AnyJSON(&in.Data, &out.Data, s); err != nil {
 		return err
 	}
+	out.SecretRef = (*LocalSecretReference)(unsafe.Pointer(in.SecretRef))
 	return nil
 }
 
@@ -1694,6 +1918,7 @@ func autoConvert_v1alpha1_DeployItemTemplate_To_core_DeployItemTemplate(in *Depl
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
 	out.Configuration = (*runtime.RawExtension)(unsafe.Pointer(in.Configuration))
 	out.DependsOn = *(*[]string)(unsafe.Pointer(&in.DependsOn))
+	out.Wave = in.Wave
 	out.UpdateOnChangeOnly = in.UpdateOnChangeOnly
 	return nil
 }
@@ -1710,6 +1935,7 @@ func autoConvert_core_DeployItemTemplate_To_v1alpha1_DeployItemTemplate(in *core
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
 	out.Configuration = (*runtime.RawExtension)(unsafe.Pointer(in.Configuration))
 	out.DependsOn = *(*[]string)(unsafe.Pointer(&in.DependsOn))
+	out.Wave = in.Wave
 	out.UpdateOnChangeOnly = in.UpdateOnChangeOnly
 	return nil
 }
@@ -2109,6 +2335,7 @@ func autoConvert_v1alpha1_ExecutionSpec_To_core_ExecutionSpec(in *ExecutionSpec,
 	out.Context = in.Context
 	out.DeployItems = *(*core.DeployItemTemplateList)(unsafe.Pointer(&in.DeployItems))
 	out.RegistryPullSecrets = *(*[]core.ObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
+	out.RolloutStrategy = (*core.RolloutStrategy)(unsafe.Pointer(in.RolloutStrategy))
 	return nil
 }
 
@@ -2116,6 +2343,7 @@ func autoConvert_core_ExecutionSpec_To_v1alpha1_ExecutionSpec(in *core.Execution
 	out.Context = in.Context
 	out.DeployItems = *(*DeployItemTemplateList)(unsafe.Pointer(&in.DeployItems))
 	out.RegistryPullSecrets = *(*[]ObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
+	out.RolloutStrategy = (*RolloutStrategy)(unsafe.Pointer(in.RolloutStrategy))
 	return nil
 }
 
@@ -2128,7 +2356,9 @@ func autoConvert_v1alpha1_ExecutionStatus_To_core_ExecutionStatus(in *ExecutionS
 	out.ExecutionGenerations = *(*[]core.ExecutionGeneration)(unsafe.Pointer(&in.ExecutionGenerations))
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
+	out.JobIDGenerationTime = (*metav1.Time)(unsafe.Pointer(in.JobIDGenerationTime))
 	out.ExecutionPhase = core.ExecPhase(in.ExecutionPhase)
+	out.Rollout = (*core.RolloutStatus)(unsafe.Pointer(in.Rollout))
 	return nil
 }
 
@@ -2146,7 +2376,9 @@ func autoConvert_core_ExecutionStatus_To_v1alpha1_ExecutionStatus(in *core.Execu
 	out.ExecutionGenerations = *(*[]ExecutionGeneration)(unsafe.Pointer(&in.ExecutionGenerations))
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
+	out.JobIDGenerationTime = (*metav1.Time)(unsafe.Pointer(in.JobIDGenerationTime))
 	out.ExecutionPhase = ExecPhase(in.ExecutionPhase)
+	out.Rollout = (*RolloutStatus)(unsafe.Pointer(in.Rollout))
 	return nil
 }
 
@@ -2160,6 +2392,7 @@ func autoConvert_v1alpha1_ExportDefinition_To_core_ExportDefinition(in *ExportDe
 		return err
 	}
 	out.Type = core.ExportType(in.Type)
+	out.Sensitive = in.Sensitive
 	return nil
 }
 
@@ -2173,6 +2406,7 @@ func autoConvert_core_ExportDefinition_To_v1alpha1_ExportDefinition(in *core.Exp
 		return err
 	}
 	out.Type = ExportType(in.Type)
+	out.Sensitive = in.Sensitive
 	return nil
 }
 
@@ -2181,6 +2415,28 @@ func Convert_core_ExportDefinition_To_v1alpha1_ExportDefinition(in *core.ExportD
 	return autoConvert_core_ExportDefinition_To_v1alpha1_ExportDefinition(in, out, s)
 }
 
+func autoConvert_v1alpha1_ExportTransformation_To_core_ExportTransformation(in *ExportTransformation, out *core.ExportTransformation, s conversion.Scope) error {
+	out.JSONPath = in.JSONPath
+	out.Template = in.Template
+	return nil
+}
+
+// Convert_v1alpha1_ExportTransformation_To_core_ExportTransformation is an autogenerated conversion function.
+func Convert_v1alpha1_ExportTransformation_To_core_ExportTransformation(in *ExportTransformation, out *core.ExportTransformation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExportTransformation_To_core_ExportTransformation(in, out, s)
+}
+
+func autoConvert_core_ExportTransformation_To_v1alpha1_ExportTransformation(in *core.ExportTransformation, out *ExportTransformation, s conversion.Scope) error {
+	out.JSONPath = in.JSONPath
+	out.Template = in.Template
+	return nil
+}
+
+// Convert_core_ExportTransformation_To_v1alpha1_ExportTransformation is an autogenerated conversion function.
+func Convert_core_ExportTransformation_To_v1alpha1_ExportTransformation(in *core.ExportTransformation, out *ExportTransformation, s conversion.Scope) error {
+	return autoConvert_core_ExportTransformation_To_v1alpha1_ExportTransformation(in, out, s)
+}
+
 func autoConvert_v1alpha1_FailedReconcile_To_core_FailedReconcile(in *FailedReconcile, out *core.FailedReconcile, s conversion.Scope) error {
 	out.NumberOfReconciles = (*int)(unsafe.Pointer(in.NumberOfReconciles))
 	out.Interval = (*core.Duration)(unsafe.Pointer(in.Interval))
@@ -2263,6 +2519,118 @@ func Convert_core_ImportDefinition_To_v1alpha1_ImportDefinition(in *core.ImportD
 	return autoConvert_core_ImportDefinition_To_v1alpha1_ImportDefinition(in, out, s)
 }
 
+func autoConvert_v1alpha1_ImportGrant_To_core_ImportGrant(in *ImportGrant, out *core.ImportGrant, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ImportGrantSpec_To_core_ImportGrantSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ImportGrant_To_core_ImportGrant is an autogenerated conversion function.
+func Convert_v1alpha1_ImportGrant_To_core_ImportGrant(in *ImportGrant, out *core.ImportGrant, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImportGrant_To_core_ImportGrant(in, out, s)
+}
+
+func autoConvert_core_ImportGrant_To_v1alpha1_ImportGrant(in *core.ImportGrant, out *ImportGrant, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_ImportGrantSpec_To_v1alpha1_ImportGrantSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_ImportGrant_To_v1alpha1_ImportGrant is an autogenerated conversion function.
+func Convert_core_ImportGrant_To_v1alpha1_ImportGrant(in *core.ImportGrant, out *ImportGrant, s conversion.Scope) error {
+	return autoConvert_core_ImportGrant_To_v1alpha1_ImportGrant(in, out, s)
+}
+
+func autoConvert_v1alpha1_ImportGrantFrom_To_core_ImportGrantFrom(in *ImportGrantFrom, out *core.ImportGrantFrom, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_v1alpha1_ImportGrantFrom_To_core_ImportGrantFrom is an autogenerated conversion function.
+func Convert_v1alpha1_ImportGrantFrom_To_core_ImportGrantFrom(in *ImportGrantFrom, out *core.ImportGrantFrom, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImportGrantFrom_To_core_ImportGrantFrom(in, out, s)
+}
+
+func autoConvert_core_ImportGrantFrom_To_v1alpha1_ImportGrantFrom(in *core.ImportGrantFrom, out *ImportGrantFrom, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_core_ImportGrantFrom_To_v1alpha1_ImportGrantFrom is an autogenerated conversion function.
+func Convert_core_ImportGrantFrom_To_v1alpha1_ImportGrantFrom(in *core.ImportGrantFrom, out *ImportGrantFrom, s conversion.Scope) error {
+	return autoConvert_core_ImportGrantFrom_To_v1alpha1_ImportGrantFrom(in, out, s)
+}
+
+func autoConvert_v1alpha1_ImportGrantList_To_core_ImportGrantList(in *ImportGrantList, out *core.ImportGrantList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.ImportGrant)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_ImportGrantList_To_core_ImportGrantList is an autogenerated conversion function.
+func Convert_v1alpha1_ImportGrantList_To_core_ImportGrantList(in *ImportGrantList, out *core.ImportGrantList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImportGrantList_To_core_ImportGrantList(in, out, s)
+}
+
+func autoConvert_core_ImportGrantList_To_v1alpha1_ImportGrantList(in *core.ImportGrantList, out *ImportGrantList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ImportGrant)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_ImportGrantList_To_v1alpha1_ImportGrantList is an autogenerated conversion function.
+func Convert_core_ImportGrantList_To_v1alpha1_ImportGrantList(in *core.ImportGrantList, out *ImportGrantList, s conversion.Scope) error {
+	return autoConvert_core_ImportGrantList_To_v1alpha1_ImportGrantList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ImportGrantSpec_To_core_ImportGrantSpec(in *ImportGrantSpec, out *core.ImportGrantSpec, s conversion.Scope) error {
+	out.From = *(*[]core.ImportGrantFrom)(unsafe.Pointer(&in.From))
+	out.To = *(*[]core.ImportGrantTo)(unsafe.Pointer(&in.To))
+	return nil
+}
+
+// Convert_v1alpha1_ImportGrantSpec_To_core_ImportGrantSpec is an autogenerated conversion function.
+func Convert_v1alpha1_ImportGrantSpec_To_core_ImportGrantSpec(in *ImportGrantSpec, out *core.ImportGrantSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImportGrantSpec_To_core_ImportGrantSpec(in, out, s)
+}
+
+func autoConvert_core_ImportGrantSpec_To_v1alpha1_ImportGrantSpec(in *core.ImportGrantSpec, out *ImportGrantSpec, s conversion.Scope) error {
+	out.From = *(*[]ImportGrantFrom)(unsafe.Pointer(&in.From))
+	out.To = *(*[]ImportGrantTo)(unsafe.Pointer(&in.To))
+	return nil
+}
+
+// Convert_core_ImportGrantSpec_To_v1alpha1_ImportGrantSpec is an autogenerated conversion function.
+func Convert_core_ImportGrantSpec_To_v1alpha1_ImportGrantSpec(in *core.ImportGrantSpec, out *ImportGrantSpec, s conversion.Scope) error {
+	return autoConvert_core_ImportGrantSpec_To_v1alpha1_ImportGrantSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_ImportGrantTo_To_core_ImportGrantTo(in *ImportGrantTo, out *core.ImportGrantTo, s conversion.Scope) error {
+	out.Kind = core.ImportGrantKind(in.Kind)
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_ImportGrantTo_To_core_ImportGrantTo is an autogenerated conversion function.
+func Convert_v1alpha1_ImportGrantTo_To_core_ImportGrantTo(in *ImportGrantTo, out *core.ImportGrantTo, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImportGrantTo_To_core_ImportGrantTo(in, out, s)
+}
+
+func autoConvert_core_ImportGrantTo_To_v1alpha1_ImportGrantTo(in *core.ImportGrantTo, out *ImportGrantTo, s conversion.Scope) error {
+	out.Kind = ImportGrantKind(in.Kind)
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_core_ImportGrantTo_To_v1alpha1_ImportGrantTo is an autogenerated conversion function.
+func Convert_core_ImportGrantTo_To_v1alpha1_ImportGrantTo(in *core.ImportGrantTo, out *ImportGrantTo, s conversion.Scope) error {
+	return autoConvert_core_ImportGrantTo_To_v1alpha1_ImportGrantTo(in, out, s)
+}
+
 func autoConvert_v1alpha1_ImportStatus_To_core_ImportStatus(in *ImportStatus, out *core.ImportStatus, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Type = core.ImportStatusType(in.Type)
@@ -2358,6 +2726,8 @@ func Convert_core_Installation_To_v1alpha1_Installation(in *core.Installation, o
 func autoConvert_v1alpha1_InstallationExports_To_core_InstallationExports(in *InstallationExports, out *core.InstallationExports, s conversion.Scope) error {
 	out.Data = *(*[]core.DataExport)(unsafe.Pointer(&in.Data))
 	out.Targets = *(*[]core.TargetExport)(unsafe.Pointer(&in.Targets))
+	out.Secrets = *(*[]core.SecretExport)(unsafe.Pointer(&in.Secrets))
+	out.ConfigMaps = *(*[]core.ConfigMapExport)(unsafe.Pointer(&in.ConfigMaps))
 	return nil
 }
 
@@ -2369,6 +2739,8 @@ func Convert_v1alpha1_InstallationExports_To_core_InstallationExports(in *Instal
 func autoConvert_core_InstallationExports_To_v1alpha1_InstallationExports(in *core.InstallationExports, out *InstallationExports, s conversion.Scope) error {
 	out.Data = *(*[]DataExport)(unsafe.Pointer(&in.Data))
 	out.Targets = *(*[]TargetExport)(unsafe.Pointer(&in.Targets))
+	out.Secrets = *(*[]SecretExport)(unsafe.Pointer(&in.Secrets))
+	out.ConfigMaps = *(*[]ConfigMapExport)(unsafe.Pointer(&in.ConfigMaps))
 	return nil
 }
 
@@ -2401,7 +2773,17 @@ func Convert_core_InstallationImports_To_v1alpha1_InstallationImports(in *core.I
 
 func autoConvert_v1alpha1_InstallationList_To_core_InstallationList(in *InstallationList, out *core.InstallationList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]core.Installation)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]core.Installation, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_Installation_To_core_Installation(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -2412,7 +2794,17 @@ func Convert_v1alpha1_InstallationList_To_core_InstallationList(in *Installation
 
 func autoConvert_core_InstallationList_To_v1alpha1_InstallationList(in *core.InstallationList, out *InstallationList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]Installation)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Installation, len(*in))
+		for i := range *in {
+			if err := Convert_core_Installation_To_v1alpha1_Installation(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -2421,6 +2813,80 @@ func Convert_core_InstallationList_To_v1alpha1_InstallationList(in *core.Install
 	return autoConvert_core_InstallationList_To_v1alpha1_InstallationList(in, out, s)
 }
 
+func autoConvert_v1alpha1_InstallationPlan_To_core_InstallationPlan(in *InstallationPlan, out *core.InstallationPlan, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.PlanTime = in.PlanTime
+	out.ImportsHash = in.ImportsHash
+	out.Imports = *(*[]core.PlannedChange)(unsafe.Pointer(&in.Imports))
+	out.Subinstallations = *(*[]core.PlannedChange)(unsafe.Pointer(&in.Subinstallations))
+	out.DeployItems = *(*[]core.PlannedChange)(unsafe.Pointer(&in.DeployItems))
+	out.Error = (*core.Error)(unsafe.Pointer(in.Error))
+	return nil
+}
+
+// Convert_v1alpha1_InstallationPlan_To_core_InstallationPlan is an autogenerated conversion function.
+func Convert_v1alpha1_InstallationPlan_To_core_InstallationPlan(in *InstallationPlan, out *core.InstallationPlan, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstallationPlan_To_core_InstallationPlan(in, out, s)
+}
+
+func autoConvert_core_InstallationPlan_To_v1alpha1_InstallationPlan(in *core.InstallationPlan, out *InstallationPlan, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.PlanTime = in.PlanTime
+	out.ImportsHash = in.ImportsHash
+	out.Imports = *(*[]PlannedChange)(unsafe.Pointer(&in.Imports))
+	out.Subinstallations = *(*[]PlannedChange)(unsafe.Pointer(&in.Subinstallations))
+	out.DeployItems = *(*[]PlannedChange)(unsafe.Pointer(&in.DeployItems))
+	out.Error = (*Error)(unsafe.Pointer(in.Error))
+	return nil
+}
+
+// Convert_core_InstallationPlan_To_v1alpha1_InstallationPlan is an autogenerated conversion function.
+func Convert_core_InstallationPlan_To_v1alpha1_InstallationPlan(in *core.InstallationPlan, out *InstallationPlan, s conversion.Scope) error {
+	return autoConvert_core_InstallationPlan_To_v1alpha1_InstallationPlan(in, out, s)
+}
+
+func autoConvert_v1alpha1_InstallationRevision_To_core_InstallationRevision(in *InstallationRevision, out *core.InstallationRevision, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.JobID = in.JobID
+	out.ObservedGeneration = in.ObservedGeneration
+	out.AppliedTime = in.AppliedTime
+	out.ComponentDescriptor = (*core.ComponentDescriptorDefinition)(unsafe.Pointer(in.ComponentDescriptor))
+	if err := Convert_v1alpha1_BlueprintDefinition_To_core_BlueprintDefinition(&in.Blueprint, &out.Blueprint, s); err != nil {
+		return err
+	}
+	out.ImportsHash = in.ImportsHash
+	out.DeployItems = *(*core.DeployItemTemplateList)(unsafe.Pointer(&in.DeployItems))
+	out.RolloutStrategy = (*core.RolloutStrategy)(unsafe.Pointer(in.RolloutStrategy))
+	out.Subinstallations = *(*[]core.SubinstallationRevision)(unsafe.Pointer(&in.Subinstallations))
+	return nil
+}
+
+// Convert_v1alpha1_InstallationRevision_To_core_InstallationRevision is an autogenerated conversion function.
+func Convert_v1alpha1_InstallationRevision_To_core_InstallationRevision(in *InstallationRevision, out *core.InstallationRevision, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstallationRevision_To_core_InstallationRevision(in, out, s)
+}
+
+func autoConvert_core_InstallationRevision_To_v1alpha1_InstallationRevision(in *core.InstallationRevision, out *InstallationRevision, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.JobID = in.JobID
+	out.ObservedGeneration = in.ObservedGeneration
+	out.AppliedTime = in.AppliedTime
+	out.ComponentDescriptor = (*ComponentDescriptorDefinition)(unsafe.Pointer(in.ComponentDescriptor))
+	if err := Convert_core_BlueprintDefinition_To_v1alpha1_BlueprintDefinition(&in.Blueprint, &out.Blueprint, s); err != nil {
+		return err
+	}
+	out.ImportsHash = in.ImportsHash
+	out.DeployItems = *(*DeployItemTemplateList)(unsafe.Pointer(&in.DeployItems))
+	out.RolloutStrategy = (*RolloutStrategy)(unsafe.Pointer(in.RolloutStrategy))
+	out.Subinstallations = *(*[]SubinstallationRevision)(unsafe.Pointer(&in.Subinstallations))
+	return nil
+}
+
+// Convert_core_InstallationRevision_To_v1alpha1_InstallationRevision is an autogenerated conversion function.
+func Convert_core_InstallationRevision_To_v1alpha1_InstallationRevision(in *core.InstallationRevision, out *InstallationRevision, s conversion.Scope) error {
+	return autoConvert_core_InstallationRevision_To_v1alpha1_InstallationRevision(in, out, s)
+}
+
 func autoConvert_v1alpha1_InstallationSpec_To_core_InstallationSpec(in *InstallationSpec, out *core.InstallationSpec, s conversion.Scope) error {
 	out.Context = in.Context
 	out.ComponentDescriptor = (*core.ComponentDescriptorDefinition)(unsafe.Pointer(in.ComponentDescriptor))
@@ -2437,6 +2903,10 @@ func autoConvert_v1alpha1_InstallationSpec_To_core_InstallationSpec(in *Installa
 	}
 	out.ExportDataMappings = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.ExportDataMappings))
 	out.AutomaticReconcile = (*core.AutomaticReconcile)(unsafe.Pointer(in.AutomaticReconcile))
+	out.RollbackPolicy = (*core.RollbackPolicy)(unsafe.Pointer(in.RollbackPolicy))
+	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	out.MaintenanceWindow = (*core.MaintenanceWindow)(unsafe.Pointer(in.MaintenanceWindow))
+	out.Suspend = in.Suspend
 	return nil
 }
 
@@ -2461,6 +2931,10 @@ func autoConvert_core_InstallationSpec_To_v1alpha1_InstallationSpec(in *core.Ins
 	}
 	out.ExportDataMappings = *(*map[string]AnyJSON)(unsafe.Pointer(&in.ExportDataMappings))
 	out.AutomaticReconcile = (*AutomaticReconcile)(unsafe.Pointer(in.AutomaticReconcile))
+	out.RollbackPolicy = (*RollbackPolicy)(unsafe.Pointer(in.RollbackPolicy))
+	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	out.MaintenanceWindow = (*MaintenanceWindow)(unsafe.Pointer(in.MaintenanceWindow))
+	out.Suspend = in.Suspend
 	return nil
 }
 
@@ -2479,9 +2953,23 @@ func autoConvert_v1alpha1_InstallationStatus_To_core_InstallationStatus(in *Inst
 	out.ExecutionReference = (*core.ObjectReference)(unsafe.Pointer(in.ExecutionReference))
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
+	out.JobIDGenerationTime = (*metav1.Time)(unsafe.Pointer(in.JobIDGenerationTime))
 	out.InstallationPhase = core.InstallationPhase(in.InstallationPhase)
 	out.ImportsHash = in.ImportsHash
 	out.AutomaticReconcileStatus = (*core.AutomaticReconcileStatus)(unsafe.Pointer(in.AutomaticReconcileStatus))
+	out.Plan = (*core.InstallationPlan)(unsafe.Pointer(in.Plan))
+	if in.LastSucceededRevision != nil {
+		in, out := &in.LastSucceededRevision, &out.LastSucceededRevision
+		*out = new(core.InstallationRevision)
+		if err := Convert_v1alpha1_InstallationRevision_To_core_InstallationRevision(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.LastSucceededRevision = nil
+	}
+	out.Rollback = (*core.RollbackStatus)(unsafe.Pointer(in.Rollback))
+	out.LatestRevision = in.LatestRevision
+	out.DeferredUntil = (*metav1.Time)(unsafe.Pointer(in.DeferredUntil))
 	return nil
 }
 
@@ -2500,9 +2988,23 @@ func autoConvert_core_InstallationStatus_To_v1alpha1_InstallationStatus(in *core
 	out.ExecutionReference = (*ObjectReference)(unsafe.Pointer(in.ExecutionReference))
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
+	out.JobIDGenerationTime = (*metav1.Time)(unsafe.Pointer(in.JobIDGenerationTime))
 	out.InstallationPhase = InstallationPhase(in.InstallationPhase)
 	out.ImportsHash = in.ImportsHash
 	out.AutomaticReconcileStatus = (*AutomaticReconcileStatus)(unsafe.Pointer(in.AutomaticReconcileStatus))
+	out.Plan = (*InstallationPlan)(unsafe.Pointer(in.Plan))
+	if in.LastSucceededRevision != nil {
+		in, out := &in.LastSucceededRevision, &out.LastSucceededRevision
+		*out = new(InstallationRevision)
+		if err := Convert_core_InstallationRevision_To_v1alpha1_InstallationRevision(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.LastSucceededRevision = nil
+	}
+	out.Rollback = (*RollbackStatus)(unsafe.Pointer(in.Rollback))
+	out.LatestRevision = in.LatestRevision
+	out.DeferredUntil = (*metav1.Time)(unsafe.Pointer(in.DeferredUntil))
 	return nil
 }
 
@@ -2599,6 +3101,28 @@ func Convert_core_JSONSchemaDefinition_To_v1alpha1_JSONSchemaDefinition(in *core
 	return autoConvert_core_JSONSchemaDefinition_To_v1alpha1_JSONSchemaDefinition(in, out, s)
 }
 
+func autoConvert_v1alpha1_LocalConfigMapReference_To_core_LocalConfigMapReference(in *LocalConfigMapReference, out *core.LocalConfigMapReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_v1alpha1_LocalConfigMapReference_To_core_LocalConfigMapReference is an autogenerated conversion function.
+func Convert_v1alpha1_LocalConfigMapReference_To_core_LocalConfigMapReference(in *LocalConfigMapReference, out *core.LocalConfigMapReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LocalConfigMapReference_To_core_LocalConfigMapReference(in, out, s)
+}
+
+func autoConvert_core_LocalConfigMapReference_To_v1alpha1_LocalConfigMapReference(in *core.LocalConfigMapReference, out *LocalConfigMapReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_core_LocalConfigMapReference_To_v1alpha1_LocalConfigMapReference is an autogenerated conversion function.
+func Convert_core_LocalConfigMapReference_To_v1alpha1_LocalConfigMapReference(in *core.LocalConfigMapReference, out *LocalConfigMapReference, s conversion.Scope) error {
+	return autoConvert_core_LocalConfigMapReference_To_v1alpha1_LocalConfigMapReference(in, out, s)
+}
+
 func autoConvert_v1alpha1_LocalSecretReference_To_core_LocalSecretReference(in *LocalSecretReference, out *core.LocalSecretReference, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Key = in.Key
@@ -2669,6 +3193,30 @@ func Convert_core_LsHealthCheckList_To_v1alpha1_LsHealthCheckList(in *core.LsHea
 	return autoConvert_core_LsHealthCheckList_To_v1alpha1_LsHealthCheckList(in, out, s)
 }
 
+func autoConvert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow(in *MaintenanceWindow, out *core.MaintenanceWindow, s conversion.Scope) error {
+	out.Begin = in.Begin
+	out.End = in.End
+	out.Timezone = in.Timezone
+	return nil
+}
+
+// Convert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow is an autogenerated conversion function.
+func Convert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow(in *MaintenanceWindow, out *core.MaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow(in, out, s)
+}
+
+func autoConvert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(in *core.MaintenanceWindow, out *MaintenanceWindow, s conversion.Scope) error {
+	out.Begin = in.Begin
+	out.End = in.End
+	out.Timezone = in.Timezone
+	return nil
+}
+
+// Convert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow is an autogenerated conversion function.
+func Convert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(in *core.MaintenanceWindow, out *MaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(in, out, s)
+}
+
 func autoConvert_v1alpha1_NamedObjectReference_To_core_NamedObjectReference(in *NamedObjectReference, out *core.NamedObjectReference, s conversion.Scope) error {
 	out.Name = in.Name
 	if err := Convert_v1alpha1_ObjectReference_To_core_ObjectReference(&in.Reference, &out.Reference, s); err != nil {
@@ -2717,6 +3265,34 @@ func Convert_core_ObjectReference_To_v1alpha1_ObjectReference(in *core.ObjectRef
 	return autoConvert_core_ObjectReference_To_v1alpha1_ObjectReference(in, out, s)
 }
 
+func autoConvert_v1alpha1_PlannedChange_To_core_PlannedChange(in *PlannedChange, out *core.PlannedChange, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kind = in.Kind
+	out.Action = core.PlanAction(in.Action)
+	out.Reference = (*core.ObjectReference)(unsafe.Pointer(in.Reference))
+	out.ChangedFields = *(*[]string)(unsafe.Pointer(&in.ChangedFields))
+	return nil
+}
+
+// Convert_v1alpha1_PlannedChange_To_core_PlannedChange is an autogenerated conversion function.
+func Convert_v1alpha1_PlannedChange_To_core_PlannedChange(in *PlannedChange, out *core.PlannedChange, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PlannedChange_To_core_PlannedChange(in, out, s)
+}
+
+func autoConvert_core_PlannedChange_To_v1alpha1_PlannedChange(in *core.PlannedChange, out *PlannedChange, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kind = in.Kind
+	out.Action = PlanAction(in.Action)
+	out.Reference = (*ObjectReference)(unsafe.Pointer(in.Reference))
+	out.ChangedFields = *(*[]string)(unsafe.Pointer(&in.ChangedFields))
+	return nil
+}
+
+// Convert_core_PlannedChange_To_v1alpha1_PlannedChange is an autogenerated conversion function.
+func Convert_core_PlannedChange_To_v1alpha1_PlannedChange(in *core.PlannedChange, out *PlannedChange, s conversion.Scope) error {
+	return autoConvert_core_PlannedChange_To_v1alpha1_PlannedChange(in, out, s)
+}
+
 func autoConvert_v1alpha1_RemoteBlueprintReference_To_core_RemoteBlueprintReference(in *RemoteBlueprintReference, out *core.RemoteBlueprintReference, s conversion.Scope) error {
 	out.ResourceName = in.ResourceName
 	return nil
@@ -2805,6 +3381,130 @@ func Convert_core_ResourceReference_To_v1alpha1_ResourceReference(in *core.Resou
 	return autoConvert_core_ResourceReference_To_v1alpha1_ResourceReference(in, out, s)
 }
 
+func autoConvert_v1alpha1_RollbackPolicy_To_core_RollbackPolicy(in *RollbackPolicy, out *core.RollbackPolicy, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Timeout = (*core.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_RollbackPolicy_To_core_RollbackPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_RollbackPolicy_To_core_RollbackPolicy(in *RollbackPolicy, out *core.RollbackPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RollbackPolicy_To_core_RollbackPolicy(in, out, s)
+}
+
+func autoConvert_core_RollbackPolicy_To_v1alpha1_RollbackPolicy(in *core.RollbackPolicy, out *RollbackPolicy, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Timeout = (*Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_core_RollbackPolicy_To_v1alpha1_RollbackPolicy is an autogenerated conversion function.
+func Convert_core_RollbackPolicy_To_v1alpha1_RollbackPolicy(in *core.RollbackPolicy, out *RollbackPolicy, s conversion.Scope) error {
+	return autoConvert_core_RollbackPolicy_To_v1alpha1_RollbackPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_RollbackStatus_To_core_RollbackStatus(in *RollbackStatus, out *core.RollbackStatus, s conversion.Scope) error {
+	out.JobID = in.JobID
+	out.FailedJobID = in.FailedJobID
+	out.RevisionJobID = in.RevisionJobID
+	out.Revision = in.Revision
+	out.Reason = core.RollbackReason(in.Reason)
+	out.StartTime = in.StartTime
+	return nil
+}
+
+// Convert_v1alpha1_RollbackStatus_To_core_RollbackStatus is an autogenerated conversion function.
+func Convert_v1alpha1_RollbackStatus_To_core_RollbackStatus(in *RollbackStatus, out *core.RollbackStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RollbackStatus_To_core_RollbackStatus(in, out, s)
+}
+
+func autoConvert_core_RollbackStatus_To_v1alpha1_RollbackStatus(in *core.RollbackStatus, out *RollbackStatus, s conversion.Scope) error {
+	out.JobID = in.JobID
+	out.FailedJobID = in.FailedJobID
+	out.RevisionJobID = in.RevisionJobID
+	out.Revision = in.Revision
+	out.Reason = RollbackReason(in.Reason)
+	out.StartTime = in.StartTime
+	return nil
+}
+
+// Convert_core_RollbackStatus_To_v1alpha1_RollbackStatus is an autogenerated conversion function.
+func Convert_core_RollbackStatus_To_v1alpha1_RollbackStatus(in *core.RollbackStatus, out *RollbackStatus, s conversion.Scope) error {
+	return autoConvert_core_RollbackStatus_To_v1alpha1_RollbackStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutStatus_To_core_RolloutStatus(in *RolloutStatus, out *core.RolloutStatus, s conversion.Scope) error {
+	out.Paused = in.Paused
+	out.ContinuedFailedItems = *(*[]string)(unsafe.Pointer(&in.ContinuedFailedItems))
+	return nil
+}
+
+// Convert_v1alpha1_RolloutStatus_To_core_RolloutStatus is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutStatus_To_core_RolloutStatus(in *RolloutStatus, out *core.RolloutStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutStatus_To_core_RolloutStatus(in, out, s)
+}
+
+func autoConvert_core_RolloutStatus_To_v1alpha1_RolloutStatus(in *core.RolloutStatus, out *RolloutStatus, s conversion.Scope) error {
+	out.Paused = in.Paused
+	out.ContinuedFailedItems = *(*[]string)(unsafe.Pointer(&in.ContinuedFailedItems))
+	return nil
+}
+
+// Convert_core_RolloutStatus_To_v1alpha1_RolloutStatus is an autogenerated conversion function.
+func Convert_core_RolloutStatus_To_v1alpha1_RolloutStatus(in *core.RolloutStatus, out *RolloutStatus, s conversion.Scope) error {
+	return autoConvert_core_RolloutStatus_To_v1alpha1_RolloutStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutStrategy_To_core_RolloutStrategy(in *RolloutStrategy, out *core.RolloutStrategy, s conversion.Scope) error {
+	out.MaxInProgress = in.MaxInProgress
+	out.PauseOnFailure = in.PauseOnFailure
+	return nil
+}
+
+// Convert_v1alpha1_RolloutStrategy_To_core_RolloutStrategy is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutStrategy_To_core_RolloutStrategy(in *RolloutStrategy, out *core.RolloutStrategy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutStrategy_To_core_RolloutStrategy(in, out, s)
+}
+
+func autoConvert_core_RolloutStrategy_To_v1alpha1_RolloutStrategy(in *core.RolloutStrategy, out *RolloutStrategy, s conversion.Scope) error {
+	out.MaxInProgress = in.MaxInProgress
+	out.PauseOnFailure = in.PauseOnFailure
+	return nil
+}
+
+// Convert_core_RolloutStrategy_To_v1alpha1_RolloutStrategy is an autogenerated conversion function.
+func Convert_core_RolloutStrategy_To_v1alpha1_RolloutStrategy(in *core.RolloutStrategy, out *RolloutStrategy, s conversion.Scope) error {
+	return autoConvert_core_RolloutStrategy_To_v1alpha1_RolloutStrategy(in, out, s)
+}
+
+func autoConvert_v1alpha1_SecretExport_To_core_SecretExport(in *SecretExport, out *core.SecretExport, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1alpha1_LocalSecretReference_To_core_LocalSecretReference(&in.SecretRef, &out.SecretRef, s); err != nil {
+		return err
+	}
+	out.Transformation = (*core.ExportTransformation)(unsafe.Pointer(in.Transformation))
+	return nil
+}
+
+// Convert_v1alpha1_SecretExport_To_core_SecretExport is an autogenerated conversion function.
+func Convert_v1alpha1_SecretExport_To_core_SecretExport(in *SecretExport, out *core.SecretExport, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecretExport_To_core_SecretExport(in, out, s)
+}
+
+func autoConvert_core_SecretExport_To_v1alpha1_SecretExport(in *core.SecretExport, out *SecretExport, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_core_LocalSecretReference_To_v1alpha1_LocalSecretReference(&in.SecretRef, &out.SecretRef, s); err != nil {
+		return err
+	}
+	out.Transformation = (*ExportTransformation)(unsafe.Pointer(in.Transformation))
+	return nil
+}
+
+// Convert_core_SecretExport_To_v1alpha1_SecretExport is an autogenerated conversion function.
+func Convert_core_SecretExport_To_v1alpha1_SecretExport(in *core.SecretExport, out *SecretExport, s conversion.Scope) error {
+	return autoConvert_core_SecretExport_To_v1alpha1_SecretExport(in, out, s)
+}
+
 func autoConvert_v1alpha1_SecretLabelSelectorRef_To_core_SecretLabelSelectorRef(in *SecretLabelSelectorRef, out *core.SecretLabelSelectorRef, s conversion.Scope) error {
 	out.Selector = *(*map[string]string)(unsafe.Pointer(&in.Selector))
 	out.Key = in.Key
@@ -2901,6 +3601,32 @@ func Convert_core_StaticDataValueFrom_To_v1alpha1_StaticDataValueFrom(in *core.S
 	return autoConvert_core_StaticDataValueFrom_To_v1alpha1_StaticDataValueFrom(in, out, s)
 }
 
+func autoConvert_v1alpha1_SubinstallationRevision_To_core_SubinstallationRevision(in *SubinstallationRevision, out *core.SubinstallationRevision, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1alpha1_InstallationSpec_To_core_InstallationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_SubinstallationRevision_To_core_SubinstallationRevision is an autogenerated conversion function.
+func Convert_v1alpha1_SubinstallationRevision_To_core_SubinstallationRevision(in *SubinstallationRevision, out *core.SubinstallationRevision, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SubinstallationRevision_To_core_SubinstallationRevision(in, out, s)
+}
+
+func autoConvert_core_SubinstallationRevision_To_v1alpha1_SubinstallationRevision(in *core.SubinstallationRevision, out *SubinstallationRevision, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_core_InstallationSpec_To_v1alpha1_InstallationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_SubinstallationRevision_To_v1alpha1_SubinstallationRevision is an autogenerated conversion function.
+func Convert_core_SubinstallationRevision_To_v1alpha1_SubinstallationRevision(in *core.SubinstallationRevision, out *SubinstallationRevision, s conversion.Scope) error {
+	return autoConvert_core_SubinstallationRevision_To_v1alpha1_SubinstallationRevision(in, out, s)
+}
+
 func autoConvert_v1alpha1_SubinstallationTemplate_To_core_SubinstallationTemplate(in *SubinstallationTemplate, out *core.SubinstallationTemplate, s conversion.Scope) error {
 	out.File = in.File
 	out.InstallationTemplate = (*core.InstallationTemplate)(unsafe.Pointer(in.InstallationTemplate))
@@ -2974,6 +3700,9 @@ func autoConvert_v1alpha1_Target_To_core_Target(in *Target, out *core.Target, s
 	if err := Convert_v1alpha1_TargetSpec_To_core_TargetSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_TargetStatus_To_core_TargetStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2987,6 +3716,9 @@ func autoConvert_core_Target_To_v1alpha1_Target(in *core.Target, out *Target, s
 	if err := Convert_core_TargetSpec_To_v1alpha1_TargetSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_core_TargetStatus_To_v1alpha1_TargetStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3137,6 +3869,36 @@ func Convert_core_TargetSpec_To_v1alpha1_TargetSpec(in *core.TargetSpec, out *Ta
 	return autoConvert_core_TargetSpec_To_v1alpha1_TargetSpec(in, out, s)
 }
 
+func autoConvert_v1alpha1_TargetStatus_To_core_TargetStatus(in *TargetStatus, out *core.TargetStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Reachable = in.Reachable
+	out.ServerVersion = in.ServerVersion
+	out.CredentialExpirationTime = (*metav1.Time)(unsafe.Pointer(in.CredentialExpirationTime))
+	out.LastCheckTime = (*metav1.Time)(unsafe.Pointer(in.LastCheckTime))
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_TargetStatus_To_core_TargetStatus is an autogenerated conversion function.
+func Convert_v1alpha1_TargetStatus_To_core_TargetStatus(in *TargetStatus, out *core.TargetStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetStatus_To_core_TargetStatus(in, out, s)
+}
+
+func autoConvert_core_TargetStatus_To_v1alpha1_TargetStatus(in *core.TargetStatus, out *TargetStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Reachable = in.Reachable
+	out.ServerVersion = in.ServerVersion
+	out.CredentialExpirationTime = (*metav1.Time)(unsafe.Pointer(in.CredentialExpirationTime))
+	out.LastCheckTime = (*metav1.Time)(unsafe.Pointer(in.LastCheckTime))
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_core_TargetStatus_To_v1alpha1_TargetStatus is an autogenerated conversion function.
+func Convert_core_TargetStatus_To_v1alpha1_TargetStatus(in *core.TargetStatus, out *TargetStatus, s conversion.Scope) error {
+	return autoConvert_core_TargetStatus_To_v1alpha1_TargetStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_TargetSync_To_core_TargetSync(in *TargetSync, out *core.TargetSync, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_TargetSyncSpec_To_core_TargetSyncSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -142,6 +142,11 @@ func (in *Blueprint) DeepCopyInto(out *Blueprint) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RolloutStrategy != nil {
+		in, out := &in.RolloutStrategy, &out.RolloutStrategy
+		*out = new(RolloutStrategy)
+		**out = **in
+	}
 	if in.ExportExecutions != nil {
 		in, out := &in.ExportExecutions, &out.ExportExecutions
 		*out = make([]TemplateExecutor, len(*in))
@@ -446,6 +451,28 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapExport) DeepCopyInto(out *ConfigMapExport) {
+	*out = *in
+	out.ConfigMapRef = in.ConfigMapRef
+	if in.Transformation != nil {
+		in, out := &in.Transformation, &out.Transformation
+		*out = new(ExportTransformation)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapExport.
+func (in *ConfigMapExport) DeepCopy() *ConfigMapExport {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigMapReference) DeepCopyInto(out *ConfigMapReference) {
 	*out = *in
@@ -484,6 +511,11 @@ func (in *Context) DeepCopyInto(out *Context) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		**out = **in
+	}
 	return
 }
 
@@ -586,6 +618,11 @@ func (in *DataObject) DeepCopyInto(out *DataObject) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Data.DeepCopyInto(&out.Data)
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(LocalSecretReference)
+		**out = **in
+	}
 	return
 }
 
@@ -1243,6 +1280,11 @@ func (in *ExecutionSpec) DeepCopyInto(out *ExecutionSpec) {
 		*out = make([]ObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.RolloutStrategy != nil {
+		in, out := &in.RolloutStrategy, &out.RolloutStrategy
+		*out = new(RolloutStrategy)
+		**out = **in
+	}
 	return
 }
 
@@ -1286,6 +1328,15 @@ func (in *ExecutionStatus) DeepCopyInto(out *ExecutionStatus) {
 		*out = make([]ExecutionGeneration, len(*in))
 		copy(*out, *in)
 	}
+	if in.JobIDGenerationTime != nil {
+		in, out := &in.JobIDGenerationTime, &out.JobIDGenerationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1338,6 +1389,22 @@ func (in ExportDefinitionList) DeepCopy() ExportDefinitionList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExportTransformation) DeepCopyInto(out *ExportTransformation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportTransformation.
+func (in *ExportTransformation) DeepCopy() *ExportTransformation {
+	if in == nil {
+		return nil
+	}
+	out := new(ExportTransformation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FailedReconcile) DeepCopyInto(out *FailedReconcile) {
 	*out = *in
@@ -1437,6 +1504,124 @@ func (in ImportDefinitionList) DeepCopy() ImportDefinitionList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportGrant) DeepCopyInto(out *ImportGrant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportGrant.
+func (in *ImportGrant) DeepCopy() *ImportGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImportGrant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportGrantFrom) DeepCopyInto(out *ImportGrantFrom) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportGrantFrom.
+func (in *ImportGrantFrom) DeepCopy() *ImportGrantFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportGrantFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportGrantList) DeepCopyInto(out *ImportGrantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ImportGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportGrantList.
+func (in *ImportGrantList) DeepCopy() *ImportGrantList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportGrantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImportGrantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportGrantSpec) DeepCopyInto(out *ImportGrantSpec) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]ImportGrantFrom, len(*in))
+		copy(*out, *in)
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]ImportGrantTo, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportGrantSpec.
+func (in *ImportGrantSpec) DeepCopy() *ImportGrantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportGrantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportGrantTo) DeepCopyInto(out *ImportGrantTo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportGrantTo.
+func (in *ImportGrantTo) DeepCopy() *ImportGrantTo {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportGrantTo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImportStatus) DeepCopyInto(out *ImportStatus) {
 	*out = *in
@@ -1523,6 +1708,20 @@ func (in *InstallationExports) DeepCopyInto(out *InstallationExports) {
 		*out = make([]TargetExport, len(*in))
 		copy(*out, *in)
 	}
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]SecretExport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConfigMaps != nil {
+		in, out := &in.ConfigMaps, &out.ConfigMaps
+		*out = make([]ConfigMapExport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1599,6 +1798,91 @@ func (in *InstallationList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationPlan) DeepCopyInto(out *InstallationPlan) {
+	*out = *in
+	in.PlanTime.DeepCopyInto(&out.PlanTime)
+	if in.Imports != nil {
+		in, out := &in.Imports, &out.Imports
+		*out = make([]PlannedChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Subinstallations != nil {
+		in, out := &in.Subinstallations, &out.Subinstallations
+		*out = make([]PlannedChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DeployItems != nil {
+		in, out := &in.DeployItems, &out.DeployItems
+		*out = make([]PlannedChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Error != nil {
+		in, out := &in.Error, &out.Error
+		*out = new(Error)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationPlan.
+func (in *InstallationPlan) DeepCopy() *InstallationPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationRevision) DeepCopyInto(out *InstallationRevision) {
+	*out = *in
+	in.AppliedTime.DeepCopyInto(&out.AppliedTime)
+	if in.ComponentDescriptor != nil {
+		in, out := &in.ComponentDescriptor, &out.ComponentDescriptor
+		*out = new(ComponentDescriptorDefinition)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Blueprint.DeepCopyInto(&out.Blueprint)
+	if in.DeployItems != nil {
+		in, out := &in.DeployItems, &out.DeployItems
+		*out = make(DeployItemTemplateList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RolloutStrategy != nil {
+		in, out := &in.RolloutStrategy, &out.RolloutStrategy
+		*out = new(RolloutStrategy)
+		**out = **in
+	}
+	if in.Subinstallations != nil {
+		in, out := &in.Subinstallations, &out.Subinstallations
+		*out = make([]SubinstallationRevision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationRevision.
+func (in *InstallationRevision) DeepCopy() *InstallationRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 	*out = *in
@@ -1634,6 +1918,21 @@ func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 		*out = new(AutomaticReconcile)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RollbackPolicy != nil {
+		in, out := &in.RollbackPolicy, &out.RollbackPolicy
+		*out = new(RollbackPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		**out = **in
+	}
 	return
 }
 
@@ -1679,11 +1978,34 @@ func (in *InstallationStatus) DeepCopyInto(out *InstallationStatus) {
 		*out = new(ObjectReference)
 		**out = **in
 	}
+	if in.JobIDGenerationTime != nil {
+		in, out := &in.JobIDGenerationTime, &out.JobIDGenerationTime
+		*out = (*in).DeepCopy()
+	}
 	if in.AutomaticReconcileStatus != nil {
 		in, out := &in.AutomaticReconcileStatus, &out.AutomaticReconcileStatus
 		*out = new(AutomaticReconcileStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(InstallationPlan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastSucceededRevision != nil {
+		in, out := &in.LastSucceededRevision, &out.LastSucceededRevision
+		*out = new(InstallationRevision)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeferredUntil != nil {
+		in, out := &in.DeferredUntil, &out.DeferredUntil
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -1803,6 +2125,22 @@ func (in *JSONSchemaDefinition) DeepCopy() *JSONSchemaDefinition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalConfigMapReference) DeepCopyInto(out *LocalConfigMapReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalConfigMapReference.
+func (in *LocalConfigMapReference) DeepCopy() *LocalConfigMapReference {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalConfigMapReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalSecretReference) DeepCopyInto(out *LocalSecretReference) {
 	*out = *in
@@ -1879,6 +2217,22 @@ func (in *LsHealthCheckList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamedObjectReference) DeepCopyInto(out *NamedObjectReference) {
 	*out = *in
@@ -1912,6 +2266,32 @@ func (in *ObjectReference) DeepCopy() *ObjectReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlannedChange) DeepCopyInto(out *PlannedChange) {
+	*out = *in
+	if in.Reference != nil {
+		in, out := &in.Reference, &out.Reference
+		*out = new(ObjectReference)
+		**out = **in
+	}
+	if in.ChangedFields != nil {
+		in, out := &in.ChangedFields, &out.ChangedFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlannedChange.
+func (in *PlannedChange) DeepCopy() *PlannedChange {
+	if in == nil {
+		return nil
+	}
+	out := new(PlannedChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteBlueprintReference) DeepCopyInto(out *RemoteBlueprintReference) {
 	*out = *in
@@ -1986,6 +2366,103 @@ func (in *ResourceReference) DeepCopy() *ResourceReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollbackPolicy) DeepCopyInto(out *RollbackPolicy) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollbackPolicy.
+func (in *RollbackPolicy) DeepCopy() *RollbackPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RollbackPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollbackStatus) DeepCopyInto(out *RollbackStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollbackStatus.
+func (in *RollbackStatus) DeepCopy() *RollbackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RollbackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
+	*out = *in
+	if in.ContinuedFailedItems != nil {
+		in, out := &in.ContinuedFailedItems, &out.ContinuedFailedItems
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStrategy) DeepCopyInto(out *RolloutStrategy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStrategy.
+func (in *RolloutStrategy) DeepCopy() *RolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretExport) DeepCopyInto(out *SecretExport) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	if in.Transformation != nil {
+		in, out := &in.Transformation, &out.Transformation
+		*out = new(ExportTransformation)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretExport.
+func (in *SecretExport) DeepCopy() *SecretExport {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretLabelSelectorRef) DeepCopyInto(out *SecretLabelSelectorRef) {
 	*out = *in
@@ -2074,6 +2551,23 @@ func (in *StaticDataValueFrom) DeepCopy() *StaticDataValueFrom {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubinstallationRevision) DeepCopyInto(out *SubinstallationRevision) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubinstallationRevision.
+func (in *SubinstallationRevision) DeepCopy() *SubinstallationRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(SubinstallationRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubinstallationTemplate) DeepCopyInto(out *SubinstallationTemplate) {
 	*out = *in
@@ -2170,6 +2664,7 @@ func (in *Target) DeepCopyInto(out *Target) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -2343,6 +2838,30 @@ func (in *TargetSpec) DeepCopy() *TargetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetStatus) DeepCopyInto(out *TargetStatus) {
+	*out = *in
+	if in.CredentialExpirationTime != nil {
+		in, out := &in.CredentialExpirationTime, &out.CredentialExpirationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastCheckTime != nil {
+		in, out := &in.LastCheckTime, &out.LastCheckTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetStatus.
+func (in *TargetStatus) DeepCopy() *TargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetSync) DeepCopyInto(out *TargetSync) {
 	*out = *in
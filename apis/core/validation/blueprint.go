@@ -38,6 +38,9 @@ func ValidateBlueprint(blueprint *core.Blueprint) field.ErrorList {
 	allErrs = append(allErrs, ValidateTemplateExecutorList(field.NewPath("exportExecutions"), blueprint.ExportExecutions)...)
 	allErrs = append(allErrs, ValidateSubinstallations(field.NewPath("subinstallations"), blueprint.Subinstallations)...)
 	allErrs = append(allErrs, ValidateTemplateExecutorList(field.NewPath("subinstallationExecutions"), blueprint.SubinstallationExecutions)...)
+	if blueprint.RolloutStrategy != nil {
+		allErrs = append(allErrs, ValidateRolloutStrategy(field.NewPath("rolloutStrategy"), *blueprint.RolloutStrategy)...)
+	}
 	return allErrs
 }
 
@@ -139,6 +142,9 @@ func ValidateBlueprintExportDefinitions(fldPath *field.Path, exports []core.Expo
 			allErrs = append(allErrs, ValidateExactlyOneOf(defPath, exportDef, "Schema", "TargetType")...)
 		}
 
+		if exportDef.Sensitive && (exportDef.Type == core.ExportTypeTarget || (len(exportDef.Type) == 0 && len(exportDef.TargetType) != 0)) {
+			allErrs = append(allErrs, field.Forbidden(defPath.Child("sensitive"), "only data exports can be sensitive"))
+		}
 	}
 
 	return allErrs
@@ -438,6 +444,12 @@ func ValidateInstallationTemplate(fldPath *field.Path, template *core.Installati
 
 	allErrs = append(allErrs, ValidateInstallationTemplateImports(template.Imports, fldPath.Child("imports"))...)
 	allErrs = append(allErrs, ValidateInstallationExports(template.Exports, fldPath.Child("exports"))...)
+	if len(template.Exports.Secrets) != 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("exports").Child("secrets"), "secret exports are not allowed in a installation template"))
+	}
+	if len(template.Exports.ConfigMaps) != 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("exports").Child("configMaps"), "configMap exports are not allowed in a installation template"))
+	}
 
 	return allErrs
 }
@@ -182,6 +182,31 @@ var _ = Describe("Blueprint", func() {
 			Expect(allErrs).To(HaveLen(0))
 		})
 
+		It("should pass if a data export is sensitive", func() {
+			expDef := core.ExportDefinition{}
+			expDef.Name = "my-export"
+			expDef.Type = core.ExportTypeData
+			expDef.Schema = &core.JSONSchemaDefinition{}
+			expDef.Sensitive = true
+
+			allErrs := validation.ValidateBlueprintExportDefinitions(field.NewPath(""), []core.ExportDefinition{expDef})
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should fail if a target export is sensitive", func() {
+			expDef := core.ExportDefinition{}
+			expDef.Name = "my-export"
+			expDef.Type = core.ExportTypeTarget
+			expDef.TargetType = "test"
+			expDef.Sensitive = true
+
+			allErrs := validation.ValidateBlueprintExportDefinitions(field.NewPath("b"), []core.ExportDefinition{expDef})
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("b[0][my-export].sensitive"),
+			}))))
+		})
+
 		It("should fail if ExportDefinitions.name is empty", func() {
 			exportDefinition := core.ExportDefinition{}
 
@@ -279,6 +304,38 @@ var _ = Describe("Blueprint", func() {
 				"Field": Equal("b.blueprint"),
 			}))))
 		})
+
+		It("should fail if InstallationTemplate defines secret or configmap exports", func() {
+			installationTemplate := &core.InstallationTemplate{}
+			installationTemplate.Name = "myname"
+			installationTemplate.Blueprint = core.InstallationTemplateBlueprintDefinition{
+				Ref: "my-ref",
+			}
+			installationTemplate.Exports.Secrets = []core.SecretExport{
+				{
+					Name:      "a",
+					SecretRef: core.LocalSecretReference{Name: "my-secret", Key: "a"},
+				},
+			}
+			installationTemplate.Exports.ConfigMaps = []core.ConfigMapExport{
+				{
+					Name:         "a",
+					ConfigMapRef: core.LocalConfigMapReference{Name: "my-cm", Key: "a"},
+				},
+			}
+
+			allErrs := validation.ValidateInstallationTemplate(field.NewPath("b"), installationTemplate)
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("b.exports.secrets"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("b.exports.configMaps"),
+				})),
+			))
+		})
 	})
 
 	Context("Subinstallations", func() {
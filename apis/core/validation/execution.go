@@ -23,6 +23,18 @@ func ValidateExecution(execution *core.Execution) field.ErrorList {
 func ValidateExecutionSpec(fldpath *field.Path, spec core.ExecutionSpec) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, ValidateDeployItemTemplateList(fldpath.Child("deployItems"), spec.DeployItems)...)
+	if spec.RolloutStrategy != nil {
+		allErrs = append(allErrs, ValidateRolloutStrategy(fldpath.Child("rolloutStrategy"), *spec.RolloutStrategy)...)
+	}
+	return allErrs
+}
+
+// ValidateRolloutStrategy validates the rollout strategy of deploy items.
+func ValidateRolloutStrategy(fldPath *field.Path, strategy core.RolloutStrategy) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if strategy.MaxInProgress < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxInProgress"), strategy.MaxInProgress, "must not be negative"))
+	}
 	return allErrs
 }
 
@@ -173,5 +185,9 @@ func ValidateDeployItemTemplate(fldPath *field.Path, tmpl core.DeployItemTemplat
 		allErrs = append(allErrs, metav1validation.ValidateLabels(tmpl.Labels, fldPath.Child("labels"))...)
 	}
 
+	if tmpl.Wave < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("wave"), tmpl.Wave, "must not be negative"))
+	}
+
 	return allErrs
 }
@@ -45,6 +45,38 @@ var _ = Describe("Execution", func() {
 				"Field": Equal("b.type"),
 			}))))
 		})
+
+		It("should fail if DeployItemTemplate.wave is negative", func() {
+			tmpl := core.DeployItemTemplate{}
+			tmpl.Name = "my-import"
+			tmpl.Type = "mytype"
+			tmpl.Wave = -1
+
+			allErrs := validation.ValidateDeployItemTemplate(field.NewPath("b"), tmpl)
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("b.wave"),
+			}))))
+		})
+	})
+
+	Context("ValidateRolloutStrategy", func() {
+		It("should pass if a RolloutStrategy is valid", func() {
+			strategy := core.RolloutStrategy{MaxInProgress: 2, PauseOnFailure: true}
+
+			allErrs := validation.ValidateRolloutStrategy(field.NewPath(""), strategy)
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should fail if RolloutStrategy.maxInProgress is negative", func() {
+			strategy := core.RolloutStrategy{MaxInProgress: -1}
+
+			allErrs := validation.ValidateRolloutStrategy(field.NewPath("r"), strategy)
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("r.maxInProgress"),
+			}))))
+		})
 	})
 
 	Context("ValidateDeployItemTemplateList", func() {
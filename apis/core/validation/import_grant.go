@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+)
+
+var supportedImportGrantKinds = []string{
+	string(core.DataObjectImportGrantKind),
+	string(core.TargetImportGrantKind),
+}
+
+// ValidateImportGrant validates an ImportGrant
+func ValidateImportGrant(grant *core.ImportGrant) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, ValidateImportGrantSpec(&grant.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateImportGrantSpec validates the spec of an ImportGrant
+func ValidateImportGrantSpec(spec *core.ImportGrantSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.From) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("from"), "at least one namespace must be granted"))
+	}
+	for i, from := range spec.From {
+		fromPath := fldPath.Child("from").Index(i)
+		if len(from.Namespace) == 0 {
+			allErrs = append(allErrs, field.Required(fromPath.Child("namespace"), "namespace must not be empty"))
+			continue
+		}
+		for _, msg := range apivalidation.ValidateNamespaceName(from.Namespace, false) {
+			allErrs = append(allErrs, field.Invalid(fromPath.Child("namespace"), from.Namespace, msg))
+		}
+	}
+
+	if len(spec.To) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("to"), "at least one object must be granted"))
+	}
+	for i, to := range spec.To {
+		toPath := fldPath.Child("to").Index(i)
+		if to.Kind != core.DataObjectImportGrantKind && to.Kind != core.TargetImportGrantKind {
+			allErrs = append(allErrs, field.NotSupported(toPath.Child("kind"), to.Kind, supportedImportGrantKinds))
+		}
+		if len(to.Name) != 0 {
+			for _, msg := range apivalidation.NameIsDNSSubdomain(to.Name, false) {
+				allErrs = append(allErrs, field.Invalid(toPath.Child("name"), to.Name, msg))
+			}
+		}
+	}
+
+	return allErrs
+}
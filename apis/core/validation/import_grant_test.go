@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/apis/core/validation"
+)
+
+var _ = Describe("ImportGrant", func() {
+	Context("Spec", func() {
+
+		It("should accept a valid ImportGrant", func() {
+			grant := &core.ImportGrant{
+				Spec: core.ImportGrantSpec{
+					From: []core.ImportGrantFrom{{Namespace: "tenant-a"}},
+					To: []core.ImportGrantTo{
+						{Kind: core.DataObjectImportGrantKind, Name: "ingress-domain"},
+						{Kind: core.TargetImportGrantKind},
+					},
+				},
+			}
+
+			allErrs := validation.ValidateImportGrant(grant)
+			Expect(allErrs).To(BeEmpty())
+		})
+
+		It("should reject an ImportGrant without namespaces and objects", func() {
+			grant := &core.ImportGrant{}
+
+			allErrs := validation.ValidateImportGrant(grant)
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.from"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.to"),
+				})),
+			))
+		})
+
+		It("should reject invalid namespaces, kinds and names", func() {
+			grant := &core.ImportGrant{
+				Spec: core.ImportGrantSpec{
+					From: []core.ImportGrantFrom{{}, {Namespace: "Tenant_A"}},
+					To: []core.ImportGrantTo{
+						{Kind: "Installation"},
+						{Kind: core.TargetImportGrantKind, Name: "my/target"},
+					},
+				},
+			}
+
+			allErrs := validation.ValidateImportGrant(grant)
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.from[0].namespace"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.from[1].namespace"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.to[0].kind"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.to[1].name"),
+				})),
+			))
+		})
+	})
+})
@@ -5,8 +5,12 @@
 package validation
 
 import (
+	"time"
+
+	cron "github.com/robfig/cron/v3"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -27,9 +31,26 @@ func ValidateInstallation(inst *core.Installation) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, validateInstallationObjectMeta(&inst.ObjectMeta, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, ValidateInstallationSpec(&inst.Spec, field.NewPath("spec"))...)
+
+	// subinstallations are processed by the jobs of their root installation, so only root installations are deferred
+	if inst.Spec.MaintenanceWindow != nil && isSubinstallation(&inst.ObjectMeta) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "maintenanceWindow"),
+			"maintenance windows are only supported for root installations"))
+	}
 	return allErrs
 }
 
+// isSubinstallation returns true if the installation is owned by another installation.
+func isSubinstallation(objMeta *metav1.ObjectMeta) bool {
+	for _, ref := range objMeta.OwnerReferences {
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err == nil && gv.Group == core.GroupName && ref.Kind == "Installation" {
+			return true
+		}
+	}
+	return false
+}
+
 func validateInstallationObjectMeta(objMeta *metav1.ObjectMeta, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -58,6 +79,54 @@ func ValidateInstallationSpec(spec *core.InstallationSpec, fldPath *field.Path)
 	// check RegistryPullSecrets
 	allErrs = append(allErrs, ValidateObjectReferenceList(spec.RegistryPullSecrets, fldPath.Child("registryPullSecrets"))...)
 
+	if spec.RevisionHistoryLimit != nil && *spec.RevisionHistoryLimit < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("revisionHistoryLimit"), *spec.RevisionHistoryLimit, "must not be negative"))
+	}
+
+	if spec.RollbackPolicy != nil {
+		allErrs = append(allErrs, ValidateRollbackPolicy(*spec.RollbackPolicy, fldPath.Child("rollbackPolicy"))...)
+	}
+
+	if spec.MaintenanceWindow != nil {
+		allErrs = append(allErrs, ValidateMaintenanceWindow(*spec.MaintenanceWindow, fldPath.Child("maintenanceWindow"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateRollbackPolicy validates the rollback policy of an Installation
+func ValidateRollbackPolicy(policy core.RollbackPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if policy.Timeout != nil && policy.Timeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), policy.Timeout.Duration.String(), "must be a positive duration"))
+	}
+
+	return allErrs
+}
+
+// ValidateMaintenanceWindow validates the maintenance window of an Installation or Context
+func ValidateMaintenanceWindow(window core.MaintenanceWindow, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(window.Begin) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("begin"), "must not be empty"))
+	} else if _, err := cron.ParseStandard(window.Begin); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("begin"), window.Begin, err.Error()))
+	}
+
+	if len(window.End) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("end"), "must not be empty"))
+	} else if _, err := cron.ParseStandard(window.End); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("end"), window.End, err.Error()))
+	}
+
+	if len(window.Timezone) != 0 {
+		if _, err := time.LoadLocation(window.Timezone); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timezone"), window.Timezone, err.Error()))
+		}
+	}
+
 	return allErrs
 }
 
@@ -159,6 +228,8 @@ func ValidateInstallationExports(exports core.InstallationExports, fldPath *fiel
 
 	allErrs = append(allErrs, ValidateInstallationDataExports(exports.Data, fldPath.Child("data"))...)
 	allErrs = append(allErrs, ValidateInstallationTargetExports(exports.Targets, fldPath.Child("targets"))...)
+	allErrs = append(allErrs, ValidateInstallationSecretExports(exports.Secrets, fldPath.Child("secrets"))...)
+	allErrs = append(allErrs, ValidateInstallationConfigMapExports(exports.ConfigMaps, fldPath.Child("configMaps"))...)
 
 	return allErrs
 }
@@ -207,6 +278,92 @@ func ValidateInstallationTargetExports(exports []core.TargetExport, fldPath *fie
 	return allErrs
 }
 
+// ValidateInstallationSecretExports validates the secret exports of an Installation
+func ValidateInstallationSecretExports(exports []core.SecretExport, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	exportNames := sets.NewString()
+	secretKeys := sets.NewString()
+	for idx, exp := range exports {
+		expPath := fldPath.Index(idx)
+		allErrs = append(allErrs, validateResourceExportReference(exp.SecretRef.Name, exp.SecretRef.Key, expPath.Child("secretRef"), secretKeys)...)
+		if exp.Transformation != nil {
+			allErrs = append(allErrs, ValidateExportTransformation(*exp.Transformation, expPath.Child("transformation"))...)
+		}
+		if exp.Name == "" {
+			allErrs = append(allErrs, field.Required(expPath.Child("name"), "name must not be empty"))
+			continue
+		}
+		if exportNames.Has(exp.Name) {
+			allErrs = append(allErrs, field.Duplicate(expPath, exp.Name))
+		}
+		exportNames.Insert(exp.Name)
+	}
+
+	return allErrs
+}
+
+// ValidateInstallationConfigMapExports validates the configmap exports of an Installation
+func ValidateInstallationConfigMapExports(exports []core.ConfigMapExport, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	exportNames := sets.NewString()
+	configMapKeys := sets.NewString()
+	for idx, exp := range exports {
+		expPath := fldPath.Index(idx)
+		allErrs = append(allErrs, validateResourceExportReference(exp.ConfigMapRef.Name, exp.ConfigMapRef.Key, expPath.Child("configMapRef"), configMapKeys)...)
+		if exp.Transformation != nil {
+			allErrs = append(allErrs, ValidateExportTransformation(*exp.Transformation, expPath.Child("transformation"))...)
+		}
+		if exp.Name == "" {
+			allErrs = append(allErrs, field.Required(expPath.Child("name"), "name must not be empty"))
+			continue
+		}
+		if exportNames.Has(exp.Name) {
+			allErrs = append(allErrs, field.Duplicate(expPath, exp.Name))
+		}
+		exportNames.Insert(exp.Name)
+	}
+
+	return allErrs
+}
+
+// validateResourceExportReference validates the name and key of a secret or configmap an export is written to.
+// A key of a secret or configmap must only be written by one export.
+func validateResourceExportReference(name, key string, fldPath *field.Path, usedKeys sets.String) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "name must not be empty"))
+	} else {
+		for _, msg := range apivalidation.NameIsDNSSubdomain(name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), name, msg))
+		}
+	}
+	if key == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("key"), "key must not be empty"))
+	} else {
+		for _, msg := range validation.IsConfigMapKey(key) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("key"), key, msg))
+		}
+	}
+	if name == "" || key == "" {
+		return allErrs
+	}
+
+	ref := name + "#" + key
+	if usedKeys.Has(ref) {
+		allErrs = append(allErrs, field.Duplicate(fldPath, ref))
+	}
+	usedKeys.Insert(ref)
+	return allErrs
+}
+
+// ValidateExportTransformation validates the transformation of a secret or configmap export
+func ValidateExportTransformation(transformation core.ExportTransformation, fldPath *field.Path) field.ErrorList {
+	return ValidateExactlyOneOf(fldPath, transformation, "JSONPath", "Template")
+}
+
 // ValidateObjectReference validates that the object reference is valid
 func ValidateObjectReference(or core.ObjectReference, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -5,9 +5,12 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
@@ -204,6 +207,112 @@ var _ = Describe("Installation", func() {
 		})
 	})
 
+	Context("RollbackPolicy", func() {
+		It("should accept a rollback policy without timeout", func() {
+			policy := core.RollbackPolicy{Enabled: true}
+
+			allErrs := validation.ValidateRollbackPolicy(policy, field.NewPath("rollbackPolicy"))
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should accept a rollback policy with a positive timeout", func() {
+			policy := core.RollbackPolicy{
+				Enabled: true,
+				Timeout: &core.Duration{Duration: 10 * time.Minute},
+			}
+
+			allErrs := validation.ValidateRollbackPolicy(policy, field.NewPath("rollbackPolicy"))
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should reject a rollback policy with a non-positive timeout", func() {
+			policy := core.RollbackPolicy{
+				Enabled: true,
+				Timeout: &core.Duration{Duration: 0},
+			}
+
+			allErrs := validation.ValidateRollbackPolicy(policy, field.NewPath("rollbackPolicy"))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("rollbackPolicy.timeout"),
+			}))))
+		})
+	})
+
+	Context("MaintenanceWindow", func() {
+		It("should accept a valid maintenance window", func() {
+			window := core.MaintenanceWindow{
+				Begin:    "0 22 * * *",
+				End:      "0 4 * * *",
+				Timezone: "Europe/Berlin",
+			}
+
+			allErrs := validation.ValidateMaintenanceWindow(window, field.NewPath("maintenanceWindow"))
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should reject a maintenance window without begin and end", func() {
+			allErrs := validation.ValidateMaintenanceWindow(core.MaintenanceWindow{}, field.NewPath("maintenanceWindow"))
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("maintenanceWindow.begin"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("maintenanceWindow.end"),
+				})),
+			))
+		})
+
+		It("should reject a maintenance window of a subinstallation", func() {
+			inst := &core.Installation{}
+			inst.Name = "sub"
+			inst.Namespace = "default"
+			inst.Spec.MaintenanceWindow = &core.MaintenanceWindow{
+				Begin: "0 22 * * *",
+				End:   "0 4 * * *",
+			}
+			Expect(validation.ValidateInstallation(inst)).ToNot(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Field": Equal("spec.maintenanceWindow"),
+			}))))
+
+			inst.OwnerReferences = []metav1.OwnerReference{{
+				APIVersion: "landscaper.gardener.cloud/v1alpha1",
+				Kind:       "Installation",
+				Name:       "root",
+			}}
+			Expect(validation.ValidateInstallation(inst)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.maintenanceWindow"),
+			}))))
+		})
+
+		It("should reject invalid schedules and an unknown timezone", func() {
+			window := core.MaintenanceWindow{
+				Begin:    "0 22 * *",
+				End:      "every night",
+				Timezone: "Mars/Olympus",
+			}
+
+			allErrs := validation.ValidateMaintenanceWindow(window, field.NewPath("maintenanceWindow"))
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("maintenanceWindow.begin"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("maintenanceWindow.end"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("maintenanceWindow.timezone"),
+				})),
+			))
+		})
+	})
+
 	Context("InstallationImports", func() {
 		It("should pass if imports are valid", func() {
 			imp := core.InstallationImports{
@@ -471,4 +580,115 @@ var _ = Describe("Installation", func() {
 			}))))
 		})
 	})
+
+	Context("InstallationExports", func() {
+		It("should pass if secret and configmap exports are valid", func() {
+			exp := core.InstallationExports{
+				Secrets: []core.SecretExport{
+					{
+						Name:      "a",
+						SecretRef: core.LocalSecretReference{Name: "my-secret", Key: "a"},
+					},
+					{
+						Name:           "b",
+						SecretRef:      core.LocalSecretReference{Name: "my-secret", Key: "b"},
+						Transformation: &core.ExportTransformation{JSONPath: ".host"},
+					},
+				},
+				ConfigMaps: []core.ConfigMapExport{
+					{
+						Name:           "a",
+						ConfigMapRef:   core.LocalConfigMapReference{Name: "my-cm", Key: "a.yaml"},
+						Transformation: &core.ExportTransformation{Template: "{{ .host }}"},
+					},
+				},
+			}
+
+			allErrs := validation.ValidateInstallationExports(exp, field.NewPath("exports"))
+			Expect(allErrs).To(BeEmpty())
+		})
+
+		It("should fail if secret and configmap exports contain empty values", func() {
+			exp := core.InstallationExports{
+				Secrets:    []core.SecretExport{{}},
+				ConfigMaps: []core.ConfigMapExport{{}},
+			}
+
+			allErrs := validation.ValidateInstallationExports(exp, field.NewPath("exports"))
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("exports.secrets[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("exports.secrets[0].secretRef.name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("exports.secrets[0].secretRef.key"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("exports.configMaps[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("exports.configMaps[0].configMapRef.name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("exports.configMaps[0].configMapRef.key"),
+				})),
+			))
+		})
+
+		It("should fail if a key of a secret is written by multiple exports", func() {
+			exp := core.InstallationExports{
+				Secrets: []core.SecretExport{
+					{
+						Name:      "a",
+						SecretRef: core.LocalSecretReference{Name: "my-secret", Key: "a"},
+					},
+					{
+						Name:      "b",
+						SecretRef: core.LocalSecretReference{Name: "my-secret", Key: "a"},
+					},
+				},
+			}
+
+			allErrs := validation.ValidateInstallationExports(exp, field.NewPath("exports"))
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("exports.secrets[1].secretRef"),
+			}))))
+		})
+
+		It("should fail if an invalid key or transformation is defined", func() {
+			exp := core.InstallationExports{
+				ConfigMaps: []core.ConfigMapExport{
+					{
+						Name:         "a",
+						ConfigMapRef: core.LocalConfigMapReference{Name: "my-cm", Key: "a/b"},
+						Transformation: &core.ExportTransformation{
+							JSONPath: ".host",
+							Template: "{{ .host }}",
+						},
+					},
+				},
+			}
+
+			allErrs := validation.ValidateInstallationExports(exp, field.NewPath("exports"))
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("exports.configMaps[0].configMapRef.key"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("exports.configMaps[0].transformation"),
+				})),
+			))
+		})
+	})
 })
@@ -30,6 +30,9 @@ type Configuration struct {
 
 	// TargetSelector describes all selectors the deployer should depend on.
 	TargetSelector []lsv1alpha1.TargetSelector `json:"targetSelector,omitempty"`
+	// FailOnUnhealthyTarget lets deploy items fail fast with a recoverable error, if the target health check
+	// of the landscaper has recently found their target unreachable.
+	FailOnUnhealthyTarget bool `json:"failOnUnhealthyTarget,omitempty"`
 
 	// Namespace defines the namespace where the pods should be executed.
 	Namespace string `json:"namespace"`
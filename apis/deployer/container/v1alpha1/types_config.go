@@ -35,6 +35,9 @@ type Configuration struct {
 
 	// TargetSelector describes all selectors the deployer should depend on.
 	TargetSelector []lsv1alpha1.TargetSelector `json:"targetSelector,omitempty"`
+	// FailOnUnhealthyTarget lets deploy items fail fast with a recoverable error, if the target health check
+	// of the landscaper has recently found their target unreachable.
+	FailOnUnhealthyTarget bool `json:"failOnUnhealthyTarget,omitempty"`
 
 	// DefaultImage configures the default images that is used if the DeployItem
 	// does not specify one.
@@ -33,6 +33,9 @@ type Configuration struct {
 	OCI *config.OCIConfiguration `json:"oci,omitempty"`
 	// TargetSelector describes all selectors the deployer should depend on.
 	TargetSelector []lsv1alpha1.TargetSelector `json:"targetSelector,omitempty"`
+	// FailOnUnhealthyTarget lets deploy items fail fast with a recoverable error, if the target health check
+	// of the landscaper has recently found their target unreachable.
+	FailOnUnhealthyTarget bool `json:"failOnUnhealthyTarget,omitempty"`
 	// Export defines the export configuration.
 	Export ExportConfiguration `json:"export,omitempty"`
 	// Controller contains configuration concerning the controller framework.
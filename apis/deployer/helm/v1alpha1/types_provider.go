@@ -34,6 +34,11 @@ type ProviderConfiguration struct {
 	// +optional
 	UpdateStrategy UpdateStrategy `json:"updateStrategy,omitempty"`
 
+	// ServerSideApply configures the server-side apply of the manifests.
+	// Only relevant if the update strategy is "serverSideApply".
+	// +optional
+	ServerSideApply *managedresource.ServerSideApplyConfiguration `json:"serverSideApply,omitempty"`
+
 	// ReadinessChecks configures the readiness checks.
 	// +optional
 	ReadinessChecks health.ReadinessCheckConfiguration `json:"readinessChecks,omitempty"`
@@ -72,6 +77,14 @@ type ProviderConfiguration struct {
 	// +optional
 	ContinuousReconcile *cr.ContinuousReconcileSpec `json:"continuousReconcile,omitempty"`
 
+	// DriftDetection configures the periodic detection of managed resources that differ from their last applied manifests.
+	// +optional
+	DriftDetection *managedresource.DriftDetectionSpec `json:"driftDetection,omitempty"`
+
+	// PostRenderer modifies the rendered manifests of the chart before they are deployed.
+	// +optional
+	PostRenderer *PostRenderer `json:"postRenderer,omitempty"`
+
 	// HelmDeployment indicates that helm is used as complete deployment mechanism and not only helm templating.
 	// Default is true.
 	// +optional
@@ -88,11 +101,74 @@ type UpdateStrategy string
 const (
 	UpdateStrategyUpdate UpdateStrategy = "update"
 	UpdateStrategyPatch  UpdateStrategy = "patch"
+	// UpdateStrategyServerSideApply is only supported if helmDeployment is false.
+	UpdateStrategyServerSideApply UpdateStrategy = "serverSideApply"
 )
 
+// PostRendererManifestsFile is the file that contains the rendered manifests of the chart
+// in the kustomization of a kustomize post-renderer.
+const PostRendererManifestsFile = "helm-output.yaml"
+
+// PostRenderer modifies the rendered manifests of a chart, e.g. to add labels or to rewrite image registries.
+// The patches are applied before the kustomize overlay.
+type PostRenderer struct {
+	// Patches are strategic merge patches or JSON6902 patches that are applied to the rendered manifests.
+	// +optional
+	Patches []PostRendererPatch `json:"patches,omitempty"`
+	// Kustomize is a kustomize overlay that is applied to the rendered manifests.
+	// +optional
+	Kustomize *KustomizePostRenderer `json:"kustomize,omitempty"`
+}
+
+// PostRendererPatch is a patch of the rendered manifests.
+type PostRendererPatch struct {
+	// Patch is a strategic merge patch or a JSON6902 patch in yaml or json format.
+	Patch string `json:"patch"`
+	// Target selects the resources that are patched.
+	// A strategic merge patch without target is applied to the resource with the kind and name of the patch,
+	// a JSON6902 patch requires a target.
+	// +optional
+	Target *PatchTarget `json:"target,omitempty"`
+}
+
+// PatchTarget selects the resources of a patch.
+// Group, version, kind, name and namespace are regular expressions; empty fields match all resources.
+type PatchTarget struct {
+	// Group is the api group of the resources.
+	// +optional
+	Group string `json:"group,omitempty"`
+	// Version is the api version of the resources.
+	// +optional
+	Version string `json:"version,omitempty"`
+	// Kind is the kind of the resources.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// Name is the name of the resources.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Namespace is the namespace of the resources.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// LabelSelector selects the resources by their labels.
+	// +optional
+	LabelSelector string `json:"labelSelector,omitempty"`
+	// AnnotationSelector selects the resources by their annotations.
+	// +optional
+	AnnotationSelector string `json:"annotationSelector,omitempty"`
+}
+
+// KustomizePostRenderer is a kustomize overlay for the rendered manifests.
+type KustomizePostRenderer struct {
+	// Files contains the files of the overlay as map of their relative paths to their content.
+	// The root of the files must contain a kustomization that lists the rendered manifests "helm-output.yaml" as resource.
+	Files map[string]string `json:"files"`
+}
+
 // Chart defines the helm chart to render and apply.
 type Chart struct {
 	// Ref defines the reference to a helm chart in a oci repository.
+	// References with the "oci://" scheme are resolved with helm's registry client,
+	// the latest semver tag is used if such a reference has no tag.
 	// +optional
 	Ref string `json:"ref,omitempty"`
 	// FromResource fetches the chart based on the resource's access method.
@@ -110,9 +186,19 @@ type Chart struct {
 
 // HelmChartRepo defines a reference to a chart in a helm chart repo
 type HelmChartRepo struct {
+	// HelmChartRepoUrl is the url of the helm chart repo.
+	// Urls with the "oci://" scheme refer to a location in an oci registry that contains the chart repository.
 	HelmChartRepoUrl string `json:"helmChartRepoUrl,omitempty"`
 	HelmChartName    string `json:"helmChartName,omitempty"`
+	// HelmChartVersion is the version of the chart.
+	// It may also be a semver constraint like "~1.4" that is resolved against the versions of the repository index
+	// or, for oci registries, against the tags of the chart.
+	// The resolved version is recorded in the provider status.
 	HelmChartVersion string `json:"helmChartVersion,omitempty"`
+	// PinVersion keeps the chart version that was resolved for a version constraint until the next spec change of the deploy item.
+	// Otherwise the constraint is resolved again on every reconcile, which upgrades the release to newer matching versions.
+	// +optional
+	PinVersion bool `json:"pinVersion,omitempty"`
 }
 
 // RemoteChartReference defines a reference to a remote Helm chart through a Component-Descriptor
@@ -144,6 +230,7 @@ type HelmDeploymentConfiguration struct {
 	Install   map[string]lsv1alpha1.AnyJSON `json:"install,omitempty"`
 	Upgrade   map[string]lsv1alpha1.AnyJSON `json:"upgrade,omitempty"`
 	Uninstall map[string]lsv1alpha1.AnyJSON `json:"uninstall,omitempty"`
+	Test      map[string]lsv1alpha1.AnyJSON `json:"test,omitempty"`
 }
 
 // HelmInstallConfiguration defines settings for a helm install operation.
@@ -157,6 +244,18 @@ type HelmInstallConfiguration struct {
 // HelmUpgradeConfiguration defines settings for a helm upgrade operation.
 type HelmUpgradeConfiguration = HelmInstallConfiguration
 
+// HelmTestConfiguration defines settings for the helm tests
+// that are run after a successful install or upgrade of the release.
+type HelmTestConfiguration struct {
+	// Enabled configures whether the helm tests of the chart are run.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// Timeout is the time to wait for the completion of the tests.
+	// Defaults to 5 minutes.
+	// +optional
+	Timeout *lsv1alpha1.Duration `json:"timeout,omitempty"`
+}
+
 // HelmUninstallConfiguration defines settings for a helm uninstall operation.
 type HelmUninstallConfiguration struct {
 	// Timeout is the timeout for the operation in minutes.
@@ -172,6 +271,90 @@ type ProviderStatus struct {
 
 	// ManagedResources contains all kubernetes resources that are deployed by the helm deployer.
 	ManagedResources managedresource.ManagedResourceStatusList `json:"managedResources,omitempty"`
+
+	// Drift contains the result of the last drift detection.
+	// +optional
+	Drift *managedresource.DriftStatus `json:"drift,omitempty"`
+
+	// Hooks contains the last executions of the hooks of the helm release including the helm tests.
+	// Only set if helm is used as deployment mechanism.
+	// +optional
+	Hooks []HookStatus `json:"hooks,omitempty"`
+
+	// ChartVersion contains the chart version that was resolved for the helm chart repo of the last reconcile.
+	// +optional
+	ChartVersion *ResolvedChartVersion `json:"chartVersion,omitempty"`
+
+	// Release describes the helm release and its revision history.
+	// Only set if helm is used as deployment mechanism.
+	// +optional
+	Release *ReleaseStatus `json:"release,omitempty"`
+}
+
+// ReleaseStatus describes a helm release.
+type ReleaseStatus struct {
+	// Name is the name of the release.
+	Name string `json:"name"`
+	// Namespace is the namespace of the release.
+	Namespace string `json:"namespace"`
+	// Revision is the current revision of the release.
+	Revision int64 `json:"revision"`
+	// Status is the status of the current revision, e.g. "deployed" or "failed".
+	Status string `json:"status"`
+	// ChartVersion is the version of the chart of the current revision.
+	ChartVersion string `json:"chartVersion"`
+	// History contains the revisions of the release, ordered from the newest to the oldest.
+	// +optional
+	History []ReleaseRevision `json:"history,omitempty"`
+}
+
+// ReleaseRevision describes a revision of a helm release.
+type ReleaseRevision struct {
+	// Revision is the number of the revision.
+	Revision int64 `json:"revision"`
+	// Status is the status of the revision, e.g. "deployed", "superseded" or "failed".
+	Status string `json:"status"`
+	// ChartVersion is the version of the chart of the revision.
+	ChartVersion string `json:"chartVersion"`
+	// AppVersion is the app version of the chart of the revision.
+	// +optional
+	AppVersion string `json:"appVersion,omitempty"`
+	// Updated is the time when the revision was deployed.
+	// +optional
+	Updated *metav1.Time `json:"updated,omitempty"`
+	// Description is the description of the revision, e.g. "Rollback to 2".
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// ResolvedChartVersion describes the chart version that was resolved for the version of a helm chart repo.
+type ResolvedChartVersion struct {
+	// Constraint is the version or version constraint of the helm chart repo.
+	Constraint string `json:"constraint"`
+	// Version is the resolved version of the chart.
+	Version string `json:"version"`
+	// ObservedGeneration is the generation of the deploy item for which the version was resolved.
+	ObservedGeneration int64 `json:"observedGeneration"`
+}
+
+// HookStatus describes the last execution of a hook of a helm release.
+type HookStatus struct {
+	// Name is the name of the hook resource.
+	Name string `json:"name"`
+	// Kind is the kind of the hook resource.
+	Kind string `json:"kind"`
+	// Events are the events that trigger the hook, e.g. "post-install" or "test".
+	Events []string `json:"events,omitempty"`
+	// Phase is the phase of the last execution of the hook.
+	// One of "Running", "Succeeded", "Failed" or "Unknown"; empty if the hook has not been executed.
+	// +optional
+	Phase string `json:"phase,omitempty"`
+	// StartedAt is the time when the last execution of the hook started.
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+	// CompletedAt is the time when the last execution of the hook completed.
+	// +optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
 }
 
 // HelmChartRepoCredentials contains the credentials to access hepl chart repos
@@ -9,16 +9,23 @@ import (
 
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
 	crval "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile/validation"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	mrval "github.com/gardener/landscaper/apis/deployer/utils/managedresource/validation"
 	health "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks/validation"
 )
 
+// kustomizationFileNames are the file names of a kustomization that are recognized by kustomize.
+var kustomizationFileNames = []string{"kustomization.yaml", "kustomization.yml", "Kustomization"}
+
 const (
 	helmArgumentAtomic  = "atomic"
 	helmArgumentTimeout = "timeout"
+	helmArgumentEnabled = "enabled"
 )
 
 // ValidateProviderConfiguration validates a helm deployer configuration
@@ -30,6 +37,18 @@ func ValidateProviderConfiguration(config *helmv1alpha1.ProviderConfiguration) e
 	allErrs = append(allErrs, ValidateChart(field.NewPath("chart"), config.Chart)...)
 	allErrs = append(allErrs, ValidateHelmDeploymentConfiguration(field.NewPath("helmDeploymentConfig"), config.HelmDeploymentConfig)...)
 	allErrs = append(allErrs, crval.ValidateContinuousReconcileSpec(field.NewPath("continuousReconcile"), config.ContinuousReconcile)...)
+	allErrs = append(allErrs, mrval.ValidateDriftDetectionSpec(field.NewPath("driftDetection"), config.DriftDetection)...)
+	allErrs = append(allErrs, ValidatePostRenderer(field.NewPath("postRenderer"), config.PostRenderer)...)
+
+	if config.UpdateStrategy == helmv1alpha1.UpdateStrategyServerSideApply && pointer.BoolDeref(config.HelmDeployment, true) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("updateStrategy"), config.UpdateStrategy,
+			"server-side apply is only supported if helmDeployment is false"))
+	}
+	if config.DriftDetection != nil && config.DriftDetection.Policy == managedresource.DriftDetectionPolicyAutoCorrect &&
+		pointer.BoolDeref(config.HelmDeployment, true) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("driftDetection", "policy"), config.DriftDetection.Policy,
+			"drift correction is only supported if helmDeployment is false, as it would bypass the helm release"))
+	}
 
 	if len(config.Name) == 0 {
 		allErrs = append(allErrs, field.Required(field.NewPath("name"), "must not be empty"))
@@ -101,7 +120,42 @@ func ValidateHelmDeploymentConfiguration(fldPath *field.Path, deployConfig *helm
 		allErrs = append(allErrs, ValidateInstallConfiguration(fldPath.Child("install"), deployConfig.Install)...)
 		allErrs = append(allErrs, ValidateUpgradeConfiguration(fldPath.Child("upgrade"), deployConfig.Upgrade)...)
 		allErrs = append(allErrs, ValidateUninstallConfiguration(fldPath.Child("uninstall"), deployConfig.Uninstall)...)
+		allErrs = append(allErrs, ValidateTestConfiguration(fldPath.Child("test"), deployConfig.Test)...)
+	}
+	return allErrs
+}
+
+// ValidatePostRenderer validates the post-renderer of the rendered manifests.
+func ValidatePostRenderer(fldPath *field.Path, postRenderer *helmv1alpha1.PostRenderer) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if postRenderer == nil {
+		return allErrs
+	}
+
+	for i, patch := range postRenderer.Patches {
+		if len(patch.Patch) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("patches").Index(i).Child("patch"), "must not be empty"))
+		}
 	}
+
+	if postRenderer.Kustomize != nil {
+		filesPath := fldPath.Child("kustomize", "files")
+		found := false
+		for _, name := range kustomizationFileNames {
+			if _, ok := postRenderer.Kustomize.Files[name]; ok {
+				found = true
+				break
+			}
+		}
+		if !found {
+			allErrs = append(allErrs, field.Required(filesPath, "must contain a kustomization at the root"))
+		}
+		if _, ok := postRenderer.Kustomize.Files[helmv1alpha1.PostRendererManifestsFile]; ok {
+			allErrs = append(allErrs, field.Forbidden(filesPath.Key(helmv1alpha1.PostRendererManifestsFile),
+				"is reserved for the rendered manifests"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -117,6 +171,10 @@ func ValidateUninstallConfiguration(fldPath *field.Path, conf map[string]lsv1alp
 	return validateHelmArguments(fldPath, conf, []string{helmArgumentTimeout})
 }
 
+func ValidateTestConfiguration(fldPath *field.Path, conf map[string]lsv1alpha1.AnyJSON) field.ErrorList {
+	return validateHelmArguments(fldPath, conf, []string{helmArgumentEnabled, helmArgumentTimeout})
+}
+
 func validateHelmArguments(fldPath *field.Path, conf map[string]lsv1alpha1.AnyJSON, validArguments []string) field.ErrorList {
 	allErrs := field.ErrorList{}
 
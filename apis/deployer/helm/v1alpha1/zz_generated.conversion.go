@@ -14,6 +14,7 @@ import (
 	json "encoding/json"
 	unsafe "unsafe"
 
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
@@ -132,6 +133,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HelmTestConfiguration)(nil), (*helm.HelmTestConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HelmTestConfiguration_To_helm_HelmTestConfiguration(a.(*HelmTestConfiguration), b.(*helm.HelmTestConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.HelmTestConfiguration)(nil), (*HelmTestConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_HelmTestConfiguration_To_v1alpha1_HelmTestConfiguration(a.(*helm.HelmTestConfiguration), b.(*HelmTestConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HelmUninstallConfiguration)(nil), (*helm.HelmUninstallConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_HelmUninstallConfiguration_To_helm_HelmUninstallConfiguration(a.(*HelmUninstallConfiguration), b.(*helm.HelmUninstallConfiguration), scope)
 	}); err != nil {
@@ -142,6 +153,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HookStatus)(nil), (*helm.HookStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HookStatus_To_helm_HookStatus(a.(*HookStatus), b.(*helm.HookStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.HookStatus)(nil), (*HookStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_HookStatus_To_v1alpha1_HookStatus(a.(*helm.HookStatus), b.(*HookStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KustomizePostRenderer)(nil), (*helm.KustomizePostRenderer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KustomizePostRenderer_To_helm_KustomizePostRenderer(a.(*KustomizePostRenderer), b.(*helm.KustomizePostRenderer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.KustomizePostRenderer)(nil), (*KustomizePostRenderer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_KustomizePostRenderer_To_v1alpha1_KustomizePostRenderer(a.(*helm.KustomizePostRenderer), b.(*KustomizePostRenderer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PatchTarget)(nil), (*helm.PatchTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PatchTarget_To_helm_PatchTarget(a.(*PatchTarget), b.(*helm.PatchTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.PatchTarget)(nil), (*PatchTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_PatchTarget_To_v1alpha1_PatchTarget(a.(*helm.PatchTarget), b.(*PatchTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PostRenderer)(nil), (*helm.PostRenderer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PostRenderer_To_helm_PostRenderer(a.(*PostRenderer), b.(*helm.PostRenderer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.PostRenderer)(nil), (*PostRenderer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_PostRenderer_To_v1alpha1_PostRenderer(a.(*helm.PostRenderer), b.(*PostRenderer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PostRendererPatch)(nil), (*helm.PostRendererPatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PostRendererPatch_To_helm_PostRendererPatch(a.(*PostRendererPatch), b.(*helm.PostRendererPatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.PostRendererPatch)(nil), (*PostRendererPatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_PostRendererPatch_To_v1alpha1_PostRendererPatch(a.(*helm.PostRendererPatch), b.(*PostRendererPatch), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProviderConfiguration)(nil), (*helm.ProviderConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(a.(*ProviderConfiguration), b.(*helm.ProviderConfiguration), scope)
 	}); err != nil {
@@ -162,6 +223,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ReleaseRevision)(nil), (*helm.ReleaseRevision)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ReleaseRevision_To_helm_ReleaseRevision(a.(*ReleaseRevision), b.(*helm.ReleaseRevision), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.ReleaseRevision)(nil), (*ReleaseRevision)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_ReleaseRevision_To_v1alpha1_ReleaseRevision(a.(*helm.ReleaseRevision), b.(*ReleaseRevision), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReleaseStatus)(nil), (*helm.ReleaseStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ReleaseStatus_To_helm_ReleaseStatus(a.(*ReleaseStatus), b.(*helm.ReleaseStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.ReleaseStatus)(nil), (*ReleaseStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_ReleaseStatus_To_v1alpha1_ReleaseStatus(a.(*helm.ReleaseStatus), b.(*ReleaseStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RemoteArchiveAccess)(nil), (*helm.RemoteArchiveAccess)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RemoteArchiveAccess_To_helm_RemoteArchiveAccess(a.(*RemoteArchiveAccess), b.(*helm.RemoteArchiveAccess), scope)
 	}); err != nil {
@@ -182,6 +263,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ResolvedChartVersion)(nil), (*helm.ResolvedChartVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResolvedChartVersion_To_helm_ResolvedChartVersion(a.(*ResolvedChartVersion), b.(*helm.ResolvedChartVersion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.ResolvedChartVersion)(nil), (*ResolvedChartVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_ResolvedChartVersion_To_v1alpha1_ResolvedChartVersion(a.(*helm.ResolvedChartVersion), b.(*ResolvedChartVersion), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -263,6 +354,7 @@ func autoConvert_v1alpha1_Configuration_To_helm_Configuration(in *Configuration,
 	out.Identity = in.Identity
 	out.OCI = (*config.OCIConfiguration)(unsafe.Pointer(in.OCI))
 	out.TargetSelector = *(*[]corev1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
+	out.FailOnUnhealthyTarget = in.FailOnUnhealthyTarget
 	if err := Convert_v1alpha1_ExportConfiguration_To_helm_ExportConfiguration(&in.Export, &out.Export, s); err != nil {
 		return err
 	}
@@ -281,6 +373,7 @@ func autoConvert_helm_Configuration_To_v1alpha1_Configuration(in *helm.Configura
 	out.Identity = in.Identity
 	out.OCI = (*config.OCIConfiguration)(unsafe.Pointer(in.OCI))
 	out.TargetSelector = *(*[]corev1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
+	out.FailOnUnhealthyTarget = in.FailOnUnhealthyTarget
 	if err := Convert_helm_ExportConfiguration_To_v1alpha1_ExportConfiguration(&in.Export, &out.Export, s); err != nil {
 		return err
 	}
@@ -339,6 +432,7 @@ func autoConvert_v1alpha1_HelmChartRepo_To_helm_HelmChartRepo(in *HelmChartRepo,
 	out.HelmChartRepoUrl = in.HelmChartRepoUrl
 	out.HelmChartName = in.HelmChartName
 	out.HelmChartVersion = in.HelmChartVersion
+	out.PinVersion = in.PinVersion
 	return nil
 }
 
@@ -351,6 +445,7 @@ func autoConvert_helm_HelmChartRepo_To_v1alpha1_HelmChartRepo(in *helm.HelmChart
 	out.HelmChartRepoUrl = in.HelmChartRepoUrl
 	out.HelmChartName = in.HelmChartName
 	out.HelmChartVersion = in.HelmChartVersion
+	out.PinVersion = in.PinVersion
 	return nil
 }
 
@@ -383,6 +478,7 @@ func autoConvert_v1alpha1_HelmDeploymentConfiguration_To_helm_HelmDeploymentConf
 	out.Install = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Install))
 	out.Upgrade = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Upgrade))
 	out.Uninstall = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Uninstall))
+	out.Test = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Test))
 	return nil
 }
 
@@ -395,6 +491,7 @@ func autoConvert_helm_HelmDeploymentConfiguration_To_v1alpha1_HelmDeploymentConf
 	out.Install = *(*map[string]corev1alpha1.AnyJSON)(unsafe.Pointer(&in.Install))
 	out.Upgrade = *(*map[string]corev1alpha1.AnyJSON)(unsafe.Pointer(&in.Upgrade))
 	out.Uninstall = *(*map[string]corev1alpha1.AnyJSON)(unsafe.Pointer(&in.Uninstall))
+	out.Test = *(*map[string]corev1alpha1.AnyJSON)(unsafe.Pointer(&in.Test))
 	return nil
 }
 
@@ -425,6 +522,28 @@ func Convert_helm_HelmInstallConfiguration_To_v1alpha1_HelmInstallConfiguration(
 	return autoConvert_helm_HelmInstallConfiguration_To_v1alpha1_HelmInstallConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_HelmTestConfiguration_To_helm_HelmTestConfiguration(in *HelmTestConfiguration, out *helm.HelmTestConfiguration, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Timeout = (*corev1alpha1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_HelmTestConfiguration_To_helm_HelmTestConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_HelmTestConfiguration_To_helm_HelmTestConfiguration(in *HelmTestConfiguration, out *helm.HelmTestConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HelmTestConfiguration_To_helm_HelmTestConfiguration(in, out, s)
+}
+
+func autoConvert_helm_HelmTestConfiguration_To_v1alpha1_HelmTestConfiguration(in *helm.HelmTestConfiguration, out *HelmTestConfiguration, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Timeout = (*corev1alpha1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_helm_HelmTestConfiguration_To_v1alpha1_HelmTestConfiguration is an autogenerated conversion function.
+func Convert_helm_HelmTestConfiguration_To_v1alpha1_HelmTestConfiguration(in *helm.HelmTestConfiguration, out *HelmTestConfiguration, s conversion.Scope) error {
+	return autoConvert_helm_HelmTestConfiguration_To_v1alpha1_HelmTestConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_HelmUninstallConfiguration_To_helm_HelmUninstallConfiguration(in *HelmUninstallConfiguration, out *helm.HelmUninstallConfiguration, s conversion.Scope) error {
 	out.Timeout = (*corev1alpha1.Duration)(unsafe.Pointer(in.Timeout))
 	return nil
@@ -445,9 +564,136 @@ func Convert_helm_HelmUninstallConfiguration_To_v1alpha1_HelmUninstallConfigurat
 	return autoConvert_helm_HelmUninstallConfiguration_To_v1alpha1_HelmUninstallConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_HookStatus_To_helm_HookStatus(in *HookStatus, out *helm.HookStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kind = in.Kind
+	out.Events = *(*[]string)(unsafe.Pointer(&in.Events))
+	out.Phase = in.Phase
+	out.StartedAt = (*v1.Time)(unsafe.Pointer(in.StartedAt))
+	out.CompletedAt = (*v1.Time)(unsafe.Pointer(in.CompletedAt))
+	return nil
+}
+
+// Convert_v1alpha1_HookStatus_To_helm_HookStatus is an autogenerated conversion function.
+func Convert_v1alpha1_HookStatus_To_helm_HookStatus(in *HookStatus, out *helm.HookStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HookStatus_To_helm_HookStatus(in, out, s)
+}
+
+func autoConvert_helm_HookStatus_To_v1alpha1_HookStatus(in *helm.HookStatus, out *HookStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kind = in.Kind
+	out.Events = *(*[]string)(unsafe.Pointer(&in.Events))
+	out.Phase = in.Phase
+	out.StartedAt = (*v1.Time)(unsafe.Pointer(in.StartedAt))
+	out.CompletedAt = (*v1.Time)(unsafe.Pointer(in.CompletedAt))
+	return nil
+}
+
+// Convert_helm_HookStatus_To_v1alpha1_HookStatus is an autogenerated conversion function.
+func Convert_helm_HookStatus_To_v1alpha1_HookStatus(in *helm.HookStatus, out *HookStatus, s conversion.Scope) error {
+	return autoConvert_helm_HookStatus_To_v1alpha1_HookStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_KustomizePostRenderer_To_helm_KustomizePostRenderer(in *KustomizePostRenderer, out *helm.KustomizePostRenderer, s conversion.Scope) error {
+	out.Files = *(*map[string]string)(unsafe.Pointer(&in.Files))
+	return nil
+}
+
+// Convert_v1alpha1_KustomizePostRenderer_To_helm_KustomizePostRenderer is an autogenerated conversion function.
+func Convert_v1alpha1_KustomizePostRenderer_To_helm_KustomizePostRenderer(in *KustomizePostRenderer, out *helm.KustomizePostRenderer, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KustomizePostRenderer_To_helm_KustomizePostRenderer(in, out, s)
+}
+
+func autoConvert_helm_KustomizePostRenderer_To_v1alpha1_KustomizePostRenderer(in *helm.KustomizePostRenderer, out *KustomizePostRenderer, s conversion.Scope) error {
+	out.Files = *(*map[string]string)(unsafe.Pointer(&in.Files))
+	return nil
+}
+
+// Convert_helm_KustomizePostRenderer_To_v1alpha1_KustomizePostRenderer is an autogenerated conversion function.
+func Convert_helm_KustomizePostRenderer_To_v1alpha1_KustomizePostRenderer(in *helm.KustomizePostRenderer, out *KustomizePostRenderer, s conversion.Scope) error {
+	return autoConvert_helm_KustomizePostRenderer_To_v1alpha1_KustomizePostRenderer(in, out, s)
+}
+
+func autoConvert_v1alpha1_PatchTarget_To_helm_PatchTarget(in *PatchTarget, out *helm.PatchTarget, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Version = in.Version
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.LabelSelector = in.LabelSelector
+	out.AnnotationSelector = in.AnnotationSelector
+	return nil
+}
+
+// Convert_v1alpha1_PatchTarget_To_helm_PatchTarget is an autogenerated conversion function.
+func Convert_v1alpha1_PatchTarget_To_helm_PatchTarget(in *PatchTarget, out *helm.PatchTarget, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PatchTarget_To_helm_PatchTarget(in, out, s)
+}
+
+func autoConvert_helm_PatchTarget_To_v1alpha1_PatchTarget(in *helm.PatchTarget, out *PatchTarget, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Version = in.Version
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.LabelSelector = in.LabelSelector
+	out.AnnotationSelector = in.AnnotationSelector
+	return nil
+}
+
+// Convert_helm_PatchTarget_To_v1alpha1_PatchTarget is an autogenerated conversion function.
+func Convert_helm_PatchTarget_To_v1alpha1_PatchTarget(in *helm.PatchTarget, out *PatchTarget, s conversion.Scope) error {
+	return autoConvert_helm_PatchTarget_To_v1alpha1_PatchTarget(in, out, s)
+}
+
+func autoConvert_v1alpha1_PostRenderer_To_helm_PostRenderer(in *PostRenderer, out *helm.PostRenderer, s conversion.Scope) error {
+	out.Patches = *(*[]helm.PostRendererPatch)(unsafe.Pointer(&in.Patches))
+	out.Kustomize = (*helm.KustomizePostRenderer)(unsafe.Pointer(in.Kustomize))
+	return nil
+}
+
+// Convert_v1alpha1_PostRenderer_To_helm_PostRenderer is an autogenerated conversion function.
+func Convert_v1alpha1_PostRenderer_To_helm_PostRenderer(in *PostRenderer, out *helm.PostRenderer, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PostRenderer_To_helm_PostRenderer(in, out, s)
+}
+
+func autoConvert_helm_PostRenderer_To_v1alpha1_PostRenderer(in *helm.PostRenderer, out *PostRenderer, s conversion.Scope) error {
+	out.Patches = *(*[]PostRendererPatch)(unsafe.Pointer(&in.Patches))
+	out.Kustomize = (*KustomizePostRenderer)(unsafe.Pointer(in.Kustomize))
+	return nil
+}
+
+// Convert_helm_PostRenderer_To_v1alpha1_PostRenderer is an autogenerated conversion function.
+func Convert_helm_PostRenderer_To_v1alpha1_PostRenderer(in *helm.PostRenderer, out *PostRenderer, s conversion.Scope) error {
+	return autoConvert_helm_PostRenderer_To_v1alpha1_PostRenderer(in, out, s)
+}
+
+func autoConvert_v1alpha1_PostRendererPatch_To_helm_PostRendererPatch(in *PostRendererPatch, out *helm.PostRendererPatch, s conversion.Scope) error {
+	out.Patch = in.Patch
+	out.Target = (*helm.PatchTarget)(unsafe.Pointer(in.Target))
+	return nil
+}
+
+// Convert_v1alpha1_PostRendererPatch_To_helm_PostRendererPatch is an autogenerated conversion function.
+func Convert_v1alpha1_PostRendererPatch_To_helm_PostRendererPatch(in *PostRendererPatch, out *helm.PostRendererPatch, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PostRendererPatch_To_helm_PostRendererPatch(in, out, s)
+}
+
+func autoConvert_helm_PostRendererPatch_To_v1alpha1_PostRendererPatch(in *helm.PostRendererPatch, out *PostRendererPatch, s conversion.Scope) error {
+	out.Patch = in.Patch
+	out.Target = (*PatchTarget)(unsafe.Pointer(in.Target))
+	return nil
+}
+
+// Convert_helm_PostRendererPatch_To_v1alpha1_PostRendererPatch is an autogenerated conversion function.
+func Convert_helm_PostRendererPatch_To_v1alpha1_PostRendererPatch(in *helm.PostRendererPatch, out *PostRendererPatch, s conversion.Scope) error {
+	return autoConvert_helm_PostRendererPatch_To_v1alpha1_PostRendererPatch(in, out, s)
+}
+
 func autoConvert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(in *ProviderConfiguration, out *helm.ProviderConfiguration, s conversion.Scope) error {
 	out.Kubeconfig = in.Kubeconfig
 	out.UpdateStrategy = helm.UpdateStrategy(in.UpdateStrategy)
+	out.ServerSideApply = (*managedresource.ServerSideApplyConfiguration)(unsafe.Pointer(in.ServerSideApply))
 	out.ReadinessChecks = in.ReadinessChecks
 	out.DeleteTimeout = (*core.Duration)(unsafe.Pointer(in.DeleteTimeout))
 	if err := Convert_v1alpha1_Chart_To_helm_Chart(&in.Chart, &out.Chart, s); err != nil {
@@ -460,6 +706,8 @@ func autoConvert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(in
 	out.ExportsFromManifests = *(*[]managedresource.Export)(unsafe.Pointer(&in.ExportsFromManifests))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.DriftDetection = (*managedresource.DriftDetectionSpec)(unsafe.Pointer(in.DriftDetection))
+	out.PostRenderer = (*helm.PostRenderer)(unsafe.Pointer(in.PostRenderer))
 	out.HelmDeployment = (*bool)(unsafe.Pointer(in.HelmDeployment))
 	out.HelmDeploymentConfig = (*helm.HelmDeploymentConfiguration)(unsafe.Pointer(in.HelmDeploymentConfig))
 	return nil
@@ -475,6 +723,7 @@ func autoConvert_helm_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in
 	out.ReadinessChecks = in.ReadinessChecks
 	out.DeleteTimeout = (*corev1alpha1.Duration)(unsafe.Pointer(in.DeleteTimeout))
 	out.UpdateStrategy = UpdateStrategy(in.UpdateStrategy)
+	out.ServerSideApply = (*managedresource.ServerSideApplyConfiguration)(unsafe.Pointer(in.ServerSideApply))
 	if err := Convert_helm_Chart_To_v1alpha1_Chart(&in.Chart, &out.Chart, s); err != nil {
 		return err
 	}
@@ -485,6 +734,8 @@ func autoConvert_helm_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in
 	out.ExportsFromManifests = *(*[]managedresource.Export)(unsafe.Pointer(&in.ExportsFromManifests))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.DriftDetection = (*managedresource.DriftDetectionSpec)(unsafe.Pointer(in.DriftDetection))
+	out.PostRenderer = (*PostRenderer)(unsafe.Pointer(in.PostRenderer))
 	out.HelmDeployment = (*bool)(unsafe.Pointer(in.HelmDeployment))
 	out.HelmDeploymentConfig = (*HelmDeploymentConfiguration)(unsafe.Pointer(in.HelmDeploymentConfig))
 	return nil
@@ -497,6 +748,10 @@ func Convert_helm_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in *he
 
 func autoConvert_v1alpha1_ProviderStatus_To_helm_ProviderStatus(in *ProviderStatus, out *helm.ProviderStatus, s conversion.Scope) error {
 	out.ManagedResources = *(*managedresource.ManagedResourceStatusList)(unsafe.Pointer(&in.ManagedResources))
+	out.Drift = (*managedresource.DriftStatus)(unsafe.Pointer(in.Drift))
+	out.Hooks = *(*[]helm.HookStatus)(unsafe.Pointer(&in.Hooks))
+	out.ChartVersion = (*helm.ResolvedChartVersion)(unsafe.Pointer(in.ChartVersion))
+	out.Release = (*helm.ReleaseStatus)(unsafe.Pointer(in.Release))
 	return nil
 }
 
@@ -507,6 +762,10 @@ func Convert_v1alpha1_ProviderStatus_To_helm_ProviderStatus(in *ProviderStatus,
 
 func autoConvert_helm_ProviderStatus_To_v1alpha1_ProviderStatus(in *helm.ProviderStatus, out *ProviderStatus, s conversion.Scope) error {
 	out.ManagedResources = *(*managedresource.ManagedResourceStatusList)(unsafe.Pointer(&in.ManagedResources))
+	out.Drift = (*managedresource.DriftStatus)(unsafe.Pointer(in.Drift))
+	out.Hooks = *(*[]HookStatus)(unsafe.Pointer(&in.Hooks))
+	out.ChartVersion = (*ResolvedChartVersion)(unsafe.Pointer(in.ChartVersion))
+	out.Release = (*ReleaseStatus)(unsafe.Pointer(in.Release))
 	return nil
 }
 
@@ -515,6 +774,66 @@ func Convert_helm_ProviderStatus_To_v1alpha1_ProviderStatus(in *helm.ProviderSta
 	return autoConvert_helm_ProviderStatus_To_v1alpha1_ProviderStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_ReleaseRevision_To_helm_ReleaseRevision(in *ReleaseRevision, out *helm.ReleaseRevision, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.Status = in.Status
+	out.ChartVersion = in.ChartVersion
+	out.AppVersion = in.AppVersion
+	out.Updated = (*v1.Time)(unsafe.Pointer(in.Updated))
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_v1alpha1_ReleaseRevision_To_helm_ReleaseRevision is an autogenerated conversion function.
+func Convert_v1alpha1_ReleaseRevision_To_helm_ReleaseRevision(in *ReleaseRevision, out *helm.ReleaseRevision, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ReleaseRevision_To_helm_ReleaseRevision(in, out, s)
+}
+
+func autoConvert_helm_ReleaseRevision_To_v1alpha1_ReleaseRevision(in *helm.ReleaseRevision, out *ReleaseRevision, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.Status = in.Status
+	out.ChartVersion = in.ChartVersion
+	out.AppVersion = in.AppVersion
+	out.Updated = (*v1.Time)(unsafe.Pointer(in.Updated))
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_helm_ReleaseRevision_To_v1alpha1_ReleaseRevision is an autogenerated conversion function.
+func Convert_helm_ReleaseRevision_To_v1alpha1_ReleaseRevision(in *helm.ReleaseRevision, out *ReleaseRevision, s conversion.Scope) error {
+	return autoConvert_helm_ReleaseRevision_To_v1alpha1_ReleaseRevision(in, out, s)
+}
+
+func autoConvert_v1alpha1_ReleaseStatus_To_helm_ReleaseStatus(in *ReleaseStatus, out *helm.ReleaseStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.Revision = in.Revision
+	out.Status = in.Status
+	out.ChartVersion = in.ChartVersion
+	out.History = *(*[]helm.ReleaseRevision)(unsafe.Pointer(&in.History))
+	return nil
+}
+
+// Convert_v1alpha1_ReleaseStatus_To_helm_ReleaseStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ReleaseStatus_To_helm_ReleaseStatus(in *ReleaseStatus, out *helm.ReleaseStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ReleaseStatus_To_helm_ReleaseStatus(in, out, s)
+}
+
+func autoConvert_helm_ReleaseStatus_To_v1alpha1_ReleaseStatus(in *helm.ReleaseStatus, out *ReleaseStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.Revision = in.Revision
+	out.Status = in.Status
+	out.ChartVersion = in.ChartVersion
+	out.History = *(*[]ReleaseRevision)(unsafe.Pointer(&in.History))
+	return nil
+}
+
+// Convert_helm_ReleaseStatus_To_v1alpha1_ReleaseStatus is an autogenerated conversion function.
+func Convert_helm_ReleaseStatus_To_v1alpha1_ReleaseStatus(in *helm.ReleaseStatus, out *ReleaseStatus, s conversion.Scope) error {
+	return autoConvert_helm_ReleaseStatus_To_v1alpha1_ReleaseStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_RemoteArchiveAccess_To_helm_RemoteArchiveAccess(in *RemoteArchiveAccess, out *helm.RemoteArchiveAccess, s conversion.Scope) error {
 	out.URL = in.URL
 	return nil
@@ -556,3 +875,27 @@ func autoConvert_helm_RemoteChartReference_To_v1alpha1_RemoteChartReference(in *
 func Convert_helm_RemoteChartReference_To_v1alpha1_RemoteChartReference(in *helm.RemoteChartReference, out *RemoteChartReference, s conversion.Scope) error {
 	return autoConvert_helm_RemoteChartReference_To_v1alpha1_RemoteChartReference(in, out, s)
 }
+
+func autoConvert_v1alpha1_ResolvedChartVersion_To_helm_ResolvedChartVersion(in *ResolvedChartVersion, out *helm.ResolvedChartVersion, s conversion.Scope) error {
+	out.Constraint = in.Constraint
+	out.Version = in.Version
+	out.ObservedGeneration = in.ObservedGeneration
+	return nil
+}
+
+// Convert_v1alpha1_ResolvedChartVersion_To_helm_ResolvedChartVersion is an autogenerated conversion function.
+func Convert_v1alpha1_ResolvedChartVersion_To_helm_ResolvedChartVersion(in *ResolvedChartVersion, out *helm.ResolvedChartVersion, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResolvedChartVersion_To_helm_ResolvedChartVersion(in, out, s)
+}
+
+func autoConvert_helm_ResolvedChartVersion_To_v1alpha1_ResolvedChartVersion(in *helm.ResolvedChartVersion, out *ResolvedChartVersion, s conversion.Scope) error {
+	out.Constraint = in.Constraint
+	out.Version = in.Version
+	out.ObservedGeneration = in.ObservedGeneration
+	return nil
+}
+
+// Convert_helm_ResolvedChartVersion_To_v1alpha1_ResolvedChartVersion is an autogenerated conversion function.
+func Convert_helm_ResolvedChartVersion_To_v1alpha1_ResolvedChartVersion(in *helm.ResolvedChartVersion, out *ResolvedChartVersion, s conversion.Scope) error {
+	return autoConvert_helm_ResolvedChartVersion_To_v1alpha1_ResolvedChartVersion(in, out, s)
+}
@@ -235,6 +235,13 @@ func (in *HelmDeploymentConfiguration) DeepCopyInto(out *HelmDeploymentConfigura
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Test != nil {
+		in, out := &in.Test, &out.Test
+		*out = make(map[string]core.AnyJSON, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -269,6 +276,27 @@ func (in *HelmInstallConfiguration) DeepCopy() *HelmInstallConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmTestConfiguration) DeepCopyInto(out *HelmTestConfiguration) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1alpha1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmTestConfiguration.
+func (in *HelmTestConfiguration) DeepCopy() *HelmTestConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmTestConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HelmUninstallConfiguration) DeepCopyInto(out *HelmUninstallConfiguration) {
 	*out = *in
@@ -290,6 +318,123 @@ func (in *HelmUninstallConfiguration) DeepCopy() *HelmUninstallConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HookStatus) DeepCopyInto(out *HookStatus) {
+	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookStatus.
+func (in *HookStatus) DeepCopy() *HookStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HookStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KustomizePostRenderer) DeepCopyInto(out *KustomizePostRenderer) {
+	*out = *in
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KustomizePostRenderer.
+func (in *KustomizePostRenderer) DeepCopy() *KustomizePostRenderer {
+	if in == nil {
+		return nil
+	}
+	out := new(KustomizePostRenderer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PatchTarget) DeepCopyInto(out *PatchTarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PatchTarget.
+func (in *PatchTarget) DeepCopy() *PatchTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(PatchTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostRenderer) DeepCopyInto(out *PostRenderer) {
+	*out = *in
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]PostRendererPatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Kustomize != nil {
+		in, out := &in.Kustomize, &out.Kustomize
+		*out = new(KustomizePostRenderer)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostRenderer.
+func (in *PostRenderer) DeepCopy() *PostRenderer {
+	if in == nil {
+		return nil
+	}
+	out := new(PostRenderer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostRendererPatch) DeepCopyInto(out *PostRendererPatch) {
+	*out = *in
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(PatchTarget)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostRendererPatch.
+func (in *PostRendererPatch) DeepCopy() *PostRendererPatch {
+	if in == nil {
+		return nil
+	}
+	out := new(PostRendererPatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 	*out = *in
@@ -300,6 +445,11 @@ func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 		*out = new(core.Duration)
 		**out = **in
 	}
+	if in.ServerSideApply != nil {
+		in, out := &in.ServerSideApply, &out.ServerSideApply
+		*out = new(managedresource.ServerSideApplyConfiguration)
+		**out = **in
+	}
 	in.Chart.DeepCopyInto(&out.Chart)
 	if in.Values != nil {
 		in, out := &in.Values, &out.Values
@@ -323,6 +473,16 @@ func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 		*out = new(continuousreconcile.ContinuousReconcileSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(managedresource.DriftDetectionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PostRenderer != nil {
+		in, out := &in.PostRenderer, &out.PostRenderer
+		*out = new(PostRenderer)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.HelmDeployment != nil {
 		in, out := &in.HelmDeployment, &out.HelmDeployment
 		*out = new(bool)
@@ -363,6 +523,28 @@ func (in *ProviderStatus) DeepCopyInto(out *ProviderStatus) {
 		*out = make(managedresource.ManagedResourceStatusList, len(*in))
 		copy(*out, *in)
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(managedresource.DriftStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make([]HookStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ChartVersion != nil {
+		in, out := &in.ChartVersion, &out.ChartVersion
+		*out = new(ResolvedChartVersion)
+		**out = **in
+	}
+	if in.Release != nil {
+		in, out := &in.Release, &out.Release
+		*out = new(ReleaseStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -384,6 +566,49 @@ func (in *ProviderStatus) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseRevision) DeepCopyInto(out *ReleaseRevision) {
+	*out = *in
+	if in.Updated != nil {
+		in, out := &in.Updated, &out.Updated
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseRevision.
+func (in *ReleaseRevision) DeepCopy() *ReleaseRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseStatus) DeepCopyInto(out *ReleaseStatus) {
+	*out = *in
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]ReleaseRevision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseStatus.
+func (in *ReleaseStatus) DeepCopy() *ReleaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteArchiveAccess) DeepCopyInto(out *RemoteArchiveAccess) {
 	*out = *in
@@ -416,3 +641,19 @@ func (in *RemoteChartReference) DeepCopy() *RemoteChartReference {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolvedChartVersion) DeepCopyInto(out *ResolvedChartVersion) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedChartVersion.
+func (in *ResolvedChartVersion) DeepCopy() *ResolvedChartVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolvedChartVersion)
+	in.DeepCopyInto(out)
+	return out
+}
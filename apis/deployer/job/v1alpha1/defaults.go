@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	lsconfigv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+// SetDefaults_ProviderConfiguration sets the defaults for the job deployer provider configuration.
+func SetDefaults_ProviderConfiguration(obj *ProviderConfiguration) {
+	if obj.Timeout == nil {
+		obj.Timeout = &lsv1alpha1.Duration{Duration: 10 * time.Minute}
+	}
+	if obj.DeleteTimeout == nil {
+		obj.DeleteTimeout = &lsv1alpha1.Duration{Duration: 180 * time.Second}
+	}
+}
+
+// SetDefaults_Configuration sets the defaults for the job deployer controller configuration.
+func SetDefaults_Configuration(obj *Configuration) {
+	lsconfigv1alpha1.SetDefaults_CommonControllerConfig(&obj.Controller.CommonControllerConfig)
+}
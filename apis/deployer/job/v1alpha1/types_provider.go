@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+)
+
+// ManagedDeployItemLabel describes the label that is added to every job that is created by the job deployer
+// to define its source deploy item.
+const ManagedDeployItemLabel = "job.deployer.landscaper.gardener.cloud/deployitem"
+
+// ConfigHashAnnotation describes the annotation of a job that contains the hash of the job manifest it was created from.
+const ConfigHashAnnotation = "job.deployer.landscaper.gardener.cloud/config-hash"
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProviderConfiguration is the job deployer configuration that is expected in a DeployItem.
+type ProviderConfiguration struct {
+	metav1.TypeMeta `json:",inline"`
+	// Kubeconfig is the base64 encoded kubeconfig file.
+	// By default the configured target is used to deploy the job.
+	// +optional
+	Kubeconfig string `json:"kubeconfig,omitempty"`
+	// Job is the manifest of the batch/v1 Job that is run on the target cluster.
+	// The name and the namespace of the job are required.
+	Job *runtime.RawExtension `json:"job"`
+	// Timeout is the time to wait for the completion of the job.
+	// Defaults to 10 minutes.
+	// +optional
+	Timeout *lsv1alpha1.Duration `json:"timeout,omitempty"`
+	// DeleteTimeout is the time to wait for the deletion of a previous run of the job.
+	// Defaults to 180s.
+	// +optional
+	DeleteTimeout *lsv1alpha1.Duration `json:"deleteTimeout,omitempty"`
+	// Exports describe the exports from the ConfigMaps or Secrets that are written by the job.
+	// +optional
+	Exports *managedresource.Exports `json:"exports,omitempty"`
+}
+
+// JobPhase describes the phase of a job.
+type JobPhase string
+
+const (
+	// JobPhaseRunning is the phase of a job that has not yet completed.
+	JobPhaseRunning JobPhase = "Running"
+	// JobPhaseSucceeded is the phase of a job that has completed successfully.
+	JobPhaseSucceeded JobPhase = "Succeeded"
+	// JobPhaseFailed is the phase of a job that has failed.
+	JobPhaseFailed JobPhase = "Failed"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProviderStatus is the job provider specific status
+type ProviderStatus struct {
+	metav1.TypeMeta `json:",inline"`
+	// Job is the reference to the job on the target cluster.
+	// +optional
+	Job *lsv1alpha1.TypedObjectReference `json:"job,omitempty"`
+	// Phase is the phase of the job.
+	// +optional
+	Phase JobPhase `json:"phase,omitempty"`
+	// StartTime is the time when the job was started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time when the job has completed successfully.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Message describes why the job has failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
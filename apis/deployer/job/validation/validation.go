@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	jobv1alpha1 "github.com/gardener/landscaper/apis/deployer/job/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource/validation"
+)
+
+// ValidateProviderConfiguration validates a job provider configuration.
+func ValidateProviderConfiguration(config *jobv1alpha1.ProviderConfiguration) error {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, ValidateJob(field.NewPath("job"), config.Job)...)
+	allErrs = append(allErrs, ValidateTimeout(field.NewPath("timeout"), config.Timeout)...)
+	allErrs = append(allErrs, ValidateTimeout(field.NewPath("deleteTimeout"), config.DeleteTimeout)...)
+	allErrs = append(allErrs, ValidateExports(field.NewPath("exports"), config.Exports)...)
+	return allErrs.ToAggregate()
+}
+
+// ValidateJob validates the job manifest of a job provider configuration.
+// The job has to be a batch/v1 Job with a name and a namespace.
+func ValidateJob(fldPath *field.Path, raw *runtime.RawExtension) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if raw == nil || len(raw.Raw) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "job must be defined"))
+		return allErrs
+	}
+	job := &struct {
+		metav1.TypeMeta   `json:",inline"`
+		metav1.ObjectMeta `json:"metadata,omitempty"`
+	}{}
+	if err := json.Unmarshal(raw.Raw, job); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, string(raw.Raw), err.Error()))
+		return allErrs
+	}
+	if job.APIVersion != "batch/v1" {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("apiVersion"), job.APIVersion, []string{"batch/v1"}))
+	}
+	if job.Kind != "Job" {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("kind"), job.Kind, []string{"Job"}))
+	}
+	if len(job.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("metadata", "name"), "must not be empty"))
+	}
+	if len(job.Namespace) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("metadata", "namespace"), "must not be empty"))
+	}
+	return allErrs
+}
+
+// ValidateExports validates the exports of a job provider configuration.
+// Exports can only be read from other resources as the job itself is not exported.
+func ValidateExports(fldPath *field.Path, exports *managedresource.Exports) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if exports == nil {
+		return allErrs
+	}
+	for i, export := range exports.Exports {
+		expPath := fldPath.Child("exports").Index(i)
+		allErrs = append(allErrs, validation.ValidateManifestExport(expPath, &export)...)
+		if export.FromResource == nil {
+			allErrs = append(allErrs, field.Required(expPath.Child("fromResource"), "exports of a job have to be read from a resource"))
+		}
+	}
+	return allErrs
+}
+
+// ValidateTimeout validates a timeout.
+func ValidateTimeout(fldPath *field.Path, timeout *lsv1alpha1.Duration) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if timeout == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "timeout can not be empty"))
+		return allErrs
+	}
+	if timeout.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, timeout, "timeout can not be negative"))
+	}
+	return allErrs
+}
@@ -7,6 +7,7 @@ package v1alpha1
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/gardener/landscaper/apis/config"
 	lsconfigv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -30,6 +31,13 @@ type Configuration struct {
 	Identity string `json:"identity,omitempty"`
 	// TargetSelector describes all selectors the deployer should depend on.
 	TargetSelector []lsv1alpha1.TargetSelector `json:"targetSelector,omitempty"`
+	// FailOnUnhealthyTarget lets deploy items fail fast with a recoverable error, if the target health check
+	// of the landscaper has recently found their target unreachable.
+	FailOnUnhealthyTarget bool `json:"failOnUnhealthyTarget,omitempty"`
+	// OCI configures the oci client of the controller.
+	// It is used to fetch kustomization sources that are referenced by a component descriptor.
+	// +optional
+	OCI *config.OCIConfiguration `json:"oci,omitempty"`
 	// Export defines the export configuration.
 	Export ExportConfiguration `json:"export,omitempty"`
 	// Controller contains configuration concerning the controller framework.
@@ -16,6 +16,7 @@ import (
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
+	config "github.com/gardener/landscaper/apis/config"
 	corev1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	manifest "github.com/gardener/landscaper/apis/deployer/manifest"
 )
@@ -83,6 +84,8 @@ func RegisterConversions(s *runtime.Scheme) error {
 func autoConvert_v1alpha1_Configuration_To_manifest_Configuration(in *Configuration, out *manifest.Configuration, s conversion.Scope) error {
 	out.Identity = in.Identity
 	out.TargetSelector = *(*[]corev1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
+	out.FailOnUnhealthyTarget = in.FailOnUnhealthyTarget
+	out.OCI = (*config.OCIConfiguration)(unsafe.Pointer(in.OCI))
 	if err := Convert_v1alpha1_ExportConfiguration_To_manifest_ExportConfiguration(&in.Export, &out.Export, s); err != nil {
 		return err
 	}
@@ -100,6 +103,8 @@ func Convert_v1alpha1_Configuration_To_manifest_Configuration(in *Configuration,
 func autoConvert_manifest_Configuration_To_v1alpha1_Configuration(in *manifest.Configuration, out *Configuration, s conversion.Scope) error {
 	out.Identity = in.Identity
 	out.TargetSelector = *(*[]corev1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
+	out.FailOnUnhealthyTarget = in.FailOnUnhealthyTarget
+	out.OCI = (*config.OCIConfiguration)(unsafe.Pointer(in.OCI))
 	if err := Convert_manifest_ExportConfiguration_To_v1alpha1_ExportConfiguration(&in.Export, &out.Export, s); err != nil {
 		return err
 	}
@@ -13,6 +13,7 @@ package v1alpha1
 import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
+	config "github.com/gardener/landscaper/apis/config"
 	corev1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	managedresource "github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 )
@@ -28,6 +29,11 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(config.OCIConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Export.DeepCopyInto(&out.Export)
 	in.Controller.DeepCopyInto(&out.Controller)
 	return
@@ -26,6 +26,10 @@ type ProviderConfiguration struct {
 	// Defaults to "update".
 	// +optional
 	UpdateStrategy UpdateStrategy `json:"updateStrategy,omitempty"`
+	// ServerSideApply configures the server-side apply of the manifests.
+	// Only relevant if the update strategy is "serverSideApply".
+	// +optional
+	ServerSideApply *managedresource.ServerSideApplyConfiguration `json:"serverSideApply,omitempty"`
 	// ReadinessChecks configures the readiness checks.
 	// +optional
 	ReadinessChecks health.ReadinessCheckConfiguration `json:"readinessChecks,omitempty"`
@@ -35,22 +39,57 @@ type ProviderConfiguration struct {
 	DeleteTimeout *lsv1alpha1.Duration `json:"deleteTimeout,omitempty"`
 	// Manifests contains a list of manifests that should be applied in the target cluster
 	Manifests []managedresource.Manifest `json:"manifests,omitempty"`
+	// Kustomize defines a kustomization that is built by the deployer.
+	// The resulting resources are applied in addition to the manifests.
+	// +optional
+	Kustomize *KustomizeSource `json:"kustomize,omitempty"`
 	// Exports describe the exports from the templated manifests that should be exported by the helm deployer.
 	// +optional
 	Exports *managedresource.Exports `json:"exports,omitempty"`
 	// ContinuousReconcile contains the schedule for continuous reconciliation.
 	// +optional
 	ContinuousReconcile *cr.ContinuousReconcileSpec `json:"continuousReconcile,omitempty"`
+	// DriftDetection configures the periodic detection of managed resources that differ from their last applied manifests.
+	// +optional
+	DriftDetection *managedresource.DriftDetectionSpec `json:"driftDetection,omitempty"`
+}
+
+// KustomizeSource defines the source of a kustomization.
+// Exactly one of files or fromResource has to be defined.
+type KustomizeSource struct {
+	// Path is the path of the kustomization directory relative to the root of the source.
+	// Defaults to the root of the source.
+	// +optional
+	Path string `json:"path,omitempty"`
+	// Files contains the files of the kustomization source as map of their relative paths to their content.
+	// +optional
+	Files map[string]string `json:"files,omitempty"`
+	// FromResource references a resource of a component descriptor
+	// that contains the kustomization source as tar archive which can optionally be gzip compressed.
+	// +optional
+	FromResource *RemoteKustomizationReference `json:"fromResource,omitempty"`
+	// Policy defines the manage policy for all resources of the kustomization.
+	// Defaults to "manage".
+	// +optional
+	Policy managedresource.ManifestPolicy `json:"policy,omitempty"`
+}
+
+// RemoteKustomizationReference defines a reference to a kustomization source through a Component-Descriptor
+type RemoteKustomizationReference struct {
+	lsv1alpha1.ComponentDescriptorDefinition `json:",inline"`
+	// ResourceName is the name of the kustomization source as defined by a component descriptor.
+	ResourceName string `json:"resourceName"`
 }
 
 // UpdateStrategy defines the strategy that is used to apply resources to the cluster.
 type UpdateStrategy string
 
 const (
-	UpdateStrategyUpdate         UpdateStrategy = "update"
-	UpdateStrategyPatch          UpdateStrategy = "patch"
-	UpdateStrategyMerge          UpdateStrategy = "merge"
-	UpdateStrategyMergeOverwrite UpdateStrategy = "mergeOverwrite"
+	UpdateStrategyUpdate          UpdateStrategy = "update"
+	UpdateStrategyPatch           UpdateStrategy = "patch"
+	UpdateStrategyMerge           UpdateStrategy = "merge"
+	UpdateStrategyMergeOverwrite  UpdateStrategy = "mergeOverwrite"
+	UpdateStrategyServerSideApply UpdateStrategy = "serverSideApply"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -60,4 +99,7 @@ type ProviderStatus struct {
 	metav1.TypeMeta `json:",inline"`
 	// ManagedResources contains all kubernetes resources that are deployed by the deployer.
 	ManagedResources managedresource.ManagedResourceStatusList `json:"managedResources,omitempty"`
+	// Drift contains the result of the last drift detection.
+	// +optional
+	Drift *managedresource.DriftStatus `json:"drift,omitempty"`
 }
@@ -16,6 +16,7 @@ import (
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
+	config "github.com/gardener/landscaper/apis/config"
 	core "github.com/gardener/landscaper/apis/core"
 	v1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	manifest "github.com/gardener/landscaper/apis/deployer/manifest"
@@ -60,6 +61,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KustomizeSource)(nil), (*manifest.KustomizeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_KustomizeSource_To_manifest_KustomizeSource(a.(*KustomizeSource), b.(*manifest.KustomizeSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*manifest.KustomizeSource)(nil), (*KustomizeSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_manifest_KustomizeSource_To_v1alpha2_KustomizeSource(a.(*manifest.KustomizeSource), b.(*KustomizeSource), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProviderConfiguration)(nil), (*manifest.ProviderConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_ProviderConfiguration_To_manifest_ProviderConfiguration(a.(*ProviderConfiguration), b.(*manifest.ProviderConfiguration), scope)
 	}); err != nil {
@@ -75,6 +86,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RemoteKustomizationReference)(nil), (*manifest.RemoteKustomizationReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_RemoteKustomizationReference_To_manifest_RemoteKustomizationReference(a.(*RemoteKustomizationReference), b.(*manifest.RemoteKustomizationReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*manifest.RemoteKustomizationReference)(nil), (*RemoteKustomizationReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_manifest_RemoteKustomizationReference_To_v1alpha2_RemoteKustomizationReference(a.(*manifest.RemoteKustomizationReference), b.(*RemoteKustomizationReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*manifest.ProviderStatus)(nil), (*ProviderStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_manifest_ProviderStatus_To_v1alpha2_ProviderStatus(a.(*manifest.ProviderStatus), b.(*ProviderStatus), scope)
 	}); err != nil {
@@ -86,6 +107,8 @@ func RegisterConversions(s *runtime.Scheme) error {
 func autoConvert_v1alpha2_Configuration_To_manifest_Configuration(in *Configuration, out *manifest.Configuration, s conversion.Scope) error {
 	out.Identity = in.Identity
 	out.TargetSelector = *(*[]v1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
+	out.FailOnUnhealthyTarget = in.FailOnUnhealthyTarget
+	out.OCI = (*config.OCIConfiguration)(unsafe.Pointer(in.OCI))
 	if err := Convert_v1alpha2_ExportConfiguration_To_manifest_ExportConfiguration(&in.Export, &out.Export, s); err != nil {
 		return err
 	}
@@ -103,6 +126,8 @@ func Convert_v1alpha2_Configuration_To_manifest_Configuration(in *Configuration,
 func autoConvert_manifest_Configuration_To_v1alpha2_Configuration(in *manifest.Configuration, out *Configuration, s conversion.Scope) error {
 	out.Identity = in.Identity
 	out.TargetSelector = *(*[]v1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
+	out.FailOnUnhealthyTarget = in.FailOnUnhealthyTarget
+	out.OCI = (*config.OCIConfiguration)(unsafe.Pointer(in.OCI))
 	if err := Convert_manifest_ExportConfiguration_To_v1alpha2_ExportConfiguration(&in.Export, &out.Export, s); err != nil {
 		return err
 	}
@@ -157,14 +182,43 @@ func Convert_manifest_ExportConfiguration_To_v1alpha2_ExportConfiguration(in *ma
 	return autoConvert_manifest_ExportConfiguration_To_v1alpha2_ExportConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha2_KustomizeSource_To_manifest_KustomizeSource(in *KustomizeSource, out *manifest.KustomizeSource, s conversion.Scope) error {
+	out.Path = in.Path
+	out.Files = *(*map[string]string)(unsafe.Pointer(&in.Files))
+	out.FromResource = (*manifest.RemoteKustomizationReference)(unsafe.Pointer(in.FromResource))
+	out.Policy = managedresource.ManifestPolicy(in.Policy)
+	return nil
+}
+
+// Convert_v1alpha2_KustomizeSource_To_manifest_KustomizeSource is an autogenerated conversion function.
+func Convert_v1alpha2_KustomizeSource_To_manifest_KustomizeSource(in *KustomizeSource, out *manifest.KustomizeSource, s conversion.Scope) error {
+	return autoConvert_v1alpha2_KustomizeSource_To_manifest_KustomizeSource(in, out, s)
+}
+
+func autoConvert_manifest_KustomizeSource_To_v1alpha2_KustomizeSource(in *manifest.KustomizeSource, out *KustomizeSource, s conversion.Scope) error {
+	out.Path = in.Path
+	out.Files = *(*map[string]string)(unsafe.Pointer(&in.Files))
+	out.FromResource = (*RemoteKustomizationReference)(unsafe.Pointer(in.FromResource))
+	out.Policy = managedresource.ManifestPolicy(in.Policy)
+	return nil
+}
+
+// Convert_manifest_KustomizeSource_To_v1alpha2_KustomizeSource is an autogenerated conversion function.
+func Convert_manifest_KustomizeSource_To_v1alpha2_KustomizeSource(in *manifest.KustomizeSource, out *KustomizeSource, s conversion.Scope) error {
+	return autoConvert_manifest_KustomizeSource_To_v1alpha2_KustomizeSource(in, out, s)
+}
+
 func autoConvert_v1alpha2_ProviderConfiguration_To_manifest_ProviderConfiguration(in *ProviderConfiguration, out *manifest.ProviderConfiguration, s conversion.Scope) error {
 	out.Kubeconfig = in.Kubeconfig
 	out.UpdateStrategy = manifest.UpdateStrategy(in.UpdateStrategy)
+	out.ServerSideApply = (*managedresource.ServerSideApplyConfiguration)(unsafe.Pointer(in.ServerSideApply))
 	out.ReadinessChecks = in.ReadinessChecks
 	out.DeleteTimeout = (*core.Duration)(unsafe.Pointer(in.DeleteTimeout))
 	out.Manifests = *(*[]managedresource.Manifest)(unsafe.Pointer(&in.Manifests))
+	out.Kustomize = (*manifest.KustomizeSource)(unsafe.Pointer(in.Kustomize))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.DriftDetection = (*managedresource.DriftDetectionSpec)(unsafe.Pointer(in.DriftDetection))
 	return nil
 }
 
@@ -176,11 +230,14 @@ func Convert_v1alpha2_ProviderConfiguration_To_manifest_ProviderConfiguration(in
 func autoConvert_manifest_ProviderConfiguration_To_v1alpha2_ProviderConfiguration(in *manifest.ProviderConfiguration, out *ProviderConfiguration, s conversion.Scope) error {
 	out.Kubeconfig = in.Kubeconfig
 	out.UpdateStrategy = UpdateStrategy(in.UpdateStrategy)
+	out.ServerSideApply = (*managedresource.ServerSideApplyConfiguration)(unsafe.Pointer(in.ServerSideApply))
 	out.ReadinessChecks = in.ReadinessChecks
 	out.DeleteTimeout = (*v1alpha1.Duration)(unsafe.Pointer(in.DeleteTimeout))
 	out.Manifests = *(*[]managedresource.Manifest)(unsafe.Pointer(&in.Manifests))
+	out.Kustomize = (*KustomizeSource)(unsafe.Pointer(in.Kustomize))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.DriftDetection = (*managedresource.DriftDetectionSpec)(unsafe.Pointer(in.DriftDetection))
 	return nil
 }
 
@@ -191,6 +248,7 @@ func Convert_manifest_ProviderConfiguration_To_v1alpha2_ProviderConfiguration(in
 
 func autoConvert_v1alpha2_ProviderStatus_To_manifest_ProviderStatus(in *ProviderStatus, out *manifest.ProviderStatus, s conversion.Scope) error {
 	out.ManagedResources = *(*managedresource.ManagedResourceStatusList)(unsafe.Pointer(&in.ManagedResources))
+	out.Drift = (*managedresource.DriftStatus)(unsafe.Pointer(in.Drift))
 	return nil
 }
 
@@ -201,7 +259,30 @@ func Convert_v1alpha2_ProviderStatus_To_manifest_ProviderStatus(in *ProviderStat
 
 func autoConvert_manifest_ProviderStatus_To_v1alpha2_ProviderStatus(in *manifest.ProviderStatus, out *ProviderStatus, s conversion.Scope) error {
 	out.ManagedResources = *(*managedresource.ManagedResourceStatusList)(unsafe.Pointer(&in.ManagedResources))
+	out.Drift = (*managedresource.DriftStatus)(unsafe.Pointer(in.Drift))
 	// WARNING: in.AnnotateBeforeCreate requires manual conversion: does not exist in peer-type
 	// WARNING: in.AnnotateBeforeDelete requires manual conversion: does not exist in peer-type
 	return nil
 }
+
+func autoConvert_v1alpha2_RemoteKustomizationReference_To_manifest_RemoteKustomizationReference(in *RemoteKustomizationReference, out *manifest.RemoteKustomizationReference, s conversion.Scope) error {
+	out.ComponentDescriptorDefinition = in.ComponentDescriptorDefinition
+	out.ResourceName = in.ResourceName
+	return nil
+}
+
+// Convert_v1alpha2_RemoteKustomizationReference_To_manifest_RemoteKustomizationReference is an autogenerated conversion function.
+func Convert_v1alpha2_RemoteKustomizationReference_To_manifest_RemoteKustomizationReference(in *RemoteKustomizationReference, out *manifest.RemoteKustomizationReference, s conversion.Scope) error {
+	return autoConvert_v1alpha2_RemoteKustomizationReference_To_manifest_RemoteKustomizationReference(in, out, s)
+}
+
+func autoConvert_manifest_RemoteKustomizationReference_To_v1alpha2_RemoteKustomizationReference(in *manifest.RemoteKustomizationReference, out *RemoteKustomizationReference, s conversion.Scope) error {
+	out.ComponentDescriptorDefinition = in.ComponentDescriptorDefinition
+	out.ResourceName = in.ResourceName
+	return nil
+}
+
+// Convert_manifest_RemoteKustomizationReference_To_v1alpha2_RemoteKustomizationReference is an autogenerated conversion function.
+func Convert_manifest_RemoteKustomizationReference_To_v1alpha2_RemoteKustomizationReference(in *manifest.RemoteKustomizationReference, out *RemoteKustomizationReference, s conversion.Scope) error {
+	return autoConvert_manifest_RemoteKustomizationReference_To_v1alpha2_RemoteKustomizationReference(in, out, s)
+}
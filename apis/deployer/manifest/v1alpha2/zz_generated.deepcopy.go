@@ -13,6 +13,7 @@ package v1alpha2
 import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
+	config "github.com/gardener/landscaper/apis/config"
 	v1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	continuousreconcile "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
 	managedresource "github.com/gardener/landscaper/apis/deployer/utils/managedresource"
@@ -29,6 +30,11 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(config.OCIConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Export.DeepCopyInto(&out.Export)
 	in.Controller.DeepCopyInto(&out.Controller)
 	return
@@ -90,10 +96,43 @@ func (in *ExportConfiguration) DeepCopy() *ExportConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KustomizeSource) DeepCopyInto(out *KustomizeSource) {
+	*out = *in
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.FromResource != nil {
+		in, out := &in.FromResource, &out.FromResource
+		*out = new(RemoteKustomizationReference)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KustomizeSource.
+func (in *KustomizeSource) DeepCopy() *KustomizeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(KustomizeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
+	if in.ServerSideApply != nil {
+		in, out := &in.ServerSideApply, &out.ServerSideApply
+		*out = new(managedresource.ServerSideApplyConfiguration)
+		**out = **in
+	}
 	in.ReadinessChecks.DeepCopyInto(&out.ReadinessChecks)
 	if in.DeleteTimeout != nil {
 		in, out := &in.DeleteTimeout, &out.DeleteTimeout
@@ -107,6 +146,11 @@ func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Kustomize != nil {
+		in, out := &in.Kustomize, &out.Kustomize
+		*out = new(KustomizeSource)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Exports != nil {
 		in, out := &in.Exports, &out.Exports
 		*out = new(managedresource.Exports)
@@ -117,6 +161,11 @@ func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 		*out = new(continuousreconcile.ContinuousReconcileSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(managedresource.DriftDetectionSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -147,6 +196,11 @@ func (in *ProviderStatus) DeepCopyInto(out *ProviderStatus) {
 		*out = make(managedresource.ManagedResourceStatusList, len(*in))
 		copy(*out, *in)
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(managedresource.DriftStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -167,3 +221,20 @@ func (in *ProviderStatus) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteKustomizationReference) DeepCopyInto(out *RemoteKustomizationReference) {
+	*out = *in
+	in.ComponentDescriptorDefinition.DeepCopyInto(&out.ComponentDescriptorDefinition)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteKustomizationReference.
+func (in *RemoteKustomizationReference) DeepCopy() *RemoteKustomizationReference {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteKustomizationReference)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -5,6 +5,9 @@
 package validation
 
 import (
+	"path"
+	"strings"
+
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/landscaper/apis/deployer/utils/managedresource/validation"
@@ -12,6 +15,7 @@ import (
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
 	crval "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile/validation"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 	health "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks/validation"
 )
 
@@ -19,13 +23,59 @@ import (
 func ValidateProviderConfiguration(config *manifestv1alpha2.ProviderConfiguration) error {
 	var allErrs field.ErrorList
 	allErrs = append(allErrs, validation.ValidateManifestList(field.NewPath(""), config.Manifests)...)
+	allErrs = append(allErrs, ValidateKustomizeSource(field.NewPath("kustomize"), config.Kustomize)...)
 	allErrs = append(allErrs, ValidateTimeout(field.NewPath("deleteTimeout"), config.DeleteTimeout)...)
 	allErrs = append(allErrs, ValidateTimeout(field.NewPath("readinessChecks", "timeout"), config.ReadinessChecks.Timeout)...)
 	allErrs = append(allErrs, health.ValidateReadinessCheckConfiguration(field.NewPath(""), &config.ReadinessChecks)...)
 	allErrs = append(allErrs, crval.ValidateContinuousReconcileSpec(field.NewPath("continuousReconcile"), config.ContinuousReconcile)...)
+	allErrs = append(allErrs, validation.ValidateDriftDetectionSpec(field.NewPath("driftDetection"), config.DriftDetection)...)
 	return allErrs.ToAggregate()
 }
 
+// ValidateKustomizeSource validates a kustomization source.
+func ValidateKustomizeSource(fldPath *field.Path, source *manifestv1alpha2.KustomizeSource) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if source == nil {
+		return allErrs
+	}
+	if len(source.Files) == 0 && source.FromResource == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "files or fromResource must be defined"))
+	}
+	if len(source.Files) != 0 && source.FromResource != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("fromResource"), "only one of files or fromResource can be defined"))
+	}
+	if source.FromResource != nil && len(source.FromResource.ResourceName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("fromResource", "resourceName"), "resource name must be defined"))
+	}
+	if !isLocalPath(source.Path) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("path"), source.Path, "path must be relative to the root of the source"))
+	}
+	for path := range source.Files {
+		if len(path) == 0 || !isLocalPath(path) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("files").Key(path), path, "path must be relative to the root of the source"))
+		}
+	}
+	switch source.Policy {
+	case "", managedresource.ManagePolicy, managedresource.FallbackPolicy, managedresource.KeepPolicy,
+		managedresource.IgnorePolicy, managedresource.ImmutablePolicy:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("policy"), source.Policy, []string{
+			string(managedresource.ManagePolicy), string(managedresource.FallbackPolicy), string(managedresource.KeepPolicy),
+			string(managedresource.IgnorePolicy), string(managedresource.ImmutablePolicy),
+		}))
+	}
+	return allErrs
+}
+
+// isLocalPath checks whether the path is relative and does not leave its root.
+func isLocalPath(p string) bool {
+	if path.IsAbs(p) {
+		return false
+	}
+	cleaned := path.Clean(p)
+	return cleaned != ".." && !strings.HasPrefix(cleaned, "../")
+}
+
 // ValidateTimeout validates a timeout.
 func ValidateTimeout(fldPath *field.Path, timeout *lsv1alpha1.Duration) field.ErrorList {
 	allErrs := field.ErrorList{}
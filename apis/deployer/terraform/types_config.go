@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package terraform
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsconfigv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Configuration is the terraform deployer configuration that configures the controller.
+type Configuration struct {
+	metav1.TypeMeta `json:",inline"`
+	// Identity identity describes the unique identity of the deployer.
+	// +optional
+	Identity string `json:"identity,omitempty"`
+	// TargetSelector describes all selectors the deployer should depend on.
+	TargetSelector []lsv1alpha1.TargetSelector `json:"targetSelector,omitempty"`
+	// FailOnUnhealthyTarget lets deploy items fail fast with a recoverable error, if the target health check
+	// of the landscaper has recently found their target unreachable.
+	FailOnUnhealthyTarget bool `json:"failOnUnhealthyTarget,omitempty"`
+	// Namespace is the namespace in the host cluster where the runner pods are executed
+	// and the terraform states are stored.
+	// Defaults to "default".
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// DefaultImage is the runner image that is used for all deploy items that do not define an image.
+	// +optional
+	DefaultImage string `json:"defaultImage,omitempty"`
+	// Controller contains configuration concerning the controller framework.
+	Controller Controller `json:"controller,omitempty"`
+}
+
+// Controller contains configuration concerning the controller framework.
+type Controller struct {
+	lsconfigv1alpha1.CommonControllerConfig
+}
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package terraform
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// DeployItemNameLabel describes the label that is added to every resource that is created by the terraform deployer
+// to define the name of its source deploy item.
+const DeployItemNameLabel = "terraform.deployer.landscaper.gardener.cloud/deployitem-name"
+
+// DeployItemNamespaceLabel describes the label that is added to every resource that is created by the terraform deployer
+// to define the namespace of its source deploy item.
+const DeployItemNamespaceLabel = "terraform.deployer.landscaper.gardener.cloud/deployitem-namespace"
+
+// GeneratedFilePrefix is the prefix of the files that are generated by the terraform deployer
+// in the root directory of the terraform configuration.
+const GeneratedFilePrefix = "zz_landscaper_"
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProviderConfiguration is the terraform deployer configuration that is expected in a DeployItem.
+type ProviderConfiguration struct {
+	metav1.TypeMeta `json:",inline"`
+	// Image is the image of the runner that executes terraform.
+	// Defaults to the runner image that is configured for the deployer.
+	// +optional
+	Image string `json:"image,omitempty"`
+	// Binary is the name of the terraform binary in the runner image.
+	// Use "tofu" to run OpenTofu instead of terraform.
+	// Defaults to "terraform".
+	// +optional
+	Binary string `json:"binary,omitempty"`
+	// Files contains the terraform configuration as a map of relative file paths to their content.
+	Files map[string]string `json:"files"`
+	// Variables contains the values of the input variables of the terraform configuration.
+	// +optional
+	Variables *runtime.RawExtension `json:"variables,omitempty"`
+	// TargetVariable is the name of an input variable that is set to the configuration of the target of the deploy item.
+	// +optional
+	TargetVariable string `json:"targetVariable,omitempty"`
+	// Env defines additional environment variables of the terraform process.
+	// +optional
+	Env map[string]string `json:"env,omitempty"`
+	// Timeout is the time to wait for the completion of a terraform run.
+	// Defaults to 30 minutes.
+	// +optional
+	Timeout *lsv1alpha1.Duration `json:"timeout,omitempty"`
+	// Exports describe the terraform outputs that are exported by the deploy item.
+	// +optional
+	Exports []Export `json:"exports,omitempty"`
+}
+
+// Export describes a terraform output that is exported by the deploy item.
+type Export struct {
+	// Key is the key of the export.
+	Key string `json:"key"`
+	// FromOutput is the name of the terraform output.
+	FromOutput string `json:"fromOutput"`
+}
+
+// Operation describes a terraform operation.
+type Operation string
+
+const (
+	// OperationApply applies the terraform configuration.
+	OperationApply Operation = "apply"
+	// OperationDestroy destroys all resources that are managed by the terraform configuration.
+	OperationDestroy Operation = "destroy"
+)
+
+// RunPhase describes the phase of a terraform run.
+type RunPhase string
+
+const (
+	// RunPhaseRunning is the phase of a terraform run that has not yet completed.
+	RunPhaseRunning RunPhase = "Running"
+	// RunPhaseSucceeded is the phase of a terraform run that has completed successfully.
+	RunPhaseSucceeded RunPhase = "Succeeded"
+	// RunPhaseFailed is the phase of a terraform run that has failed.
+	RunPhaseFailed RunPhase = "Failed"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProviderStatus is the terraform provider specific status
+type ProviderStatus struct {
+	metav1.TypeMeta `json:",inline"`
+	// LastOperation is the terraform operation of the last run.
+	// +optional
+	LastOperation Operation `json:"lastOperation,omitempty"`
+	// Pod is the name of the runner pod of the last run.
+	// +optional
+	Pod string `json:"pod,omitempty"`
+	// Phase is the phase of the last run.
+	// +optional
+	Phase RunPhase `json:"phase,omitempty"`
+	// StartTime is the time when the last run was started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time when the last run has completed successfully.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Message describes why the last run has failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
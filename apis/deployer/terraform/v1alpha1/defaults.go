@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	lsconfigv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// DefaultBinary is the default name of the terraform binary.
+const DefaultBinary = "terraform"
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+// SetDefaults_ProviderConfiguration sets the defaults for the terraform deployer provider configuration.
+func SetDefaults_ProviderConfiguration(obj *ProviderConfiguration) {
+	if len(obj.Binary) == 0 {
+		obj.Binary = DefaultBinary
+	}
+	if obj.Timeout == nil {
+		obj.Timeout = &lsv1alpha1.Duration{Duration: 30 * time.Minute}
+	}
+}
+
+// SetDefaults_Configuration sets the defaults for the terraform deployer controller configuration.
+func SetDefaults_Configuration(obj *Configuration) {
+	if len(obj.Namespace) == 0 {
+		obj.Namespace = metav1.NamespaceDefault
+	}
+	lsconfigv1alpha1.SetDefaults_CommonControllerConfig(&obj.Controller.CommonControllerConfig)
+}
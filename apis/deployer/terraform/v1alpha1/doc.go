@@ -0,0 +1,12 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package v1alpha1 is the v1alpha1 version of the API.
+// +k8s:deepcopy-gen=package
+// +k8s:conversion-gen=github.com/gardener/landscaper/apis/deployer/terraform
+// +k8s:openapi-gen=true
+// +k8s:defaulter-gen=TypeMeta
+
+// +groupName=terraform.deployer.landscaper.gardener.cloud
+package v1alpha1
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"encoding/json"
+	"path"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	terraformv1alpha1 "github.com/gardener/landscaper/apis/deployer/terraform/v1alpha1"
+)
+
+// ValidateProviderConfiguration validates a terraform provider configuration.
+func ValidateProviderConfiguration(config *terraformv1alpha1.ProviderConfiguration) error {
+	var allErrs field.ErrorList
+	if len(config.Binary) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("binary"), "binary must be defined"))
+	}
+	allErrs = append(allErrs, ValidateFiles(field.NewPath("files"), config.Files)...)
+	allErrs = append(allErrs, ValidateVariables(field.NewPath("variables"), config.Variables)...)
+	if len(config.TargetVariable) != 0 && !isIdentifier(config.TargetVariable) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("targetVariable"), config.TargetVariable, "must be a valid terraform identifier"))
+	}
+	allErrs = append(allErrs, ValidateTimeout(field.NewPath("timeout"), config.Timeout)...)
+	allErrs = append(allErrs, ValidateExports(field.NewPath("exports"), config.Exports)...)
+	return allErrs.ToAggregate()
+}
+
+// ValidateFiles validates the terraform configuration files.
+// The file paths have to be relative paths that do not leave the configuration directory.
+func ValidateFiles(fldPath *field.Path, files map[string]string) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(files) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one file must be defined"))
+		return allErrs
+	}
+	for filePath := range files {
+		filePath := filePath
+		if len(filePath) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath, filePath, "file path must not be empty"))
+			continue
+		}
+		clean := path.Clean(filePath)
+		if path.IsAbs(filePath) || clean != filePath || clean == ".." || strings.HasPrefix(clean, "../") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(filePath), filePath, "file path must be a clean relative path inside the configuration directory"))
+			continue
+		}
+		if strings.HasPrefix(filePath, terraformv1alpha1.GeneratedFilePrefix) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(filePath), filePath,
+				"file names with the prefix "+terraformv1alpha1.GeneratedFilePrefix+" are reserved for the deployer"))
+		}
+	}
+	return allErrs
+}
+
+// ValidateVariables validates that the variables are a json object.
+func ValidateVariables(fldPath *field.Path, variables *runtime.RawExtension) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if variables == nil || len(variables.Raw) == 0 {
+		return allErrs
+	}
+	values := map[string]json.RawMessage{}
+	if err := json.Unmarshal(variables.Raw, &values); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, string(variables.Raw), "variables must be a json object"))
+	}
+	return allErrs
+}
+
+// ValidateExports validates the exports of a terraform provider configuration.
+func ValidateExports(fldPath *field.Path, exports []terraformv1alpha1.Export) field.ErrorList {
+	allErrs := field.ErrorList{}
+	keys := sets.NewString()
+	for i, export := range exports {
+		expPath := fldPath.Index(i)
+		if len(export.Key) == 0 {
+			allErrs = append(allErrs, field.Required(expPath.Child("key"), "key must be defined"))
+		} else if keys.Has(export.Key) {
+			allErrs = append(allErrs, field.Duplicate(expPath.Child("key"), export.Key))
+		}
+		keys.Insert(export.Key)
+		if len(export.FromOutput) == 0 {
+			allErrs = append(allErrs, field.Required(expPath.Child("fromOutput"), "fromOutput must be defined"))
+		}
+	}
+	return allErrs
+}
+
+// ValidateTimeout validates a timeout.
+func ValidateTimeout(fldPath *field.Path, timeout *lsv1alpha1.Duration) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if timeout == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "timeout can not be empty"))
+		return allErrs
+	}
+	if timeout.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, timeout, "timeout can not be negative"))
+	}
+	return allErrs
+}
+
+// isIdentifier checks whether the name is a valid terraform identifier.
+// Identifiers contain letters, digits, underscores and dashes and must not start with a digit.
+func isIdentifier(name string) bool {
+	for i, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '_':
+		case (r >= '0' && r <= '9') || r == '-':
+			if i == 0 {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
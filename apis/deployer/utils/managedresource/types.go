@@ -6,6 +6,7 @@ package managedresource
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -45,6 +46,18 @@ type Manifest struct {
 	AnnotateBeforeDelete map[string]string `json:"annotateBeforeDelete,omitempty"`
 }
 
+// ServerSideApplyConfiguration configures how manifests are applied with the server-side apply update strategy.
+type ServerSideApplyConfiguration struct {
+	// FieldManager is the name of the field manager that owns the applied fields.
+	// Defaults to "landscaper".
+	// +optional
+	FieldManager string `json:"fieldManager,omitempty"`
+	// ForceConflicts takes over the ownership of fields that are managed by another field manager.
+	// If false, the apply fails if a field with a different value is owned by another field manager.
+	// +optional
+	ForceConflicts bool `json:"forceConflicts,omitempty"`
+}
+
 // ManagedResourceStatusList describes a list of managed resource statuses.
 type ManagedResourceStatusList []ManagedResourceStatus
 
@@ -81,6 +94,53 @@ type ManagedResourceStatus struct {
 	Resource corev1.ObjectReference `json:"resource"`
 }
 
+// DriftDetectionPolicy defines how drifted resources are handled.
+type DriftDetectionPolicy string
+
+const (
+	// DriftDetectionPolicyReport only reports drifted resources in the provider status of the deploy item.
+	DriftDetectionPolicyReport DriftDetectionPolicy = "report"
+	// DriftDetectionPolicyAutoCorrect reports drifted resources and re-applies their manifests.
+	DriftDetectionPolicyAutoCorrect DriftDetectionPolicy = "autoCorrect"
+)
+
+// DriftDetectionSpec configures the periodic comparison of the managed resources with their last applied manifests.
+type DriftDetectionSpec struct {
+	// Every is the interval in which the managed resources are checked for drift.
+	Every *lsv1alpha1.Duration `json:"every"`
+	// Policy defines how drifted resources are handled.
+	// Defaults to "report".
+	// +optional
+	Policy DriftDetectionPolicy `json:"policy,omitempty"`
+}
+
+// DriftStatus describes the result of the last drift detection.
+type DriftStatus struct {
+	// LastCheckTime is the time when the managed resources have been checked for drift the last time.
+	LastCheckTime metav1.Time `json:"lastCheckTime"`
+	// DriftedResources contains the managed resources that differ from their last applied manifests.
+	// +optional
+	DriftedResources []DriftedResource `json:"driftedResources,omitempty"`
+}
+
+// DriftedResource describes a managed resource that differs from its last applied manifest.
+type DriftedResource struct {
+	// Resource describes the drifted kubernetes resource.
+	Resource corev1.ObjectReference `json:"resource"`
+	// Fields contains the paths of the fields that differ from the last applied manifest.
+	// +optional
+	Fields []string `json:"fields,omitempty"`
+	// Missing is true if the resource does not exist anymore.
+	// +optional
+	Missing bool `json:"missing,omitempty"`
+	// Managers contains the field managers that own the drifted fields.
+	// +optional
+	Managers []string `json:"managers,omitempty"`
+	// Corrected is true if the manifest of the resource has been re-applied.
+	// +optional
+	Corrected bool `json:"corrected,omitempty"`
+}
+
 // Exports describes one export that is read from a resource.
 type Exports struct {
 	// DefaultTimeout defines the default timeout for all exports
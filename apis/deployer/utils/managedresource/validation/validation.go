@@ -30,6 +30,26 @@ func ValidateManifest(fldPath *field.Path, manifest managedresource.Manifest) fi
 	return allErrs
 }
 
+// ValidateDriftDetectionSpec validates a drift detection configuration.
+func ValidateDriftDetectionSpec(fldPath *field.Path, spec *managedresource.DriftDetectionSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil {
+		return allErrs
+	}
+	if spec.Every == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("every"), "interval must be defined"))
+	} else if spec.Every.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("every"), spec.Every.Duration.String(), "interval must be positive"))
+	}
+	switch spec.Policy {
+	case "", managedresource.DriftDetectionPolicyReport, managedresource.DriftDetectionPolicyAutoCorrect:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("policy"), spec.Policy,
+			[]string{string(managedresource.DriftDetectionPolicyReport), string(managedresource.DriftDetectionPolicyAutoCorrect)}))
+	}
+	return allErrs
+}
+
 // ValidateManifestExport validates a readiness check configuration
 func ValidateManifestExport(fldPath *field.Path, export *managedresource.Export) field.ErrorList {
 	var allErrs field.ErrorList
@@ -6,6 +6,7 @@ package validation_test
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -29,6 +30,37 @@ var _ = Describe("Validation", func() {
 		fld = field.NewPath("a")
 	)
 
+	Context("DriftDetection", func() {
+		It("should accept a valid drift detection configuration", func() {
+			spec := &managedresource.DriftDetectionSpec{
+				Every:  &lsv1alpha1.Duration{Duration: 10 * time.Minute},
+				Policy: managedresource.DriftDetectionPolicyAutoCorrect,
+			}
+			Expect(validation.ValidateDriftDetectionSpec(fld, spec)).To(HaveLen(0))
+		})
+
+		It("should deny a drift detection configuration without interval", func() {
+			spec := &managedresource.DriftDetectionSpec{}
+			allErrs := validation.ValidateDriftDetectionSpec(fld, spec)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("a.every"),
+			}))))
+		})
+
+		It("should deny an unknown policy", func() {
+			spec := &managedresource.DriftDetectionSpec{
+				Every:  &lsv1alpha1.Duration{Duration: 10 * time.Minute},
+				Policy: "ignore",
+			}
+			allErrs := validation.ValidateDriftDetectionSpec(fld, spec)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("a.policy"),
+			}))))
+		})
+	})
+
 	Context("Export", func() {
 		It("should accept if a key and a jsonpath is set", func() {
 			export := &managedresource.Export{
@@ -55,6 +55,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/config.OCICacheConfiguration":                                     schema_gardener_landscaper_apis_config_OCICacheConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config.OCIConfiguration":                                          schema_gardener_landscaper_apis_config_OCIConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config.RegistryConfiguration":                                     schema_gardener_landscaper_apis_config_RegistryConfiguration(ref),
+		"github.com/gardener/landscaper/apis/config.TargetsController":                                         schema_gardener_landscaper_apis_config_TargetsController(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.AgentConfiguration":                               schema_landscaper_apis_config_v1alpha1_AgentConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.BlueprintStore":                                   schema_landscaper_apis_config_v1alpha1_BlueprintStore(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig":                           schema_landscaper_apis_config_v1alpha1_CommonControllerConfig(ref),
@@ -77,6 +78,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/config/v1alpha1.OCICacheConfiguration":                            schema_landscaper_apis_config_v1alpha1_OCICacheConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.OCIConfiguration":                                 schema_landscaper_apis_config_v1alpha1_OCIConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.RegistryConfiguration":                            schema_landscaper_apis_config_v1alpha1_RegistryConfiguration(ref),
+		"github.com/gardener/landscaper/apis/config/v1alpha1.TargetsController":                                schema_landscaper_apis_config_v1alpha1_TargetsController(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON":                                            schema_landscaper_apis_core_v1alpha1_AnyJSON(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcile":                                 schema_landscaper_apis_core_v1alpha1_AutomaticReconcile(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus":                           schema_landscaper_apis_core_v1alpha1_AutomaticReconcileStatus(ref),
@@ -92,6 +94,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrites":                         schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwrites(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwritesList":                     schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwritesList(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.Condition":                                          schema_landscaper_apis_core_v1alpha1_Condition(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.ConfigMapExport":                                    schema_landscaper_apis_core_v1alpha1_ConfigMapExport(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ConfigMapReference":                                 schema_landscaper_apis_core_v1alpha1_ConfigMapReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.Context":                                            schema_landscaper_apis_core_v1alpha1_Context(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ContextList":                                        schema_landscaper_apis_core_v1alpha1_ContextList(ref),
@@ -122,33 +125,50 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionSpec":                                      schema_landscaper_apis_core_v1alpha1_ExecutionSpec(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionStatus":                                    schema_landscaper_apis_core_v1alpha1_ExecutionStatus(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ExportDefinition":                                   schema_landscaper_apis_core_v1alpha1_ExportDefinition(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.ExportTransformation":                               schema_landscaper_apis_core_v1alpha1_ExportTransformation(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.FailedReconcile":                                    schema_landscaper_apis_core_v1alpha1_FailedReconcile(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.FieldValueDefinition":                               schema_landscaper_apis_core_v1alpha1_FieldValueDefinition(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition":                                   schema_landscaper_apis_core_v1alpha1_ImportDefinition(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.ImportGrant":                                        schema_landscaper_apis_core_v1alpha1_ImportGrant(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.ImportGrantFrom":                                    schema_landscaper_apis_core_v1alpha1_ImportGrantFrom(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.ImportGrantList":                                    schema_landscaper_apis_core_v1alpha1_ImportGrantList(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.ImportGrantSpec":                                    schema_landscaper_apis_core_v1alpha1_ImportGrantSpec(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.ImportGrantTo":                                      schema_landscaper_apis_core_v1alpha1_ImportGrantTo(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ImportStatus":                                       schema_landscaper_apis_core_v1alpha1_ImportStatus(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InlineBlueprint":                                    schema_landscaper_apis_core_v1alpha1_InlineBlueprint(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.Installation":                                       schema_landscaper_apis_core_v1alpha1_Installation(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports":                                schema_landscaper_apis_core_v1alpha1_InstallationExports(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports":                                schema_landscaper_apis_core_v1alpha1_InstallationImports(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationList":                                   schema_landscaper_apis_core_v1alpha1_InstallationList(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationPlan":                                   schema_landscaper_apis_core_v1alpha1_InstallationPlan(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationRevision":                               schema_landscaper_apis_core_v1alpha1_InstallationRevision(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationSpec":                                   schema_landscaper_apis_core_v1alpha1_InstallationSpec(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationStatus":                                 schema_landscaper_apis_core_v1alpha1_InstallationStatus(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplate":                               schema_landscaper_apis_core_v1alpha1_InstallationTemplate(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition":            schema_landscaper_apis_core_v1alpha1_InstallationTemplateBlueprintDefinition(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition":                               schema_landscaper_apis_core_v1alpha1_JSONSchemaDefinition(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.LocalConfigMapReference":                            schema_landscaper_apis_core_v1alpha1_LocalConfigMapReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference":                               schema_landscaper_apis_core_v1alpha1_LocalSecretReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.LsHealthCheck":                                      schema_landscaper_apis_core_v1alpha1_LsHealthCheck(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.LsHealthCheckList":                                  schema_landscaper_apis_core_v1alpha1_LsHealthCheckList(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceWindow":                                  schema_landscaper_apis_core_v1alpha1_MaintenanceWindow(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.NamedObjectReference":                               schema_landscaper_apis_core_v1alpha1_NamedObjectReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference":                                    schema_landscaper_apis_core_v1alpha1_ObjectReference(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.PlannedChange":                                      schema_landscaper_apis_core_v1alpha1_PlannedChange(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.RemoteBlueprintReference":                           schema_landscaper_apis_core_v1alpha1_RemoteBlueprintReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.Requirement":                                        schema_landscaper_apis_core_v1alpha1_Requirement(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ResolvedTarget":                                     schema_landscaper_apis_core_v1alpha1_ResolvedTarget(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ResourceReference":                                  schema_landscaper_apis_core_v1alpha1_ResourceReference(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.RollbackPolicy":                                     schema_landscaper_apis_core_v1alpha1_RollbackPolicy(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.RollbackStatus":                                     schema_landscaper_apis_core_v1alpha1_RollbackStatus(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStatus":                                      schema_landscaper_apis_core_v1alpha1_RolloutStatus(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStrategy":                                    schema_landscaper_apis_core_v1alpha1_RolloutStrategy(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.SecretExport":                                       schema_landscaper_apis_core_v1alpha1_SecretExport(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.SecretLabelSelectorRef":                             schema_landscaper_apis_core_v1alpha1_SecretLabelSelectorRef(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.SecretReference":                                    schema_landscaper_apis_core_v1alpha1_SecretReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataSource":                                   schema_landscaper_apis_core_v1alpha1_StaticDataSource(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom":                                schema_landscaper_apis_core_v1alpha1_StaticDataValueFrom(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.SubinstallationRevision":                            schema_landscaper_apis_core_v1alpha1_SubinstallationRevision(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.SubinstallationTemplate":                            schema_landscaper_apis_core_v1alpha1_SubinstallationTemplate(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.SucceededReconcile":                                 schema_landscaper_apis_core_v1alpha1_SucceededReconcile(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TLSClientConfig":                                    schema_landscaper_apis_core_v1alpha1_TLSClientConfig(ref),
@@ -159,6 +179,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TargetList":                                         schema_landscaper_apis_core_v1alpha1_TargetList(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector":                                     schema_landscaper_apis_core_v1alpha1_TargetSelector(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSpec":                                         schema_landscaper_apis_core_v1alpha1_TargetSpec(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.TargetStatus":                                       schema_landscaper_apis_core_v1alpha1_TargetStatus(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSync":                                         schema_landscaper_apis_core_v1alpha1_TargetSync(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncList":                                     schema_landscaper_apis_core_v1alpha1_TargetSyncList(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncSpec":                                     schema_landscaper_apis_core_v1alpha1_TargetSyncSpec(ref),
@@ -193,11 +214,25 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmChartRepoCredentials":                  schema_apis_deployer_helm_v1alpha1_HelmChartRepoCredentials(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration":               schema_apis_deployer_helm_v1alpha1_HelmDeploymentConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmInstallConfiguration":                  schema_apis_deployer_helm_v1alpha1_HelmInstallConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmTestConfiguration":                     schema_apis_deployer_helm_v1alpha1_HelmTestConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmUninstallConfiguration":                schema_apis_deployer_helm_v1alpha1_HelmUninstallConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HookStatus":                                schema_apis_deployer_helm_v1alpha1_HookStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.KustomizePostRenderer":                     schema_apis_deployer_helm_v1alpha1_KustomizePostRenderer(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.PatchTarget":                               schema_apis_deployer_helm_v1alpha1_PatchTarget(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.PostRenderer":                              schema_apis_deployer_helm_v1alpha1_PostRenderer(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.PostRendererPatch":                         schema_apis_deployer_helm_v1alpha1_PostRendererPatch(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ProviderConfiguration":                     schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ProviderStatus":                            schema_apis_deployer_helm_v1alpha1_ProviderStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ReleaseRevision":                           schema_apis_deployer_helm_v1alpha1_ReleaseRevision(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ReleaseStatus":                             schema_apis_deployer_helm_v1alpha1_ReleaseStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteArchiveAccess":                       schema_apis_deployer_helm_v1alpha1_RemoteArchiveAccess(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteChartReference":                      schema_apis_deployer_helm_v1alpha1_RemoteChartReference(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ResolvedChartVersion":                      schema_apis_deployer_helm_v1alpha1_ResolvedChartVersion(ref),
+		"github.com/gardener/landscaper/apis/deployer/job/v1alpha1.Configuration":                              schema_apis_deployer_job_v1alpha1_Configuration(ref),
+		"github.com/gardener/landscaper/apis/deployer/job/v1alpha1.Controller":                                 schema_apis_deployer_job_v1alpha1_Controller(ref),
+		"github.com/gardener/landscaper/apis/deployer/job/v1alpha1.ExportConfiguration":                        schema_apis_deployer_job_v1alpha1_ExportConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/job/v1alpha1.ProviderConfiguration":                      schema_apis_deployer_job_v1alpha1_ProviderConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/job/v1alpha1.ProviderStatus":                             schema_apis_deployer_job_v1alpha1_ProviderStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.Configuration":                         schema_apis_deployer_manifest_v1alpha1_Configuration(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.Controller":                            schema_apis_deployer_manifest_v1alpha1_Controller(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.ExportConfiguration":                   schema_apis_deployer_manifest_v1alpha1_ExportConfiguration(ref),
@@ -206,16 +241,27 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.Configuration":                         schema_apis_deployer_manifest_v1alpha2_Configuration(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.Controller":                            schema_apis_deployer_manifest_v1alpha2_Controller(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.ExportConfiguration":                   schema_apis_deployer_manifest_v1alpha2_ExportConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.KustomizeSource":                       schema_apis_deployer_manifest_v1alpha2_KustomizeSource(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.ProviderConfiguration":                 schema_apis_deployer_manifest_v1alpha2_ProviderConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.ProviderStatus":                        schema_apis_deployer_manifest_v1alpha2_ProviderStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.RemoteKustomizationReference":          schema_apis_deployer_manifest_v1alpha2_RemoteKustomizationReference(ref),
 		"github.com/gardener/landscaper/apis/deployer/mock/v1alpha1.Configuration":                             schema_apis_deployer_mock_v1alpha1_Configuration(ref),
 		"github.com/gardener/landscaper/apis/deployer/mock/v1alpha1.ProviderConfiguration":                     schema_apis_deployer_mock_v1alpha1_ProviderConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/terraform/v1alpha1.Configuration":                        schema_apis_deployer_terraform_v1alpha1_Configuration(ref),
+		"github.com/gardener/landscaper/apis/deployer/terraform/v1alpha1.Controller":                           schema_apis_deployer_terraform_v1alpha1_Controller(ref),
+		"github.com/gardener/landscaper/apis/deployer/terraform/v1alpha1.Export":                               schema_apis_deployer_terraform_v1alpha1_Export(ref),
+		"github.com/gardener/landscaper/apis/deployer/terraform/v1alpha1.ProviderConfiguration":                schema_apis_deployer_terraform_v1alpha1_ProviderConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/terraform/v1alpha1.ProviderStatus":                       schema_apis_deployer_terraform_v1alpha1_ProviderStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec":       schema_apis_deployer_utils_continuousreconcile_ContinuousReconcileSpec(ref),
+		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftDetectionSpec":                schema_apis_deployer_utils_managedresource_DriftDetectionSpec(ref),
+		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftStatus":                       schema_apis_deployer_utils_managedresource_DriftStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftedResource":                   schema_apis_deployer_utils_managedresource_DriftedResource(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export":                            schema_apis_deployer_utils_managedresource_Export(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports":                           schema_apis_deployer_utils_managedresource_Exports(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.FromObjectReference":               schema_apis_deployer_utils_managedresource_FromObjectReference(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus":             schema_apis_deployer_utils_managedresource_ManagedResourceStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest":                          schema_apis_deployer_utils_managedresource_Manifest(ref),
+		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration":      schema_apis_deployer_utils_managedresource_ServerSideApplyConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.CustomReadinessCheckConfiguration": schema_apis_deployer_utils_readinesschecks_CustomReadinessCheckConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.LabelSelectorSpec":                 schema_apis_deployer_utils_readinesschecks_LabelSelectorSpec(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration":       schema_apis_deployer_utils_readinesschecks_ReadinessCheckConfiguration(ref),
@@ -1352,12 +1398,19 @@ func schema_gardener_landscaper_apis_config_Controllers(ref common.ReferenceCall
 							Ref:         ref("github.com/gardener/landscaper/apis/config.ContextsController"),
 						},
 					},
+					"Targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets contains the controller config that checks the health of targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/config.TargetsController"),
+						},
+					},
 				},
-				Required: []string{"SyncPeriod", "Installations", "Executions", "DeployItems", "Contexts"},
+				Required: []string{"SyncPeriod", "Installations", "Executions", "DeployItems", "Contexts", "Targets"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config.ContextsController", "github.com/gardener/landscaper/apis/config.DeployItemsController", "github.com/gardener/landscaper/apis/config.ExecutionsController", "github.com/gardener/landscaper/apis/config.InstallationsController", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/gardener/landscaper/apis/config.ContextsController", "github.com/gardener/landscaper/apis/config.DeployItemsController", "github.com/gardener/landscaper/apis/config.ExecutionsController", "github.com/gardener/landscaper/apis/config.InstallationsController", "github.com/gardener/landscaper/apis/config.TargetsController", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -1929,6 +1982,48 @@ func schema_gardener_landscaper_apis_config_RegistryConfiguration(ref common.Ref
 	}
 }
 
+func schema_gardener_landscaper_apis_config_TargetsController(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetsController contains the controller config that periodically checks the health of targets. Only targets of types that are known to the landscaper are checked.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"CommonControllerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/config.CommonControllerConfig"),
+						},
+					},
+					"Disable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disable disables the health checks of targets.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"CheckInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CheckInterval defines the interval in which the health of a target is checked. Defaults to 5 minutes.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"CheckTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CheckTimeout defines how long a health check of a target may take. Defaults to 10 seconds.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"CommonControllerConfig", "Disable"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/config.CommonControllerConfig", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_landscaper_apis_config_v1alpha1_AgentConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2213,12 +2308,19 @@ func schema_landscaper_apis_config_v1alpha1_Controllers(ref common.ReferenceCall
 							Ref:         ref("github.com/gardener/landscaper/apis/config/v1alpha1.ContextsController"),
 						},
 					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets contains the controller config that checks the health of targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/config/v1alpha1.TargetsController"),
+						},
+					},
 				},
-				Required: []string{"syncPeriod", "installations", "executions", "deployItems", "contexts"},
+				Required: []string{"syncPeriod", "installations", "executions", "deployItems", "contexts", "targets"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config/v1alpha1.ContextsController", "github.com/gardener/landscaper/apis/config/v1alpha1.DeployItemsController", "github.com/gardener/landscaper/apis/config/v1alpha1.ExecutionsController", "github.com/gardener/landscaper/apis/config/v1alpha1.InstallationsController", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/gardener/landscaper/apis/config/v1alpha1.ContextsController", "github.com/gardener/landscaper/apis/config/v1alpha1.DeployItemsController", "github.com/gardener/landscaper/apis/config/v1alpha1.ExecutionsController", "github.com/gardener/landscaper/apis/config/v1alpha1.InstallationsController", "github.com/gardener/landscaper/apis/config/v1alpha1.TargetsController", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -2794,6 +2896,48 @@ func schema_landscaper_apis_config_v1alpha1_RegistryConfiguration(ref common.Ref
 	}
 }
 
+func schema_landscaper_apis_config_v1alpha1_TargetsController(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetsController contains the controller config that periodically checks the health of targets. Only targets of types that are known to the landscaper are checked.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"CommonControllerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
+						},
+					},
+					"disable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disable disables the health checks of targets.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"checkInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CheckInterval defines the interval in which the health of a target is checked. Defaults to 5 minutes.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"checkTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CheckTimeout defines how long a health check of a target may take. Defaults to 10 seconds.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"CommonControllerConfig", "disable"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_landscaper_apis_core_v1alpha1_AnyJSON(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3019,6 +3163,12 @@ func schema_landscaper_apis_core_v1alpha1_Blueprint(ref common.ReferenceCallback
 							},
 						},
 					},
+					"rolloutStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RolloutStrategy defines how the deploy items of the deploy executions are rolled out.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStrategy"),
+						},
+					},
 					"exportExecutions": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ExportExecutions defines the templating executors that are used to generate the exports.",
@@ -3037,7 +3187,7 @@ func schema_landscaper_apis_core_v1alpha1_Blueprint(ref common.ReferenceCallback
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ExportDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.SubinstallationTemplate", "github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ExportDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStrategy", "github.com/gardener/landscaper/apis/core/v1alpha1.SubinstallationTemplate", "github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"},
 	}
 }
 
@@ -3489,6 +3639,43 @@ func schema_landscaper_apis_core_v1alpha1_Condition(ref common.ReferenceCallback
 	}
 }
 
+func schema_landscaper_apis_core_v1alpha1_ConfigMapExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConfigMapExport writes an export into a key of a configmap.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name the internal name of the exported data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"configMapRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapRef defines the configmap and the key the exported data is written to. The configmap is created in the namespace of the installation and is owned by the installation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalConfigMapReference"),
+						},
+					},
+					"transformation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transformation optionally transforms the exported data before it is written.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ExportTransformation"),
+						},
+					},
+				},
+				Required: []string{"name", "configMapRef"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ExportTransformation", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalConfigMapReference"},
+	}
+}
+
 func schema_landscaper_apis_core_v1alpha1_ConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3596,11 +3783,17 @@ func schema_landscaper_apis_core_v1alpha1_Context(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"maintenanceWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindow restricts the start of new reconciliations of the installations that use this context to the given time window. The maintenance window of an installation takes precedence.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceWindow"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceWindow", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -3762,16 +3955,21 @@ func schema_landscaper_apis_core_v1alpha1_DataObject(ref common.ReferenceCallbac
 					},
 					"data": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data contains the data of the object as string.",
+							Description: "Data contains the data of the object as string. The data is empty if the data object is sensitive.",
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 						},
 					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef references the secret in the namespace of the data object that holds the data of a sensitive data object.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+						},
+					},
 				},
-				Required: []string{"data"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -4200,6 +4398,13 @@ func schema_landscaper_apis_core_v1alpha1_DeployItemTemplate(ref common.Referenc
 							},
 						},
 					},
+					"wave": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Wave is the rollout wave of the deploy item. A deploy item is only started after all deploy items of lower waves have finished.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"updateOnChangeOnly": {
 						SchemaProps: spec.SchemaProps{
 							Description: "UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.",
@@ -4857,11 +5062,17 @@ func schema_landscaper_apis_core_v1alpha1_ExecutionSpec(ref common.ReferenceCall
 							},
 						},
 					},
+					"rolloutStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RolloutStrategy defines how the deploy items of the execution are rolled out. If not set, all deploy items are started as soon as their dependencies are fulfilled.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStrategy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTemplate", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTemplate", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStrategy"},
 	}
 }
 
@@ -4948,6 +5159,12 @@ func schema_landscaper_apis_core_v1alpha1_ExecutionStatus(ref common.ReferenceCa
 							Format:      "",
 						},
 					},
+					"jobIDGenerationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobIDGenerationTime is the timestamp when the JobID was set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 					"phase": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ExecutionPhase is the current phase of the execution.",
@@ -4955,11 +5172,17 @@ func schema_landscaper_apis_core_v1alpha1_ExecutionStatus(ref common.ReferenceCa
 							Format:      "",
 						},
 					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout contains the state of the rollout of the current job if a rollout strategy is defined.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionGeneration", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.VersionedNamedObjectReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionGeneration", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.VersionedNamedObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -4998,6 +5221,13 @@ func schema_landscaper_apis_core_v1alpha1_ExportDefinition(ref common.ReferenceC
 							Format:      "",
 						},
 					},
+					"sensitive": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sensitive marks the exported data as sensitive. The data of sensitive exports is not stored in the data object itself but in a secret that is referenced by the data object. Only data exports can be sensitive.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
@@ -5007,6 +5237,33 @@ func schema_landscaper_apis_core_v1alpha1_ExportDefinition(ref common.ReferenceC
 	}
 }
 
+func schema_landscaper_apis_core_v1alpha1_ExportTransformation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExportTransformation defines how exported data is transformed before it is written into a secret or configmap. Exactly one of JSONPath and Template has to be defined. String results are written as they are, all other results are written as json.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"jsonPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JSONPath selects a value of the exported data, e.g. \".spec.host\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template is a go template that is rendered with the exported data as its root value. The sprig functions are available in the template.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_landscaper_apis_core_v1alpha1_FailedReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5144,120 +5401,76 @@ func schema_landscaper_apis_core_v1alpha1_ImportDefinition(ref common.ReferenceC
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ImportStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ImportGrant(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ImportStatus hold the state of a import.",
+				Description: "The ImportGrant allows installations in other namespaces to import data objects and targets of its namespace. Installations reference granted objects with \"<namespace>/<name>\" in their data and target imports.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the distinct identifier of the import. Can be either from data or target imports",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Type defines the kind of import. Can be either DataObject, Target, or TargetList",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"target": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Target is the name of the in-cluster target object.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"targetList": {
-						SchemaProps: spec.SchemaProps{
-							Description: "TargetList is a list of import statuses for in-cluster target objects.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetImportStatus"),
-									},
-								},
-							},
-						},
-					},
-					"dataRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DataRef is the name of the in-cluster data object.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"secretRef": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef is the name of the secret.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"configMapRef": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ConfigMapRef is the name of the imported configmap.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"sourceRef": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SourceRef is the reference to the installation from where the value is imported",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"configGeneration": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ConfigGeneration is the generation of the imported value.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Spec contains the specification",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ImportGrantSpec"),
 						},
 					},
 				},
-				Required: []string{"name", "type"},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetImportStatus"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ImportGrantSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_InlineBlueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ImportGrantFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InlineBlueprint defines a inline blueprint with component descriptor and filesystem.",
+				Description: "ImportGrantFrom describes the namespace of installations that are allowed to import.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"filesystem": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filesystem defines a inline yaml filesystem with a blueprint.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Description: "Namespace is the namespace of the importing installations.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"filesystem"},
+				Required: []string{"namespace"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Installation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ImportGrantList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Installation contains the configuration of a component",
+				Description: "ImportGrantList contains a list of ImportGrant objects",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -5277,419 +5490,651 @@ func schema_landscaper_apis_core_v1alpha1_Installation(ref common.ReferenceCallb
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains the specification for a installation.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationSpec"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"status": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the status of the installation.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationStatus"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ImportGrant"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"spec"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ImportGrant", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_InstallationExports(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ImportGrantSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationExports defines exports of data objects and targets.",
+				Description: "ImportGrantSpec contains the specification for an ImportGrant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"data": {
+					"from": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data defines all data object exports.",
+							Description: "From defines the namespaces whose installations are allowed to import the granted objects.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DataExport"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ImportGrantFrom"),
 									},
 								},
 							},
 						},
 					},
-					"targets": {
+					"to": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Targets defines all target exports.",
+							Description: "To defines the data objects and targets of the namespace of the grant that can be imported.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetExport"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ImportGrantTo"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"from", "to"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.DataExport", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetExport"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ImportGrantFrom", "github.com/gardener/landscaper/apis/core/v1alpha1.ImportGrantTo"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_InstallationImports(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ImportGrantTo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationImports defines import of data objects and targets.",
+				Description: "ImportGrantTo describes the objects that can be imported.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"data": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data defines all data object imports.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DataImport"),
-									},
-								},
-							},
+							Description: "Kind is the kind of the granted objects, either DataObject or Target.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"targets": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Targets defines all target imports.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetImport"),
-									},
-								},
-							},
+							Description: "Name is the name of the granted object. All objects of the kind are granted if no name is given.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"kind"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.DataImport", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetImport"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_InstallationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ImportStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationList contains a list of Components",
+				Description: "ImportStatus hold the state of a import.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name is the distinct identifier of the import. Can be either from data or target imports",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Type defines the kind of import. Can be either DataObject, Target, or TargetList",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Target is the name of the in-cluster target object.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"items": {
+					"targetList": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "TargetList is a list of import statuses for in-cluster target objects.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Installation"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetImportStatus"),
 									},
 								},
 							},
 						},
 					},
+					"dataRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataRef is the name of the in-cluster data object.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef is the name of the secret.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"configMapRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapRef is the name of the imported configmap.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sourceRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourceRef is the reference to the installation from where the value is imported",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+						},
+					},
+					"configGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigGeneration is the generation of the imported value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"items"},
+				Required: []string{"name", "type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Installation", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetImportStatus"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_InstallationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InlineBlueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationSpec defines a component installation.",
+				Description: "InlineBlueprint defines a inline blueprint with component descriptor and filesystem.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"context": {
+					"filesystem": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Context defines the current context of the installation.",
+							Description: "Filesystem defines a inline yaml filesystem with a blueprint.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+						},
+					},
+				},
+				Required: []string{"filesystem"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_Installation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Installation contains the configuration of a component",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"componentDescriptor": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentDescriptor is a reference to the installation's component descriptor",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"blueprint": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Blueprint is the resolved reference to the definition.",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification for a installation.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition"),
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationSpec"),
 						},
 					},
-					"registryPullSecrets": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints, component descriptors and jsonschemas from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
+							Description: "Status contains the status of the installation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_InstallationExports(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationExports defines exports of data objects and targets.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"data": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Data defines all data object exports.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DataExport"),
 									},
 								},
 							},
 						},
 					},
-					"imports": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the imported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports"),
-						},
-					},
-					"importDataMappings": {
+					"targets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Targets defines all target exports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetExport"),
 									},
 								},
 							},
 						},
 					},
-					"exports": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports"),
-						},
-					},
-					"exportDataMappings": {
+					"secrets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Secrets defines exports that are written into a key of a secret in the namespace of the installation. This method is not allowed in installation templates.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.SecretExport"),
 									},
 								},
 							},
 						},
 					},
-					"automaticReconcile": {
+					"configMaps": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcile"),
+							Description: "ConfigMaps defines exports that are written into a key of a configmap in the namespace of the installation. This method is not allowed in installation templates.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ConfigMapExport"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"blueprint"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcile", "github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ConfigMapExport", "github.com/gardener/landscaper/apis/core/v1alpha1.DataExport", "github.com/gardener/landscaper/apis/core/v1alpha1.SecretExport", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetExport"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_InstallationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationImports(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationStatus contains the current status of a Installation.",
+				Description: "InstallationImports defines import of data objects and targets.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"observedGeneration": {
+					"data": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this ControllerInstallations. It corresponds to the ControllerInstallations generation, which is updated on mutation by the landscaper.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"conditions": {
+							Description: "Data defines all data object imports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DataImport"),
+									},
+								},
+							},
+						},
+					},
+					"targets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions contains the actual condition of a installation",
+							Description: "Targets defines all target imports.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Condition"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetImport"),
 									},
 								},
 							},
 						},
 					},
-					"lastError": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DataImport", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetImport"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_InstallationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationList contains a list of Components",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastError describes the last error that occurred.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"configGeneration": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ConfigGeneration is the generation of the exported values.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Installation"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Installation", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_InstallationPlan(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationPlan describes the changes that a reconciliation of an installation would apply to its subinstallations, deploy items and imported data objects and targets.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the generation of the installation the plan was computed for.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"planTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PlanTime is the time when the plan was computed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"importsHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportsHash is the hash of the import data the plan was computed with.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"imports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Imports contain the state of the imported values.",
+							Description: "Imports describes the planned changes of the data objects and targets that hold the imports of the installation.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ImportStatus"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.PlannedChange"),
 									},
 								},
 							},
 						},
 					},
-					"installationRefs": {
+					"subinstallations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InstallationReferences contain all references to sub-components that are created based on the component definition.",
+							Description: "Subinstallations describes the planned changes of the subinstallations.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.NamedObjectReference"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.PlannedChange"),
 									},
 								},
 							},
 						},
 					},
-					"executionRef": {
+					"deployItems": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExecutionReference is the reference to the execution that schedules the templated execution items.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+							Description: "DeployItems describes the planned changes of the deploy item templates of the execution.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.PlannedChange"),
+									},
+								},
+							},
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Error describes the error that occurred while the plan was computed.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
+						},
+					},
+				},
+				Required: []string{"observedGeneration", "planTime"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.PlannedChange", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_InstallationRevision(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationRevision describes a configuration of an installation that has been applied successfully.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the number of the revision in the revision history of the installation.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 					"jobID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobID is the ID of the current working request.",
+							Description: "JobID is the ID of the job that applied the revision.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"jobIDFinished": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobIDFinished is the ID of the finished working request.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ObservedGeneration is the generation of the installation that was applied.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"phase": {
+					"appliedTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InstallationPhase is the current phase of the installation.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "AppliedTime is the time when the revision was applied successfully.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"componentDescriptor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentDescriptor is the reference to the component descriptor of the revision.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition"),
+						},
+					},
+					"blueprint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Blueprint is the reference to the blueprint of the revision.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition"),
 						},
 					},
 					"importsHash": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportsHash is the hash of the import data.",
+							Description: "ImportsHash is the hash of the import data of the revision.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"automaticReconcileStatus": {
+					"deployItems": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus"),
+							Description: "DeployItems are the rendered deploy item templates of the execution of the revision.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTemplate"),
+									},
+								},
+							},
+						},
+					},
+					"rolloutStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RolloutStrategy is the rollout strategy of the execution of the revision.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStrategy"),
+						},
+					},
+					"subinstallations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subinstallations are the subinstallations of the revision.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.SubinstallationRevision"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"observedGeneration", "configGeneration"},
+				Required: []string{"jobID", "observedGeneration", "appliedTime", "blueprint"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ImportStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.NamedObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTemplate", "github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStrategy", "github.com/gardener/landscaper/apis/core/v1alpha1.SubinstallationRevision", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_InstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationTemplate defines a subinstallation in a blueprint.",
+				Description: "InstallationSpec defines a component installation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"context": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Context defines the current context of the installation.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"componentDescriptor": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ComponentDescriptor is a reference to the installation's component descriptor",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition"),
 						},
 					},
-					"name": {
+					"blueprint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the step",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Blueprint is the resolved reference to the definition.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition"),
 						},
 					},
-					"blueprint": {
+					"registryPullSecrets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition"),
+							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints, component descriptors and jsonschemas from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+									},
+								},
+							},
 						},
 					},
 					"imports": {
@@ -5734,198 +6179,2059 @@ func schema_landscaper_apis_core_v1alpha1_InstallationTemplate(ref common.Refere
 							},
 						},
 					},
-				},
-				Required: []string{"name", "blueprint"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_InstallationTemplateBlueprintDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "InstallationTemplateBlueprintDefinition contains either a reference to a blueprint or an inline definition.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"ref": {
+					"automaticReconcile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ref is a reference to a blueprint. Only blueprints that are defined by the component descriptor of the current blueprint can be referenced here. Example: cd://componentReference/dns/resources/blueprint",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcile"),
 						},
 					},
-					"filesystem": {
+					"rollbackPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filesystem defines a virtual filesystem with all files needed for a blueprint. The filesystem must be a YAML filesystem.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Description: "RollbackPolicy allows to configure an automatic rollback to the last succeeded revision of the installation if a reconciliation fails or times out.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.RollbackPolicy"),
+						},
+					},
+					"revisionHistoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RevisionHistoryLimit is the number of applied revisions of the installation that are kept in its revision history. If not set or 0, no revision history is recorded.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maintenanceWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindow restricts the start of new reconciliations of the installation to the given time window. Reconciliations that are requested outside the window are deferred until the window begins. If not set, the maintenance window of the context of the installation is used.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceWindow"),
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspend suspends the installation and all its subinstallations, executions and deploy items. Suspended installations do not start new jobs, and automatic and continuous reconciliations are paused. Running jobs are not interrupted.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"blueprint"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcile", "github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceWindow", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.RollbackPolicy"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_JSONSchemaDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JSONSchemaDefinition defines a jsonschema.",
-				Type:        v1alpha1.JSONSchemaDefinition{}.OpenAPISchemaType(),
-				Format:      v1alpha1.JSONSchemaDefinition{}.OpenAPISchemaFormat(),
+				Description: "InstallationStatus contains the current status of a Installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this ControllerInstallations. It corresponds to the ControllerInstallations generation, which is updated on mutation by the landscaper.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions contains the actual condition of a installation",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError describes the last error that occurred.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
+						},
+					},
+					"configGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigGeneration is the generation of the exported values.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Imports contain the state of the imported values.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ImportStatus"),
+									},
+								},
+							},
+						},
+					},
+					"installationRefs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstallationReferences contain all references to sub-components that are created based on the component definition.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.NamedObjectReference"),
+									},
+								},
+							},
+						},
+					},
+					"executionRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExecutionReference is the reference to the execution that schedules the templated execution items.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+						},
+					},
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the ID of the current working request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jobIDFinished": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobIDFinished is the ID of the finished working request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jobIDGenerationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobIDGenerationTime is the timestamp when the JobID was set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstallationPhase is the current phase of the installation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"importsHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportsHash is the hash of the import data.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"automaticReconcileStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus"),
+						},
+					},
+					"plan": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Plan describes the changes that a reconciliation of the installation would apply. It is computed if the installation is annotated with the plan operation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationPlan"),
+						},
+					},
+					"lastSucceededRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSucceededRevision is the configuration of the installation that was applied by the last succeeded reconciliation. It is only recorded if the rollback policy of the installation is enabled.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationRevision"),
+						},
+					},
+					"rollback": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollback describes the last automatic rollback of the installation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.RollbackStatus"),
+						},
+					},
+					"latestRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LatestRevision is the number of the latest revision in the revision history of the installation.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"deferredUntil": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeferredUntil is the begin of the next maintenance window if a requested reconciliation has been deferred because it was requested outside the maintenance window of the installation.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"observedGeneration", "configGeneration"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ImportStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationPlan", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationRevision", "github.com/gardener/landscaper/apis/core/v1alpha1.NamedObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.RollbackStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_LocalSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LocalSecretReference is a reference to data in a secret.",
+				Description: "InstallationTemplate defines a subinstallation in a blueprint.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the secret",
+							Description: "Name is the unique name of the step",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"key": {
+					"blueprint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition"),
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Imports define the imported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports"),
+						},
+					},
+					"importDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"exports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exports define the exported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports"),
+						},
+					},
+					"exportDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "blueprint"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_InstallationTemplateBlueprintDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationTemplateBlueprintDefinition contains either a reference to a blueprint or an inline definition.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ref is a reference to a blueprint. Only blueprints that are defined by the component descriptor of the current blueprint can be referenced here. Example: cd://componentReference/dns/resources/blueprint",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filesystem": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filesystem defines a virtual filesystem with all files needed for a blueprint. The filesystem must be a YAML filesystem.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_JSONSchemaDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JSONSchemaDefinition defines a jsonschema.",
+				Type:        v1alpha1.JSONSchemaDefinition{}.OpenAPISchemaType(),
+				Format:      v1alpha1.JSONSchemaDefinition{}.OpenAPISchemaFormat(),
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_LocalConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LocalConfigMapReference is a reference to data in a configmap.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the configmap",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the name of the key in the configmap that holds the data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_LocalSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LocalSecretReference is a reference to data in a secret.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the name of the key in the secret that holds the data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_LsHealthCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LsHealthCheck is a resource containing information about problems with the landscaper installation",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status defines the overall status of the landscaper and its standard deployers.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime contains last time the check was updated.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description contains description of the problem(s)",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"status", "lastUpdateTime", "description"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_LsHealthCheckList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LsHealthCheckList contains a list of LsHealthChecks",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.LsHealthCheck"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.LsHealthCheck", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_MaintenanceWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceWindow defines a recurring time window in which new reconciliations of an installation are started.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"begin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Begin is a cron schedule in the standard format that defines when the window opens, e.g. \"0 22 * * *\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is a cron schedule in the standard format that defines when the window closes, e.g. \"0 4 * * *\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timezone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timezone is the IANA name of the time zone in which the schedules are evaluated, e.g. \"Europe/Berlin\". If not set, UTC is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"begin", "end"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_NamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamedObjectReference is a named reference to a specific resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the reference.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference is the reference to an object.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+						},
+					},
+				},
+				Required: []string{"name", "ref"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ObjectReference is the reference to a kubernetes object.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_PlannedChange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PlannedChange describes the planned change of a single object.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the logical name of the object, e.g. the name of the deploy item template or the subinstallation template.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action is the action that would be applied to the object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reference": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference is the reference to the existing object.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+						},
+					},
+					"changedFields": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChangedFields contains the fields of the object that would change.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "kind", "action"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_RemoteBlueprintReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RemoteBlueprintReference describes a reference to a blueprint defined by a component descriptor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName is the name of the blueprint as defined by a component descriptor.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"resourceName"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_Requirement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Requirement contains values, a key, and an operator that relates the key and values. The zero value of Requirement is invalid. Requirement implements both set based match and exact match Requirement should be initialized via NewRequirement constructor for creating a valid Requirement.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"operator": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "In huge majority of cases we have at most one value here. It is generally faster to operate on a single-element slice than on a single-element map, so we have a slice here.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"key", "operator"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ResolvedTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResolvedTarget is a helper struct to store a target together with the content of its resolved secret reference.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target contains the original target.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Target"),
+						},
+					},
+					"content": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Content contains the content of the target. If the target has a secret reference, this field should be filled by a TargetResolver. Otherwise, the inline configuration of the target is put here.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"target", "content"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Target"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceReference defines the reference to a resource defined in a component descriptor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"componentName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentName defines the unique of the component containing the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName defines the name of the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"componentName", "resourceName"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_RollbackPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RollbackPolicy defines if and when an installation is rolled back to its last succeeded revision.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled activates the automatic rollback. If enabled, the landscaper remembers the last succeeded revision of the installation and re-applies it if a reconciliation fails.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout specifies the maximal duration of a reconciliation. Reconciliations that take longer are interrupted and rolled back. If not set, reconciliations are only rolled back if they fail.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+				},
+				Required: []string{"enabled"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_RollbackStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RollbackStatus describes an automatic rollback of an installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the ID of the job that re-applies the last succeeded revision.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failedJobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedJobID is the ID of the job that failed or timed out. It is not set if the rollback has been triggered by the rollback operation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"revisionJobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RevisionJobID is the ID of the job that applied the revision which is re-applied.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the number of the revision which is re-applied.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason describes why the installation has been rolled back.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is the time when the rollback has been started.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"jobID", "revisionJobID", "reason", "startTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_RolloutStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RolloutStatus contains the state of the rollout of the deploy items of an execution.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"paused": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Paused is true if the rollout has been paused because of failed deploy items.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"continuedFailedItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContinuedFailedItems contains the names of the failed deploy items for which the rollout has been continued.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_RolloutStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RolloutStrategy defines how the deploy items of an execution are rolled out.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxInProgress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxInProgress is the maximal number of deploy items of the execution that are processed at the same time. All deploy items are processed in parallel if it is not set or 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pauseOnFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PauseOnFailure pauses the rollout if a deploy item has failed instead of failing the execution. A paused rollout is continued with the \"continue\" operation annotation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_SecretExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretExport writes an export into a key of a secret.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name the internal name of the exported data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef defines the secret and the key the exported data is written to. The secret is created in the namespace of the installation and is owned by the installation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+						},
+					},
+					"transformation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transformation optionally transforms the exported data before it is written.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ExportTransformation"),
+						},
+					},
+				},
+				Required: []string{"name", "secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ExportTransformation", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_SecretLabelSelectorRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretLabelSelectorRef selects secrets with the given label and key.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector is a map of labels to select specific secrets.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The key of the secret to select from.  Must be a valid secret key.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"selector", "key"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_SecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretReference is reference to data in a secret. The secret can also be in a different namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the name of the key in the secret that holds the data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_StaticDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StaticDataSource defines a static data source",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value defined inline a raw data",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+						},
+					},
+					"valueFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValueFrom defines data from an external resource",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_StaticDataValueFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StaticDataValueFrom defines a static data that is read from a external resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretKeyRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selects a key of a secret in the installations's namespace",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"secretLabelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selects a key from multiple secrets in the installations's namespace that matches the given labels.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SecretLabelSelectorRef"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.SecretLabelSelectorRef", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_SubinstallationRevision(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SubinstallationRevision describes a subinstallation of a revision of an installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the installation template of the subinstallation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the applied spec of the subinstallation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationSpec"),
+						},
+					},
+				},
+				Required: []string{"name", "spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationSpec"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_SubinstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SubinstallationTemplate defines a subinstallation template.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"file": {
+						SchemaProps: spec.SchemaProps{
+							Description: "File references a subinstallation template stored in another file.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the step",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"blueprint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition"),
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Imports define the imported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports"),
+						},
+					},
+					"importDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"exports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exports define the exported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports"),
+						},
+					},
+					"exportDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "blueprint"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_SucceededReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 24 hours is used.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TLSClientConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TLSClientConfig contains settings to enable transport layer security",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"insecure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Server should be accessed without verifying the TLS certificate. For testing only.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"serverName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerName is passed to the server for SNI and is used in the client to check server ceritificates against. If ServerName is empty, the hostname used to contact the server is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"caData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CAData holds PEM-encoded bytes (typically read from a root certificates bundle). CAData takes precedence over CAFile",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"nextProtos": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextProtos is a list of supported application level protocols, in order of preference. Used to populate tls.Config.NextProtos. To indicate to the server http/1.1 is preferred over http/2, set to [\"http/1.1\", \"h2\"] (though the server is free to ignore that preference). To use only http/1.1, set to [\"http/1.1\"].",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_Target(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Target defines a specific data object that defines target environment. Every deploy item can have a target which is used by the deployer to install the specific application.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the result of the last health check of the target.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TargetExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetExport is a single target export.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name the internal name of the exported target.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target is the name of the in-cluster target object.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TargetImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetImport is either a single target or a target list import.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name the internal name of the imported target.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target is the name of the in-cluster target object. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets is a list of in-cluster target objects. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"targetListRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetListReference can (only) be used to import a targetlist that has been imported by the parent installation. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TargetImportStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetImportStatus",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target is the name of the in-cluster target object.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sourceRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourceRef is the reference to the installation from where the value is imported",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+						},
+					},
+					"configGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigGeneration is the generation of the imported value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TargetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetList contains a list of Targets",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Target"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Target", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TargetSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetSelector describes a selector that matches specific targets.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets defines a list of specific targets (name and namespace) that should be reconciled.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations matches a target based on annotations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Requirement"),
+									},
+								},
+							},
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels matches a target based on its labels.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Requirement"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.Requirement"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TargetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetSpec contains the definition of a target.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TargetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetStatus contains the result of the last health check of a target. The status is only maintained for targets of types that are known to the landscaper.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the generation of the target that was checked.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"reachable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reachable indicates whether the target environment could be accessed with the configuration of the target.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"serverVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerVersion is the version of the target environment that was reported by the last successful check.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"credentialExpirationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialExpirationTime is the time when the credentials of the target expire. It is only set if the expiration time can be determined from the credentials.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastCheckTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCheckTime is the time of the last health check of the target.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message describes the reason why the target is not reachable.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TargetSync(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "The TargetSync is created targets from secrets.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the status",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TargetSyncList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetSyncList contains a list of TargetSync objects",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSync"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSync", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TargetSyncSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetSyncSpec contains the specification for a TargetSync.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sourceNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourceNamespace describes the namespace from where the secrets should be synced",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef references the secret that contains the kubeconfig to the namespace of the secrets to be synced.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+						},
+					},
+					"createTargetToSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreateTargetToSource specifies if set on true, that also a target is created, which references the secret in SecretRef",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"targetToSourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetToSourceName is the name of the target referencing the secret defined in SecretRef if CreateTargetToSource is set on true. If TargetToSourceName is empty SourceNamespace is used instead.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretNameExpression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretNameExpression defines the names of the secrets which should be synced via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no secrets are synced",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"shootNameExpression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootNameExpression defines the names of shoot clusters for which targets with short living access data to the shoots are created via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no targets for the shoots are created",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenRotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenRotation defines the data to perform an automatic rotation of the token to access the source cluster with the secrets to sync. The token expires after 90 days and will be rotated every 60 days.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TokenRotation"),
+						},
+					},
+				},
+				Required: []string{"sourceNamespace", "secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TokenRotation"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TargetSyncStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetSyncStatus contains the status of a TargetSync.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the status was updated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastErrors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastErrors describe the last errors",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"lastTokenRotationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the token was rotated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TargetTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetTemplate exposes specific parts of a target that are used in the exports to export a target",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects. May match selectors of replication controllers and services. More info: http://kubernetes.io/docs/user-guide/labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the secret that holds the data.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata. They are not queryable and should be preserved when modifying objects. More info: http://kubernetes.io/docs/user-guide/annotations",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"type"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_LsHealthCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TemplateExecutor(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LsHealthCheck is a resource containing information about problems with the landscaper installation",
+				Description: "TemplateExecutor describes a templating mechanism and configuration.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name is the unique name of the template",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Type describes the templating mechanism.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"status": {
+					"file": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status defines the overall status of the landscaper and its standard deployers.",
-							Default:     "",
+							Description: "File is the path to the template in the blueprint's content.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastUpdateTime": {
+					"template": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastUpdateTime contains last time the check was updated.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Template contains an optional inline template. The template has to be of string for go template and either a string or valid yaml/json for spiff.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 						},
 					},
-					"description": {
+				},
+				Required: []string{"name", "type"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TokenRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description contains description of the problem(s)",
-							Default:     "",
-							Type:        []string{"string"},
+							Description: "Enabled defines if automatic token is executed",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"status", "lastUpdateTime", "description"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_LsHealthCheckList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TypedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LsHealthCheckList contains a list of LsHealthChecks",
+				Description: "TypedObjectReference is a reference to a typed kubernetes object.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "APIVersion is the group and version for the resource being referenced. If APIVersion is not specified, the specified Kind must be in the core API group. For any other third-party types, APIVersion is required.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Kind is the type of resource being referenced",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"items": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.LsHealthCheck"),
-									},
-								},
-							},
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"apiVersion", "kind", "name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.LsHealthCheck", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_NamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_VersionedNamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NamedObjectReference is a named reference to a specific resource.",
+				Description: "VersionedNamedObjectReference is a named reference to a object with its last observed resource generation. This struct is used by status fields.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
@@ -5940,7 +8246,7 @@ func schema_landscaper_apis_core_v1alpha1_NamedObjectReference(ref common.Refere
 						SchemaProps: spec.SchemaProps{
 							Description: "Reference is the reference to an object.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.VersionedObjectReference"),
 						},
 					},
 				},
@@ -5948,15 +8254,15 @@ func schema_landscaper_apis_core_v1alpha1_NamedObjectReference(ref common.Refere
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.VersionedObjectReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_VersionedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ObjectReference is the reference to a kubernetes object.",
+				Description: "VersionedObjectReference is a reference to a object with its last observed resource generation. This struct is used by status fields.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
@@ -5975,151 +8281,206 @@ func schema_landscaper_apis_core_v1alpha1_ObjectReference(ref common.ReferenceCa
 							Format:      "",
 						},
 					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration defines the last observed generation of the referenced resource.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
-				Required: []string{"name"},
+				Required: []string{"name", "observedGeneration"},
 			},
 		},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_RemoteBlueprintReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_VersionedResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RemoteBlueprintReference describes a reference to a blueprint defined by a component descriptor.",
+				Description: "VersionedResourceReference defines the reference to a resource with its version.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"componentName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentName defines the unique of the component containing the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"resourceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName is the name of the blueprint as defined by a component descriptor.",
+							Description: "ResourceName defines the name of the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version defines the version of the component.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"resourceName"},
+				Required: []string{"componentName", "resourceName", "version"},
 			},
 		},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Requirement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Requirement contains values, a key, and an operator that relates the key and values. The zero value of Requirement is invalid. Requirement implements both set based match and exact match Requirement should be initialized via NewRequirement constructor for creating a valid Requirement.",
+				Description: "Configuration is the container deployer configuration that configures the controller",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"key": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"operator": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"values": {
+					"identity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "In huge majority of cases we have at most one value here. It is generally faster to operate on a single-element slice than on a single-element map, so we have a slice here.",
+							Description: "Identity identity describes the unique identity of the deployer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"oci": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OCI configures the oci client of the controller",
+							Ref:         ref("github.com/gardener/landscaper/apis/config.OCIConfiguration"),
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace defines the namespace where the pods should be executed. Defaults to default",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetSelector describes all selectors the deployer should depend on.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"),
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"key", "operator"},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_ResolvedTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ResolvedTarget is a helper struct to store a target together with the content of its resolved secret reference.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"target": {
+					"failOnUnhealthyTarget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailOnUnhealthyTarget lets deploy items fail fast with a recoverable error, if the target health check of the landscaper has recently found their target unreachable.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"defaultImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultImage configures the default images that is used if the DeployItem does not specify one.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec"),
+						},
+					},
+					"initContainer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitContainerImage defines the image that is used to init the container. This container bootstraps the necessary directories and files.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec"),
+						},
+					},
+					"waitContainer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SidecarContainerImage defines the image that is used as a sidecar to the defined main container. The sidecar container is responsible to collect the exports and the state of the main container.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec"),
+						},
+					},
+					"garbageCollection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GarbageCollection configures the container deployer garbage collector.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.GarbageCollection"),
+						},
+					},
+					"debug": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target contains the original target.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Target"),
+							Description: "DebugOptions configure additional debug options.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.DebugOptions"),
 						},
 					},
-					"content": {
+					"controller": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Content contains the content of the target. If the target has a secret reference, this field should be filled by a TargetResolver. Otherwise, the inline configuration of the target is put here.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Controller contains configuration concerning the controller framework.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Controller"),
 						},
 					},
 				},
-				Required: []string{"target", "content"},
+				Required: []string{"defaultImage", "initContainer", "waitContainer", "garbageCollection"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Target"},
+			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.DebugOptions", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.GarbageCollection"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_ContainerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ResourceReference defines the reference to a resource defined in a component descriptor.",
+				Description: "ContainerSpec defines a container specification",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"componentName": {
+					"image": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
-							Default:     "",
+							Description: "Docker image name. More info: https://kubernetes.io/docs/concepts/containers/images The image will be defaulted by the container deployer to the configured default.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"resourceName": {
+					"command": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName defines the name of the resource.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-				},
-				Required: []string{"componentName", "resourceName"},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_SecretLabelSelectorRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "SecretLabelSelectorRef selects secrets with the given label and key.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"selector": {
+					"args": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selector is a map of labels to select specific secrets.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Arguments to the entrypoint. The docker image's CMD is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -6130,260 +8491,241 @@ func schema_landscaper_apis_core_v1alpha1_SecretLabelSelectorRef(ref common.Refe
 							},
 						},
 					},
-					"key": {
+					"imagePullPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The key of the secret to select from.  Must be a valid secret key.",
-							Default:     "",
+							Description: "Image pull policy. One of Always, Never, IfNotPresent. Defaults to Always if :latest tag is specified, or IfNotPresent otherwise. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/images#updating-images\n\nPossible enum values:\n - `\"Always\"` means that kubelet always attempts to pull the latest image. Container will fail If the pull fails.\n - `\"IfNotPresent\"` means that kubelet pulls if the image isn't present on disk. Container will fail if the image isn't present and the pull fails.\n - `\"Never\"` means that kubelet never pulls an image, but only uses a local image. Container will fail if the image isn't present",
 							Type:        []string{"string"},
 							Format:      "",
-						},
+							Enum:        []interface{}{"Always", "IfNotPresent", "Never"}},
 					},
 				},
-				Required: []string{"selector", "key"},
 			},
 		},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_ContainerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SecretReference is reference to data in a secret. The secret can also be in a different namespace.",
+				Description: "ContainerStatus describes the status of a pod with its init, wait and main container.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
-							Default:     "",
+							Description: "A human readable message indicating details about why the pod is in this condition.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
+							Description: "A brief CamelCase message indicating details about why the pod is in this state. e.g. 'Evicted'",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Details about the container's current condition.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ContainerState"),
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The image the container is running. More info: https://kubernetes.io/docs/concepts/containers/images",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"key": {
+					"imageID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the secret that holds the data.",
+							Description: "ImageID of the container's image.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"exitCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExitCode of the main container.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
-				Required: []string{"name"},
+				Required: []string{"image", "imageID"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ContainerState"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_StaticDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "StaticDataSource defines a static data source",
+				Description: "Controller contains configuration concerning the controller framework.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"value": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Value defined inline a raw data",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
-						},
-					},
-					"valueFrom": {
+					"CommonControllerConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ValueFrom defines data from an external resource",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom"),
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
 						},
 					},
 				},
+				Required: []string{"CommonControllerConfig"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom"},
+			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_StaticDataValueFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_DebugOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "StaticDataValueFrom defines a static data that is read from a external resource.",
+				Description: "DebugOptions defines optional debug options.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"secretKeyRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Selects a key of a secret in the installations's namespace",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
-						},
-					},
-					"secretLabelSelector": {
+					"keepPod": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selects a key from multiple secrets in the installations's namespace that matches the given labels.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SecretLabelSelectorRef"),
+							Description: "KeepPod will only remove the finalizer on the pod but will not delete the pod.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.SecretLabelSelectorRef", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SubinstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_GarbageCollection(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SubinstallationTemplate defines a subinstallation template.",
+				Description: "GarbageCollection defines the container deployer garbage collection configuration.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"file": {
+					"disable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "File references a subinstallation template stored in another file.",
-							Type:        []string{"string"},
+							Description: "Disable disables the garbage collector and the resources clean-up.",
+							Default:     false,
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"kind": {
+					"worker": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Worker defines the number of parallel garbage collection routines. Defaults to 5.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"apiVersion": {
+					"requeueTimeSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "RequeueTime specifies the duration after which the object, which is not yet ready to be garbage collected, is requeued. Defaults to 60.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"name": {
+				},
+				Required: []string{"disable", "worker", "requeueTimeSeconds"},
+			},
+		},
+	}
+}
+
+func schema_apis_deployer_container_v1alpha1_PodStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PodStatus describes the status of a pod with its init, wait and main container",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"podName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the step",
+							Description: "PodName is the name of the created pod.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"blueprint": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition"),
-						},
-					},
-					"imports": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the imported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports"),
-						},
-					},
-					"importDataMappings": {
+					"lastRun": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
-									},
-								},
-							},
+							Description: "LastRun is the time when the pod was executed the last time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"exports": {
+					"containerStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported data objects and targets.",
+							Description: "ContainerStatus contains the status of the pod that executes the configured container.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus"),
 						},
 					},
-					"exportDataMappings": {
+					"initContainerStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
-									},
-								},
-							},
+							Description: "InitContainerStatus contains the status of the init container.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus"),
 						},
 					},
-				},
-				Required: []string{"name", "blueprint"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_SucceededReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"interval": {
+					"waitContainerStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 24 hours is used.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+							Description: "WaitContainerStatus contains the status of the wait container.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus"),
 						},
 					},
 				},
+				Required: []string{"podName", "containerStatus", "initContainerStatus", "waitContainerStatus"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+			"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TLSClientConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TLSClientConfig contains settings to enable transport layer security",
+				Description: "ProviderConfiguration is the container deployer configuration that is expected in a DeployItem",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"insecure": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Server should be accessed without verifying the TLS certificate. For testing only.",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"serverName": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ServerName is passed to the server for SNI and is used in the client to check server ceritificates against. If ServerName is empty, the hostname used to contact the server is used.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"caData": {
+					"image": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CAData holds PEM-encoded bytes (typically read from a root certificates bundle). CAData takes precedence over CAFile",
+							Description: "Docker image name. More info: https://kubernetes.io/docs/concepts/containers/images The image will be defaulted by the container deployer to the configured default.",
 							Type:        []string{"string"},
-							Format:      "byte",
+							Format:      "",
 						},
 					},
-					"nextProtos": {
+					"command": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NextProtos is a list of supported application level protocols, in order of preference. Used to populate tls.Config.NextProtos. To indicate to the server http/1.1 is preferred over http/2, set to [\"http/1.1\", \"h2\"] (though the server is free to ignore that preference). To use only http/1.1, set to [\"http/1.1\"].",
+							Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -6396,174 +8738,166 @@ func schema_landscaper_apis_core_v1alpha1_TLSClientConfig(ref common.ReferenceCa
 							},
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_Target(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Target defines a specific data object that defines target environment. Every deploy item can have a target which is used by the deployer to install the specific application.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"args": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Arguments to the entrypoint. The docker image's CMD is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"apiVersion": {
+					"importValues": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "ImportValues contains the import values for the container.",
 							Type:        []string{"string"},
-							Format:      "",
+							Format:      "byte",
 						},
 					},
-					"metadata": {
+					"blueprint": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Blueprint is the resolved reference to the Blueprint definition",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition"),
 						},
 					},
-					"spec": {
+					"componentDescriptor": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSpec"),
+							Description: "ComponentDescriptor is the resolved reference to the ComponentDescriptor defnition",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition"),
 						},
 					},
-				},
-				Required: []string{"spec"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_TargetExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "TargetExport is a single target export.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"registryPullSecrets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the exported target.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints and component descriptors from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+									},
+								},
+							},
 						},
 					},
-					"target": {
+					"continuousReconcile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target is the name of the in-cluster target object.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetImport is either a single target or a target list import.",
+				Description: "ProviderStatus is the container provider specific status",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the imported target.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"target": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target is the name of the in-cluster target object. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"targets": {
+					"lastOperation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Targets is a list of in-cluster target objects. Exactly one of Target, Targets, and TargetListReference has to be specified.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "LastOperation defines the last run operation of the pod. The operation can be either reconcile or deletion.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"targetListRef": {
+					"podStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetListReference can (only) be used to import a targetlist that has been imported by the parent installation. Exactly one of Target, Targets, and TargetListReference has to be specified.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "PodStatus indicated the status of the executed pod.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PodStatus"),
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"lastOperation"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PodStatus"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetImportStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_core_v1alpha1_Owner(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetImportStatus",
+				Description: "Owner are resources that can hold any kind of json or yaml data.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"target": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target is the name of the in-cluster target object.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"sourceRef": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SourceRef is the reference to the installation from where the value is imported",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"configGeneration": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ConfigGeneration is the generation of the imported value.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains configuration for a deployer ownership.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/core/v1alpha1.OwnerSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/deployer/core/v1alpha1.OwnerStatus"),
 						},
 					},
 				},
+				Required: []string{"spec", "status"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"},
+			"github.com/gardener/landscaper/apis/deployer/core/v1alpha1.OwnerSpec", "github.com/gardener/landscaper/apis/deployer/core/v1alpha1.OwnerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_core_v1alpha1_OwnerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetList contains a list of Targets",
+				Description: "OwnerList contains a list of Owners.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -6593,7 +8927,7 @@ func schema_landscaper_apis_core_v1alpha1_TargetList(ref common.ReferenceCallbac
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Target"),
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/core/v1alpha1.Owner"),
 									},
 								},
 							},
@@ -6604,744 +8938,606 @@ func schema_landscaper_apis_core_v1alpha1_TargetList(ref common.ReferenceCallbac
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Target", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/deployer/core/v1alpha1.Owner", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_core_v1alpha1_OwnerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSelector describes a selector that matches specific targets.",
+				Description: "OwnerSpec describes the configuration for a deployer ownership.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"targets": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Targets defines a list of specific targets (name and namespace) that should be reconciled.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
-									},
-								},
-							},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type describes the type of the deployer.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"annotations": {
+					"deployerId": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations matches a target based on annotations.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Requirement"),
-									},
-								},
-							},
+							Description: "DeployerId describes the unique identity of a deployer.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"labels": {
+					"targets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels matches a target based on its labels.",
+							Description: "Targets is a list of targets the referenced deployer is responsible for.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Requirement"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"type", "deployerId"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.Requirement"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_core_v1alpha1_OwnerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSpec contains the definition of a target.",
+				Description: "OwnerStatus describes the status for a deployer ownership.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"accepted": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
-							Default:     "",
-							Type:        []string{"string"},
+							Description: "Accepted defines if the responsible controller has accepted the owner.",
+							Default:     false,
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"config": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Description: "ObservedGeneration indicates the generation that was last observed by the responsive deployer controller.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"secretRef": {
+				},
+				Required: []string{"accepted", "observedGeneration"},
+			},
+		},
+	}
+}
+
+func schema_apis_deployer_helm_v1alpha1_ArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ArchiveAccess defines the access for a helm chart as compressed archive.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"raw": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+							Description: "Raw defines a compressed tarred helm chart as base64 encoded string.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"remote": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Remote defines the remote access for a helm chart as compressed archive.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteArchiveAccess"),
 						},
 					},
 				},
-				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteArchiveAccess"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetSync(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_Auth(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "The TargetSync is created targets from secrets.",
+				Description: "Auth contains authentication info for one helm chart repo",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "URL contains URL of helm chart repo",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"customCAData": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "CustomCAData allows you to add a custom CA, which is useful if your server speaks HTTPS with a self- signed certificate. The added certificate must be in PEM format and base64 encoded.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
+					"authHeader": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains the specification",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncSpec"),
+							Description: "AuthHeader contains the value that will be set in the \"Authorization\" header when fetching the Chart, e.g. \"Basic dX...3dvcmQ=\". Exactly one of the fields AuthHeader and SecretRef must be set.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"status": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the status",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncStatus"),
+							Description: "Reference to a secret containing the AuthHeader Exactly one of the fields AuthHeader and SecretRef must be set.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
 						},
 					},
 				},
-				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetSyncList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_Chart(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSyncList contains a list of TargetSync objects",
+				Description: "Chart defines the helm chart to render and apply.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"ref": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Ref defines the reference to a helm chart in a oci repository. References with the \"oci://\" scheme are resolved with helm's registry client, the latest semver tag is used if such a reference has no tag.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"fromResource": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "FromResource fetches the chart based on the resource's access method. The resource is defined as part of a component descriptor which is necessary to also handle local artifacts.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteChartReference"),
 						},
 					},
-					"metadata": {
+					"archive": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Archive defines a compressed tarred helm chart as base64 encoded string.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ArchiveAccess"),
 						},
 					},
-					"items": {
+					"helmChartRepo": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSync"),
-									},
-								},
-							},
+							Description: "HelmChartRepo defines a reference to a chart in a helm chart repo.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmChartRepo"),
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSync", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ArchiveAccess", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmChartRepo", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteChartReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetSyncSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSyncSpec contains the specification for a TargetSync.",
+				Description: "Configuration is the helm deployer configuration that configures the controller",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"sourceNamespace": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SourceNamespace describes the namespace from where the secrets should be synced",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"secretRef": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef references the secret that contains the kubeconfig to the namespace of the secrets to be synced.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"createTargetToSource": {
+					"identity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CreateTargetToSource specifies if set on true, that also a target is created, which references the secret in SecretRef",
-							Type:        []string{"boolean"},
+							Description: "Identity identity describes the unique identity of the deployer.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"targetToSourceName": {
+					"oci": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetToSourceName is the name of the target referencing the secret defined in SecretRef if CreateTargetToSource is set on true. If TargetToSourceName is empty SourceNamespace is used instead.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "OCI configures the oci client of the controller",
+							Ref:         ref("github.com/gardener/landscaper/apis/config.OCIConfiguration"),
 						},
 					},
-					"secretNameExpression": {
+					"targetSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretNameExpression defines the names of the secrets which should be synced via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no secrets are synced",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "TargetSelector describes all selectors the deployer should depend on.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"),
+									},
+								},
+							},
 						},
 					},
-					"shootNameExpression": {
+					"failOnUnhealthyTarget": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ShootNameExpression defines the names of shoot clusters for which targets with short living access data to the shoots are created via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no targets for the shoots are created",
-							Default:     "",
-							Type:        []string{"string"},
+							Description: "FailOnUnhealthyTarget lets deploy items fail fast with a recoverable error, if the target health check of the landscaper has recently found their target unreachable.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"tokenRotation": {
+					"export": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TokenRotation defines the data to perform an automatic rotation of the token to access the source cluster with the secrets to sync. The token expires after 90 days and will be rotated every 60 days.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TokenRotation"),
+							Description: "Export defines the export configuration.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ExportConfiguration"),
+						},
+					},
+					"controller": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Controller contains configuration concerning the controller framework.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Controller"),
 						},
 					},
 				},
-				Required: []string{"sourceNamespace", "secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TokenRotation"},
+			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ExportConfiguration"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetSyncStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "TargetSyncStatus contains the status of a TargetSync.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"observedGeneration": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"lastUpdateTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Last time the status was updated",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"lastErrors": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastErrors describe the last errors",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"lastTokenRotationTime": {
+			SchemaProps: spec.SchemaProps{
+				Description: "Controller contains configuration concerning the controller framework.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"CommonControllerConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the token was rotated",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
 						},
 					},
 				},
+				Required: []string{"CommonControllerConfig"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_ExportConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetTemplate exposes specific parts of a target that are used in the exports to export a target",
+				Description: "ExportConfiguration defines the export configuration for the deployer.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"config": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
-						},
-					},
-					"secretRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
-						},
-					},
-					"labels": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects. May match selectors of replication controllers and services. More info: http://kubernetes.io/docs/user-guide/labels",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"annotations": {
+					"defaultTimeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata. They are not queryable and should be preserved when modifying objects. More info: http://kubernetes.io/docs/user-guide/annotations",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "DefaultTimeout configures the default timeout for all exports without a explicit export timeout defined.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
 				},
-				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TemplateExecutor(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_HelmChartRepo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TemplateExecutor describes a templating mechanism and configuration.",
+				Description: "HelmChartRepo defines a reference to a chart in a helm chart repo",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"helmChartRepoUrl": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the template",
-							Default:     "",
+							Description: "HelmChartRepoUrl is the url of the helm chart repo. Urls with the \"oci://\" scheme refer to a location in an oci registry that contains the chart repository.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"type": {
+					"helmChartName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type describes the templating mechanism.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"file": {
+					"helmChartVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "File is the path to the template in the blueprint's content.",
+							Description: "HelmChartVersion is the version of the chart. It may also be a semver constraint like \"~1.4\" that is resolved against the versions of the repository index or, for oci registries, against the tags of the chart. The resolved version is recorded in the provider status.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"template": {
+					"pinVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Template contains an optional inline template. The template has to be of string for go template and either a string or valid yaml/json for spiff.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Description: "PinVersion keeps the chart version that was resolved for a version constraint until the next spec change of the deploy item. Otherwise the constraint is resolved again on every reconcile, which upgrades the release to newer matching versions.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "type"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TokenRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_HelmChartRepoCredentials(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "HelmChartRepoCredentials contains the credentials to access hepl chart repos",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"auths": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled defines if automatic token is executed",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Auth"),
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Auth"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TypedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_HelmDeploymentConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TypedObjectReference is a reference to a typed kubernetes object.",
+				Description: "HelmDeploymentConfiguration defines settings for a helm deployment.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"apiVersion": {
+					"install": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion is the group and version for the resource being referenced. If APIVersion is not specified, the specified Kind must be in the core API group. For any other third-party types, APIVersion is required.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+									},
+								},
+							},
 						},
 					},
-					"kind": {
+					"upgrade": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is the type of resource being referenced",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+									},
+								},
+							},
 						},
 					},
-					"name": {
+					"uninstall": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+									},
+								},
+							},
 						},
 					},
-					"namespace": {
+					"test": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"apiVersion", "kind", "name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_VersionedNamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_HelmInstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VersionedNamedObjectReference is a named reference to a object with its last observed resource generation. This struct is used by status fields.",
+				Description: "HelmUpgradeConfiguration defines settings for a helm upgrade operation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"atomic": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the reference.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"ref": {
+					"timeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference is the reference to an object.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.VersionedObjectReference"),
+							Description: "Timeout is the timeout for the operation in minutes.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
 				},
-				Required: []string{"name", "ref"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.VersionedObjectReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_VersionedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_HelmTestConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VersionedObjectReference is a reference to a object with its last observed resource generation. This struct is used by status fields.",
+				Description: "HelmTestConfiguration defines settings for the helm tests that are run after a successful install or upgrade of the release.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"namespace": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
-							Type:        []string{"string"},
+							Description: "Enabled configures whether the helm tests of the chart are run.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"observedGeneration": {
+					"timeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration defines the last observed generation of the referenced resource.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Timeout is the time to wait for the completion of the tests. Defaults to 5 minutes.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
 				},
-				Required: []string{"name", "observedGeneration"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_VersionedResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_HelmUninstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "VersionedResourceReference defines the reference to a resource with its version.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"componentName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"resourceName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName defines the name of the resource.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"version": {
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmUninstallConfiguration defines settings for a helm uninstall operation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version defines the version of the component.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Timeout is the timeout for the operation in minutes.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
 				},
-				Required: []string{"componentName", "resourceName", "version"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_apis_deployer_container_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_HookStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Configuration is the container deployer configuration that configures the controller",
+				Description: "HookStatus describes the last execution of a hook of a helm release.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"identity": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Identity identity describes the unique identity of the deployer.",
+							Description: "Name is the name of the hook resource.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"oci": {
-						SchemaProps: spec.SchemaProps{
-							Description: "OCI configures the oci client of the controller",
-							Ref:         ref("github.com/gardener/landscaper/apis/config.OCIConfiguration"),
-						},
-					},
-					"namespace": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace defines the namespace where the pods should be executed. Defaults to default",
+							Description: "Kind is the kind of the hook resource.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"targetSelector": {
+					"events": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetSelector describes all selectors the deployer should depend on.",
+							Description: "Events are the events that trigger the hook, e.g. \"post-install\" or \"test\".",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"defaultImage": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DefaultImage configures the default images that is used if the DeployItem does not specify one.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec"),
-						},
-					},
-					"initContainer": {
-						SchemaProps: spec.SchemaProps{
-							Description: "InitContainerImage defines the image that is used to init the container. This container bootstraps the necessary directories and files.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec"),
-						},
-					},
-					"waitContainer": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SidecarContainerImage defines the image that is used as a sidecar to the defined main container. The sidecar container is responsible to collect the exports and the state of the main container.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec"),
-						},
-					},
-					"garbageCollection": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "GarbageCollection configures the container deployer garbage collector.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.GarbageCollection"),
+							Description: "Phase is the phase of the last execution of the hook. One of \"Running\", \"Succeeded\", \"Failed\" or \"Unknown\"; empty if the hook has not been executed.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"debug": {
+					"startedAt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DebugOptions configure additional debug options.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.DebugOptions"),
+							Description: "StartedAt is the time when the last execution of the hook started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"controller": {
+					"completedAt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Controller contains configuration concerning the controller framework.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Controller"),
+							Description: "CompletedAt is the time when the last execution of the hook completed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"defaultImage", "initContainer", "waitContainer", "garbageCollection"},
+				Required: []string{"name", "kind"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.DebugOptions", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.GarbageCollection"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_apis_deployer_container_v1alpha1_ContainerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_KustomizePostRenderer(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ContainerSpec defines a container specification",
+				Description: "KustomizePostRenderer is a kustomize overlay for the rendered manifests.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"image": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Docker image name. More info: https://kubernetes.io/docs/concepts/containers/images The image will be defaulted by the container deployer to the configured default.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"command": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"args": {
+					"files": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Arguments to the entrypoint. The docker image's CMD is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Files contains the files of the overlay as map of their relative paths to their content. The root of the files must contain a kustomization that lists the rendered manifests \"helm-output.yaml\" as resource.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -7352,112 +9548,66 @@ func schema_apis_deployer_container_v1alpha1_ContainerSpec(ref common.ReferenceC
 							},
 						},
 					},
-					"imagePullPolicy": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Image pull policy. One of Always, Never, IfNotPresent. Defaults to Always if :latest tag is specified, or IfNotPresent otherwise. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/images#updating-images\n\nPossible enum values:\n - `\"Always\"` means that kubelet always attempts to pull the latest image. Container will fail If the pull fails.\n - `\"IfNotPresent\"` means that kubelet pulls if the image isn't present on disk. Container will fail if the image isn't present and the pull fails.\n - `\"Never\"` means that kubelet never pulls an image, but only uses a local image. Container will fail if the image isn't present",
-							Type:        []string{"string"},
-							Format:      "",
-							Enum:        []interface{}{"Always", "IfNotPresent", "Never"}},
-					},
 				},
+				Required: []string{"files"},
 			},
 		},
 	}
 }
 
-func schema_apis_deployer_container_v1alpha1_ContainerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_PatchTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ContainerStatus describes the status of a pod with its init, wait and main container.",
+				Description: "PatchTarget selects the resources of a patch. Group, version, kind, name and namespace are regular expressions; empty fields match all resources.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"message": {
+					"group": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about why the pod is in this condition.",
+							Description: "Group is the api group of the resources.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reason": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A brief CamelCase message indicating details about why the pod is in this state. e.g. 'Evicted'",
+							Description: "Version is the api version of the resources.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"state": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Details about the container's current condition.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.ContainerState"),
-						},
-					},
-					"image": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The image the container is running. More info: https://kubernetes.io/docs/concepts/containers/images",
-							Default:     "",
+							Description: "Kind is the kind of the resources.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"imageID": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImageID of the container's image.",
-							Default:     "",
+							Description: "Name is the name of the resources.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"exitCode": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExitCode of the main container.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Namespace is the namespace of the resources.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-				Required: []string{"image", "imageID"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/api/core/v1.ContainerState"},
-	}
-}
-
-func schema_apis_deployer_container_v1alpha1_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Controller contains configuration concerning the controller framework.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"CommonControllerConfig": {
+					"labelSelector": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
+							Description: "LabelSelector selects the resources by their labels.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-				Required: []string{"CommonControllerConfig"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
-	}
-}
-
-func schema_apis_deployer_container_v1alpha1_DebugOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "DebugOptions defines optional debug options.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"keepPod": {
+					"annotationSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KeepPod will only remove the finalizer on the pod but will not delete the pod.",
-							Type:        []string{"boolean"},
+							Description: "AnnotationSelector selects the resources by their annotations.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
@@ -7467,100 +9617,76 @@ func schema_apis_deployer_container_v1alpha1_DebugOptions(ref common.ReferenceCa
 	}
 }
 
-func schema_apis_deployer_container_v1alpha1_GarbageCollection(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_PostRenderer(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GarbageCollection defines the container deployer garbage collection configuration.",
+				Description: "PostRenderer modifies the rendered manifests of a chart, e.g. to add labels or to rewrite image registries. The patches are applied before the kustomize overlay.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"disable": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Disable disables the garbage collector and the resources clean-up.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"worker": {
+					"patches": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Worker defines the number of parallel garbage collection routines. Defaults to 5.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Patches are strategic merge patches or JSON6902 patches that are applied to the rendered manifests.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.PostRendererPatch"),
+									},
+								},
+							},
 						},
 					},
-					"requeueTimeSeconds": {
+					"kustomize": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RequeueTime specifies the duration after which the object, which is not yet ready to be garbage collected, is requeued. Defaults to 60.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Kustomize is a kustomize overlay that is applied to the rendered manifests.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.KustomizePostRenderer"),
 						},
 					},
 				},
-				Required: []string{"disable", "worker", "requeueTimeSeconds"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.KustomizePostRenderer", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.PostRendererPatch"},
 	}
 }
 
-func schema_apis_deployer_container_v1alpha1_PodStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_PostRendererPatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PodStatus describes the status of a pod with its init, wait and main container",
+				Description: "PostRendererPatch is a patch of the rendered manifests.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"podName": {
+					"patch": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodName is the name of the created pod.",
+							Description: "Patch is a strategic merge patch or a JSON6902 patch in yaml or json format.",
 							Default:     "",
 							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"lastRun": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastRun is the time when the pod was executed the last time.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"containerStatus": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ContainerStatus contains the status of the pod that executes the configured container.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus"),
-						},
-					},
-					"initContainerStatus": {
-						SchemaProps: spec.SchemaProps{
-							Description: "InitContainerStatus contains the status of the init container.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus"),
+							Format:      "",
 						},
 					},
-					"waitContainerStatus": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WaitContainerStatus contains the status of the wait container.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus"),
+							Description: "Target selects the resources that are patched. A strategic merge patch without target is applied to the resource with the kind and name of the patch, a JSON6902 patch requires a target.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.PatchTarget"),
 						},
 					},
 				},
-				Required: []string{"podName", "containerStatus", "initContainerStatus", "waitContainerStatus"},
+				Required: []string{"patch"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.PatchTarget"},
 	}
 }
 
-func schema_apis_deployer_container_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderConfiguration is the container deployer configuration that is expected in a DeployItem",
+				Description: "ProviderConfiguration is the helm deployer configuration that is expected in a DeployItem",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -7577,188 +9703,143 @@ func schema_apis_deployer_container_v1alpha1_ProviderConfiguration(ref common.Re
 							Format:      "",
 						},
 					},
-					"image": {
+					"kubeconfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Docker image name. More info: https://kubernetes.io/docs/concepts/containers/images The image will be defaulted by the container deployer to the configured default.",
+							Description: "Kubeconfig is the base64 encoded kubeconfig file. By default the configured target is used to deploy the resources",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"command": {
+					"updateStrategy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "UpdateStrategy defines the strategy how the manifests are updated in the cluster. Defaults to \"update\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"args": {
+					"serverSideApply": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Arguments to the entrypoint. The docker image's CMD is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "ServerSideApply configures the server-side apply of the manifests. Only relevant if the update strategy is \"serverSideApply\".",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration"),
 						},
 					},
-					"importValues": {
+					"readinessChecks": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportValues contains the import values for the container.",
-							Type:        []string{"string"},
-							Format:      "byte",
+							Description: "ReadinessChecks configures the readiness checks.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"),
 						},
 					},
-					"blueprint": {
+					"deleteTimeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Blueprint is the resolved reference to the Blueprint definition",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition"),
+							Description: "DeleteTimeout is the time to wait before giving up on a resource to be deleted. Defaults to 180s.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
-					"componentDescriptor": {
+					"chart": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentDescriptor is the resolved reference to the ComponentDescriptor defnition",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition"),
+							Description: "Chart defines helm chart to be templated and applied.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Chart"),
 						},
 					},
-					"registryPullSecrets": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints and component descriptors from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
-									},
-								},
-							},
+							Description: "Name is the release name of the chart",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"continuousReconcile": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
+							Description: "Namespace is the release namespace of the chart",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"},
-	}
-}
-
-func schema_apis_deployer_container_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ProviderStatus is the container provider specific status",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"createNamespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
+							Description: "CreateNamespace configures the deployer to create the release namespace if not present. The behavior is similar to the \"helm install --create-namespace\"",
+							Default:     false,
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"values": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Values are the values that are used for templating.",
 							Type:        []string{"string"},
-							Format:      "",
+							Format:      "byte",
 						},
 					},
-					"lastOperation": {
+					"exportsFromManifests": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastOperation defines the last run operation of the pod. The operation can be either reconcile or deletion.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ExportsFromManifests describe the exports from the templated manifests that should be exported by the helm deployer. DEPRECATED",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export"),
+									},
+								},
+							},
 						},
 					},
-					"podStatus": {
+					"exports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodStatus indicated the status of the executed pod.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PodStatus"),
+							Description: "Exports describe the exports from the templated manifests that should be exported by the helm deployer.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports"),
 						},
 					},
-				},
-				Required: []string{"lastOperation"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PodStatus"},
-	}
-}
-
-func schema_apis_deployer_core_v1alpha1_Owner(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Owner are resources that can hold any kind of json or yaml data.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"continuousReconcile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
 						},
 					},
-					"apiVersion": {
+					"driftDetection": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "DriftDetection configures the periodic detection of managed resources that differ from their last applied manifests.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftDetectionSpec"),
 						},
 					},
-					"metadata": {
+					"postRenderer": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "PostRenderer modifies the rendered manifests of the chart before they are deployed.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.PostRenderer"),
 						},
 					},
-					"spec": {
+					"helmDeployment": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains configuration for a deployer ownership.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/core/v1alpha1.OwnerSpec"),
+							Description: "HelmDeployment indicates that helm is used as complete deployment mechanism and not only helm templating. Default is true.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"status": {
+					"helmDeploymentConfig": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/landscaper/apis/deployer/core/v1alpha1.OwnerStatus"),
+							Description: "HelmDeploymentConfig contains settings for helm operations. Only relevant if HelmDeployment is true.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration"),
 						},
 					},
 				},
-				Required: []string{"spec", "status"},
+				Required: []string{"chart", "name", "namespace", "createNamespace"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/core/v1alpha1.OwnerSpec", "github.com/gardener/landscaper/apis/deployer/core/v1alpha1.OwnerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Chart", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.PostRenderer", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftDetectionSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
 	}
 }
 
-func schema_apis_deployer_core_v1alpha1_OwnerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OwnerList contains a list of Owners.",
+				Description: "ProviderStatus is the helm provider specific status",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -7775,225 +9856,289 @@ func schema_apis_deployer_core_v1alpha1_OwnerList(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"managedResources": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "ManagedResources contains all kubernetes resources that are deployed by the helm deployer.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"),
+									},
+								},
+							},
 						},
 					},
-					"items": {
+					"drift": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Drift contains the result of the last drift detection.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftStatus"),
+						},
+					},
+					"hooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hooks contains the last executions of the hooks of the helm release including the helm tests. Only set if helm is used as deployment mechanism.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/core/v1alpha1.Owner"),
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HookStatus"),
 									},
 								},
 							},
 						},
 					},
+					"chartVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChartVersion contains the chart version that was resolved for the helm chart repo of the last reconcile.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ResolvedChartVersion"),
+						},
+					},
+					"release": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Release describes the helm release and its revision history. Only set if helm is used as deployment mechanism.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ReleaseStatus"),
+						},
+					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/core/v1alpha1.Owner", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HookStatus", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ReleaseStatus", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ResolvedChartVersion", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftStatus", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
 	}
 }
 
-func schema_apis_deployer_core_v1alpha1_OwnerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_ReleaseRevision(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OwnerSpec describes the configuration for a deployer ownership.",
+				Description: "ReleaseRevision describes a revision of a helm release.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"revision": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type describes the type of the deployer.",
+							Description: "Revision is the number of the revision.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the status of the revision, e.g. \"deployed\", \"superseded\" or \"failed\".",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"deployerId": {
+					"chartVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeployerId describes the unique identity of a deployer.",
+							Description: "ChartVersion is the version of the chart of the revision.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"targets": {
+					"appVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Targets is a list of targets the referenced deployer is responsible for.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
-									},
-								},
-							},
+							Description: "AppVersion is the app version of the chart of the revision.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"updated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Updated is the time when the revision was deployed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is the description of the revision, e.g. \"Rollback to 2\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"type", "deployerId"},
+				Required: []string{"revision", "status", "chartVersion"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_apis_deployer_core_v1alpha1_OwnerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_ReleaseStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OwnerStatus describes the status for a deployer ownership.",
+				Description: "ReleaseStatus describes a helm release.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"accepted": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Accepted defines if the responsible controller has accepted the owner.",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "Name is the name of the release.",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"observedGeneration": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration indicates the generation that was last observed by the responsive deployer controller.",
+							Description: "Namespace is the namespace of the release.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the current revision of the release.",
 							Default:     0,
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the status of the current revision, e.g. \"deployed\" or \"failed\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"chartVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChartVersion is the version of the chart of the current revision.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"history": {
+						SchemaProps: spec.SchemaProps{
+							Description: "History contains the revisions of the release, ordered from the newest to the oldest.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ReleaseRevision"),
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"accepted", "observedGeneration"},
+				Required: []string{"name", "namespace", "revision", "status", "chartVersion"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ReleaseRevision"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_ArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_RemoteArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ArchiveAccess defines the access for a helm chart as compressed archive.",
+				Description: "RemoteArchiveAccess defines the remote access for a helm chart as compressed archive.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"raw": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Raw defines a compressed tarred helm chart as base64 encoded string.",
+							Description: "URL defines a compressed tarred helm chart that is fetched from a url.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"remote": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Remote defines the remote access for a helm chart as compressed archive.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteArchiveAccess"),
-						},
-					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteArchiveAccess"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_Auth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_RemoteChartReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Auth contains authentication info for one helm chart repo",
+				Description: "RemoteChartReference defines a reference to a remote Helm chart through a Component-Descriptor",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"url": {
+					"ref": {
 						SchemaProps: spec.SchemaProps{
-							Description: "URL contains URL of helm chart repo",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ComponentDescriptorReference is the reference to a component descriptor",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"),
 						},
 					},
-					"customCAData": {
+					"inline": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CustomCAData allows you to add a custom CA, which is useful if your server speaks HTTPS with a self- signed certificate. The added certificate must be in PEM format and base64 encoded.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "InlineDescriptorReference defines an inline component descriptor",
+							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor"),
 						},
 					},
-					"authHeader": {
+					"resourceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AuthHeader contains the value that will be set in the \"Authorization\" header when fetching the Chart, e.g. \"Basic dX...3dvcmQ=\". Exactly one of the fields AuthHeader and SecretRef must be set.",
+							Description: "ResourceName is the name of the Helm chart as defined by a component descriptor.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"secretRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Reference to a secret containing the AuthHeader Exactly one of the fields AuthHeader and SecretRef must be set.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
-						},
-					},
 				},
+				Required: []string{"resourceName"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
+			"github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_Chart(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_ResolvedChartVersion(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Chart defines the helm chart to render and apply.",
+				Description: "ResolvedChartVersion describes the chart version that was resolved for the version of a helm chart repo.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ref": {
+					"constraint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ref defines the reference to a helm chart in a oci repository.",
+							Description: "Constraint is the version or version constraint of the helm chart repo.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"fromResource": {
-						SchemaProps: spec.SchemaProps{
-							Description: "FromResource fetches the chart based on the resource's access method. The resource is defined as part of a component descriptor which is necessary to also handle local artifacts.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteChartReference"),
-						},
-					},
-					"archive": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Archive defines a compressed tarred helm chart as base64 encoded string.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ArchiveAccess"),
+							Description: "Version is the resolved version of the chart.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"helmChartRepo": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HelmChartRepo defines a reference to a chart in a helm chart repo.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmChartRepo"),
+							Description: "ObservedGeneration is the generation of the deploy item for which the version was resolved.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
+				Required: []string{"constraint", "version", "observedGeneration"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ArchiveAccess", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmChartRepo", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteChartReference"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_job_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Configuration is the helm deployer configuration that configures the controller",
+				Description: "Configuration is the job deployer configuration that configures the controller.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -8017,12 +10162,6 @@ func schema_apis_deployer_helm_v1alpha1_Configuration(ref common.ReferenceCallba
 							Format:      "",
 						},
 					},
-					"oci": {
-						SchemaProps: spec.SchemaProps{
-							Description: "OCI configures the oci client of the controller",
-							Ref:         ref("github.com/gardener/landscaper/apis/config.OCIConfiguration"),
-						},
-					},
 					"targetSelector": {
 						SchemaProps: spec.SchemaProps{
 							Description: "TargetSelector describes all selectors the deployer should depend on.",
@@ -8037,29 +10176,36 @@ func schema_apis_deployer_helm_v1alpha1_Configuration(ref common.ReferenceCallba
 							},
 						},
 					},
+					"failOnUnhealthyTarget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailOnUnhealthyTarget lets deploy items fail fast with a recoverable error, if the target health check of the landscaper has recently found their target unreachable.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"export": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Export defines the export configuration.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ExportConfiguration"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/job/v1alpha1.ExportConfiguration"),
 						},
 					},
 					"controller": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Controller contains configuration concerning the controller framework.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Controller"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/job/v1alpha1.Controller"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ExportConfiguration"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/job/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/job/v1alpha1.ExportConfiguration"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_job_v1alpha1_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -8081,7 +10227,7 @@ func schema_apis_deployer_helm_v1alpha1_Controller(ref common.ReferenceCallback)
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_ExportConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_job_v1alpha1_ExportConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -8102,156 +10248,237 @@ func schema_apis_deployer_helm_v1alpha1_ExportConfiguration(ref common.Reference
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_HelmChartRepo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_job_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HelmChartRepo defines a reference to a chart in a helm chart repo",
+				Description: "ProviderConfiguration is the job deployer configuration that is expected in a DeployItem.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"helmChartRepoUrl": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"helmChartName": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"helmChartVersion": {
+					"kubeconfig": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kubeconfig is the base64 encoded kubeconfig file. By default the configured target is used to deploy the job.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"job": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Job is the manifest of the batch/v1 Job that is run on the target cluster. The name and the namespace of the job are required.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the time to wait for the completion of the job. Defaults to 10 minutes.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+					"deleteTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeleteTimeout is the time to wait for the deletion of a previous run of the job. Defaults to 180s.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+					"exports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exports describe the exports from the ConfigMaps or Secrets that are written by the job.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports"),
 						},
 					},
 				},
+				Required: []string{"job"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_HelmChartRepoCredentials(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_job_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HelmChartRepoCredentials contains the credentials to access hepl chart repos",
+				Description: "ProviderStatus is the job provider specific status",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"auths": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Auth"),
-									},
-								},
-							},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"job": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Job is the reference to the job on the target cluster.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TypedObjectReference"),
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the job.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is the time when the job was started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletionTime is the time when the job has completed successfully.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message describes why the job has failed.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Auth"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TypedObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_HelmDeploymentConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_manifest_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HelmDeploymentConfiguration defines settings for a helm deployment.",
+				Description: "Configuration is the manifest deployer configuration that configures the controller",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"install": {
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"identity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Identity identity describes the unique identity of the deployer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetSelector": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "TargetSelector describes all selectors the deployer should depend on.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"),
 									},
 								},
 							},
 						},
 					},
-					"upgrade": {
+					"failOnUnhealthyTarget": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
-									},
-								},
-							},
+							Description: "FailOnUnhealthyTarget lets deploy items fail fast with a recoverable error, if the target health check of the landscaper has recently found their target unreachable.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"uninstall": {
+					"oci": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
-									},
-								},
-							},
+							Description: "OCI configures the oci client of the controller. It is used to fetch kustomization sources that are referenced by a component descriptor.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config.OCIConfiguration"),
+						},
+					},
+					"export": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Export defines the export configuration.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.ExportConfiguration"),
+						},
+					},
+					"controller": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Controller contains configuration concerning the controller framework.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.Controller"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
+			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.ExportConfiguration"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_HelmInstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_manifest_v1alpha1_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HelmUpgradeConfiguration defines settings for a helm upgrade operation.",
+				Description: "Controller contains configuration concerning the controller framework.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"atomic": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"timeout": {
+					"CommonControllerConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Timeout is the timeout for the operation in minutes.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
 						},
 					},
 				},
+				Required: []string{"CommonControllerConfig"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_HelmUninstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_manifest_v1alpha1_ExportConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HelmUninstallConfiguration defines settings for a helm uninstall operation.",
+				Description: "ExportConfiguration defines the export configuration for the deployer.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"timeout": {
+					"defaultTimeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Timeout is the timeout for the operation in minutes.",
+							Description: "DefaultTimeout configures the default timeout for all exports without a explicit export timeout defined.",
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
@@ -8263,11 +10490,11 @@ func schema_apis_deployer_helm_v1alpha1_HelmUninstallConfiguration(ref common.Re
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_manifest_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderConfiguration is the helm deployer configuration that is expected in a DeployItem",
+				Description: "ProviderConfiguration is the manifest deployer configuration that is expected in a DeployItem",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -8294,7 +10521,7 @@ func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.Referen
 					},
 					"updateStrategy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UpdateStrategy defines the strategy how the manifests are updated in the cluster. Defaults to \"update\".",
+							Description: "UpdateStrategy defines the strategy how the manifest are updated in the cluster. Defaults to \"update\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -8312,53 +10539,14 @@ func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.Referen
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
-					"chart": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Chart defines helm chart to be templated and applied.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Chart"),
-						},
-					},
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the release name of the chart",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"namespace": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the release namespace of the chart",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"createNamespace": {
-						SchemaProps: spec.SchemaProps{
-							Description: "CreateNamespace configures the deployer to create the release namespace if not present. The behavior is similar to the \"helm install --create-namespace\"",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"values": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Values are the values that are used for templating.",
-							Type:        []string{"string"},
-							Format:      "byte",
-						},
-					},
-					"exportsFromManifests": {
+					"manifests": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportsFromManifests describe the exports from the templated manifests that should be exported by the helm deployer. DEPRECATED",
+							Description: "Manifests contains a list of manifests that should be applied in the target cluster",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export"),
+										Ref: ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 									},
 								},
 							},
@@ -8370,39 +10558,19 @@ func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.Referen
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports"),
 						},
 					},
-					"continuousReconcile": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
-						},
-					},
-					"helmDeployment": {
-						SchemaProps: spec.SchemaProps{
-							Description: "HelmDeployment indicates that helm is used as complete deployment mechanism and not only helm templating. Default is true.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"helmDeploymentConfig": {
-						SchemaProps: spec.SchemaProps{
-							Description: "HelmDeploymentConfig contains settings for helm operations. Only relevant if HelmDeployment is true.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration"),
-						},
-					},
 				},
-				Required: []string{"chart", "name", "namespace", "createNamespace"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Chart", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_manifest_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderStatus is the helm provider specific status",
+				Description: "ProviderStatus is the manifest provider specific status",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -8421,13 +10589,13 @@ func schema_apis_deployer_helm_v1alpha1_ProviderStatus(ref common.ReferenceCallb
 					},
 					"managedResources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ManagedResources contains all kubernetes resources that are deployed by the helm deployer.",
+							Description: "ManagedResources contains all kubernetes resources that are deployed by the manifest deployer.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TypedObjectReference"),
 									},
 								},
 							},
@@ -8437,71 +10605,15 @@ func schema_apis_deployer_helm_v1alpha1_ProviderStatus(ref common.ReferenceCallb
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
-	}
-}
-
-func schema_apis_deployer_helm_v1alpha1_RemoteArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "RemoteArchiveAccess defines the remote access for a helm chart as compressed archive.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"url": {
-						SchemaProps: spec.SchemaProps{
-							Description: "URL defines a compressed tarred helm chart that is fetched from a url.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
-			},
-		},
-	}
-}
-
-func schema_apis_deployer_helm_v1alpha1_RemoteChartReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "RemoteChartReference defines a reference to a remote Helm chart through a Component-Descriptor",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"ref": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ComponentDescriptorReference is the reference to a component descriptor",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"),
-						},
-					},
-					"inline": {
-						SchemaProps: spec.SchemaProps{
-							Description: "InlineDescriptorReference defines an inline component descriptor",
-							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor"),
-						},
-					},
-					"resourceName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName is the name of the Helm chart as defined by a component descriptor.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
-				Required: []string{"resourceName"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TypedObjectReference"},
 	}
 }
 
-func schema_apis_deployer_manifest_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_manifest_v1alpha2_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Configuration is the manifest deployer configuration that configures the controller",
+				Description: "Configuration is the manifest deployer configuration that configures the controller.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -8539,72 +10651,136 @@ func schema_apis_deployer_manifest_v1alpha1_Configuration(ref common.ReferenceCa
 							},
 						},
 					},
+					"failOnUnhealthyTarget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailOnUnhealthyTarget lets deploy items fail fast with a recoverable error, if the target health check of the landscaper has recently found their target unreachable.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"oci": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OCI configures the oci client of the controller. It is used to fetch kustomization sources that are referenced by a component descriptor.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config.OCIConfiguration"),
+						},
+					},
 					"export": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Export defines the export configuration.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.ExportConfiguration"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.ExportConfiguration"),
 						},
 					},
 					"controller": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Controller contains configuration concerning the controller framework.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.Controller"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.Controller"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.ExportConfiguration"},
+			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.Controller", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.ExportConfiguration"},
 	}
 }
 
-func schema_apis_deployer_manifest_v1alpha1_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_manifest_v1alpha2_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Description: "Controller contains configuration concerning the controller framework.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"CommonControllerConfig": {
+					"CommonControllerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
+						},
+					},
+				},
+				Required: []string{"CommonControllerConfig"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
+	}
+}
+
+func schema_apis_deployer_manifest_v1alpha2_ExportConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExportConfiguration defines the export configuration for the deployer.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"defaultTimeout": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
+							Description: "DefaultTimeout configures the default timeout for all exports without a explicit export timeout defined.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
 				},
-				Required: []string{"CommonControllerConfig"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_apis_deployer_manifest_v1alpha1_ExportConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_manifest_v1alpha2_KustomizeSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExportConfiguration defines the export configuration for the deployer.",
+				Description: "KustomizeSource defines the source of a kustomization. Exactly one of files or fromResource has to be defined.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"defaultTimeout": {
+					"path": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DefaultTimeout configures the default timeout for all exports without a explicit export timeout defined.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+							Description: "Path is the path of the kustomization directory relative to the root of the source. Defaults to the root of the source.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"files": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Files contains the files of the kustomization source as map of their relative paths to their content.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"fromResource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FromResource references a resource of a component descriptor that contains the kustomization source as tar archive which can optionally be gzip compressed.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.RemoteKustomizationReference"),
+						},
+					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy defines the manage policy for all resources of the kustomization. Defaults to \"manage\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+			"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.RemoteKustomizationReference"},
 	}
 }
 
-func schema_apis_deployer_manifest_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_manifest_v1alpha2_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -8640,6 +10816,12 @@ func schema_apis_deployer_manifest_v1alpha1_ProviderConfiguration(ref common.Ref
 							Format:      "",
 						},
 					},
+					"serverSideApply": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerSideApply configures the server-side apply of the manifests. Only relevant if the update strategy is \"serverSideApply\".",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration"),
+						},
+					},
 					"readinessChecks": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ReadinessChecks configures the readiness checks.",
@@ -8660,27 +10842,46 @@ func schema_apis_deployer_manifest_v1alpha1_ProviderConfiguration(ref common.Ref
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest"),
 									},
 								},
 							},
 						},
 					},
+					"kustomize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kustomize defines a kustomization that is built by the deployer. The resulting resources are applied in addition to the manifests.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.KustomizeSource"),
+						},
+					},
 					"exports": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Exports describe the exports from the templated manifests that should be exported by the helm deployer.",
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports"),
 						},
 					},
+					"continuousReconcile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
+						},
+					},
+					"driftDetection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriftDetection configures the periodic detection of managed resources that differ from their last applied manifests.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftDetectionSpec"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.KustomizeSource", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftDetectionSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
 	}
 }
 
-func schema_apis_deployer_manifest_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_manifest_v1alpha2_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -8703,31 +10904,73 @@ func schema_apis_deployer_manifest_v1alpha1_ProviderStatus(ref common.ReferenceC
 					},
 					"managedResources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ManagedResources contains all kubernetes resources that are deployed by the manifest deployer.",
+							Description: "ManagedResources contains all kubernetes resources that are deployed by the deployer.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TypedObjectReference"),
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"),
 									},
 								},
 							},
 						},
 					},
+					"drift": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Drift contains the result of the last drift detection.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TypedObjectReference"},
+			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftStatus", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
 	}
 }
 
-func schema_apis_deployer_manifest_v1alpha2_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_manifest_v1alpha2_RemoteKustomizationReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Configuration is the manifest deployer configuration that configures the controller.",
+				Description: "RemoteKustomizationReference defines a reference to a kustomization source through a Component-Descriptor",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentDescriptorReference is the reference to a component descriptor",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"),
+						},
+					},
+					"inline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InlineDescriptorReference defines an inline component descriptor",
+							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor"),
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName is the name of the kustomization source as defined by a component descriptor.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"resourceName"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"},
+	}
+}
+
+func schema_apis_deployer_mock_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Configuration is the helm deployer configuration that configures the controller",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -8765,76 +11008,88 @@ func schema_apis_deployer_manifest_v1alpha2_Configuration(ref common.ReferenceCa
 							},
 						},
 					},
-					"export": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Export defines the export configuration.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.ExportConfiguration"),
-						},
-					},
-					"controller": {
+					"failOnUnhealthyTarget": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Controller contains configuration concerning the controller framework.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.Controller"),
+							Description: "FailOnUnhealthyTarget lets deploy items fail fast with a recoverable error, if the target health check of the landscaper has recently found their target unreachable.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.Controller", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.ExportConfiguration"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"},
 	}
 }
 
-func schema_apis_deployer_manifest_v1alpha2_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_mock_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Controller contains configuration concerning the controller framework.",
+				Description: "ProviderConfiguration is the helm deployer configuration that is expected in a DeployItem",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"CommonControllerConfig": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-				Required: []string{"CommonControllerConfig"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
-	}
-}
-
-func schema_apis_deployer_manifest_v1alpha2_ExportConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ExportConfiguration defines the export configuration for the deployer.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"defaultTimeout": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DefaultTimeout configures the default timeout for all exports without a explicit export timeout defined.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase sets the phase of the DeployItem",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"initialPhase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitialPhase sets the phase of the DeployItem, but only if it is empty or \"Init\" Additionally, setting it will suppress the DeployItem phase being set to \"Succeeded\" after successful reconciliation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"providerStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderStatus sets the provider status to the given value",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"export": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Export sets the exported configuration to the given value",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"continuousReconcile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+			"github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_apis_deployer_manifest_v1alpha2_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_terraform_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderConfiguration is the manifest deployer configuration that is expected in a DeployItem",
+				Description: "Configuration is the terraform deployer configuration that configures the controller.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -8851,116 +11106,120 @@ func schema_apis_deployer_manifest_v1alpha2_ProviderConfiguration(ref common.Ref
 							Format:      "",
 						},
 					},
-					"kubeconfig": {
+					"identity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kubeconfig is the base64 encoded kubeconfig file. By default the configured target is used to deploy the resources",
-							Default:     "",
+							Description: "Identity identity describes the unique identity of the deployer.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"updateStrategy": {
+					"targetSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UpdateStrategy defines the strategy how the manifest are updated in the cluster. Defaults to \"update\".",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "TargetSelector describes all selectors the deployer should depend on.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"),
+									},
+								},
+							},
 						},
 					},
-					"readinessChecks": {
+					"failOnUnhealthyTarget": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ReadinessChecks configures the readiness checks.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"),
+							Description: "FailOnUnhealthyTarget lets deploy items fail fast with a recoverable error, if the target health check of the landscaper has recently found their target unreachable.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"deleteTimeout": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeleteTimeout is the time to wait before giving up on a resource to be deleted. Defaults to 180s.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+							Description: "Namespace is the namespace in the host cluster where the runner pods are executed and the terraform states are stored. Defaults to \"default\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"manifests": {
+					"defaultImage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Manifests contains a list of manifests that should be applied in the target cluster",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest"),
-									},
-								},
-							},
+							Description: "DefaultImage is the runner image that is used for all deploy items that do not define an image.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"exports": {
+					"controller": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exports describe the exports from the templated manifests that should be exported by the helm deployer.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports"),
+							Description: "Controller contains configuration concerning the controller framework.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/terraform/v1alpha1.Controller"),
 						},
 					},
-					"continuousReconcile": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/terraform/v1alpha1.Controller"},
+	}
+}
+
+func schema_apis_deployer_terraform_v1alpha1_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Controller contains configuration concerning the controller framework.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"CommonControllerConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
 						},
 					},
 				},
+				Required: []string{"CommonControllerConfig"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
+			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
 	}
 }
 
-func schema_apis_deployer_manifest_v1alpha2_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_terraform_v1alpha1_Export(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderStatus is the manifest provider specific status",
+				Description: "Export describes a terraform output that is exported by the deploy item.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Key is the key of the export.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"fromOutput": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "FromOutput is the name of the terraform output.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"managedResources": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ManagedResources contains all kubernetes resources that are deployed by the deployer.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"),
-									},
-								},
-							},
-						},
-					},
 				},
+				Required: []string{"key", "fromOutput"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
 	}
 }
 
-func schema_apis_deployer_mock_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_terraform_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Configuration is the helm deployer configuration that configures the controller",
+				Description: "ProviderConfiguration is the terraform deployer configuration that is expected in a DeployItem.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -8977,40 +11236,99 @@ func schema_apis_deployer_mock_v1alpha1_Configuration(ref common.ReferenceCallba
 							Format:      "",
 						},
 					},
-					"identity": {
+					"image": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Identity identity describes the unique identity of the deployer.",
+							Description: "Image is the image of the runner that executes terraform. Defaults to the runner image that is configured for the deployer.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"targetSelector": {
+					"binary": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetSelector describes all selectors the deployer should depend on.",
+							Description: "Binary is the name of the terraform binary in the runner image. Use \"tofu\" to run OpenTofu instead of terraform. Defaults to \"terraform\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"files": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Files contains the terraform configuration as a map of relative file paths to their content.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"variables": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Variables contains the values of the input variables of the terraform configuration.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"targetVariable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetVariable is the name of an input variable that is set to the configuration of the target of the deploy item.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"env": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Env defines additional environment variables of the terraform process.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the time to wait for the completion of a terraform run. Defaults to 30 minutes.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+					"exports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exports describe the terraform outputs that are exported by the deploy item.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"),
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/terraform/v1alpha1.Export"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"files"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/deployer/terraform/v1alpha1.Export", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_apis_deployer_mock_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_terraform_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderConfiguration is the helm deployer configuration that is expected in a DeployItem",
+				Description: "ProviderStatus is the terraform provider specific status",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -9027,44 +11345,51 @@ func schema_apis_deployer_mock_v1alpha1_ProviderConfiguration(ref common.Referen
 							Format:      "",
 						},
 					},
-					"phase": {
+					"lastOperation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase sets the phase of the DeployItem",
+							Description: "LastOperation is the terraform operation of the last run.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"initialPhase": {
+					"pod": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InitialPhase sets the phase of the DeployItem, but only if it is empty or \"Init\" Additionally, setting it will suppress the DeployItem phase being set to \"Succeeded\" after successful reconciliation",
+							Description: "Pod is the name of the runner pod of the last run.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"providerStatus": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ProviderStatus sets the provider status to the given value",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Description: "Phase is the phase of the last run.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"export": {
+					"startTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Export sets the exported configuration to the given value",
-							Type:        []string{"string"},
-							Format:      "byte",
+							Description: "StartTime is the time when the last run was started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"continuousReconcile": {
+					"completionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
+							Description: "CompletionTime is the time when the last run has completed successfully.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message describes why the last run has failed.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -9096,6 +11421,139 @@ func schema_apis_deployer_utils_continuousreconcile_ContinuousReconcileSpec(ref
 	}
 }
 
+func schema_apis_deployer_utils_managedresource_DriftDetectionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DriftDetectionSpec configures the periodic comparison of the managed resources with their last applied manifests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"every": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Every is the interval in which the managed resources are checked for drift.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy defines how drifted resources are handled. Defaults to \"report\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"every"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
+func schema_apis_deployer_utils_managedresource_DriftStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DriftStatus describes the result of the last drift detection.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastCheckTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCheckTime is the time when the managed resources have been checked for drift the last time.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"driftedResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriftedResources contains the managed resources that differ from their last applied manifests.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftedResource"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"lastCheckTime"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftedResource", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_apis_deployer_utils_managedresource_DriftedResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DriftedResource describes a managed resource that differs from its last applied manifest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resource describes the drifted kubernetes resource.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
+						},
+					},
+					"fields": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Fields contains the paths of the fields that differ from the last applied manifest.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"missing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Missing is true if the resource does not exist anymore.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"managers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Managers contains the field managers that own the drifted fields.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"corrected": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Corrected is true if the manifest of the resource has been re-applied.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"resource"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ObjectReference"},
+	}
+}
+
 func schema_apis_deployer_utils_managedresource_Export(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9308,6 +11766,33 @@ func schema_apis_deployer_utils_managedresource_Manifest(ref common.ReferenceCal
 	}
 }
 
+func schema_apis_deployer_utils_managedresource_ServerSideApplyConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServerSideApplyConfiguration configures how manifests are applied with the server-side apply update strategy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"fieldManager": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FieldManager is the name of the field manager that owns the applied fields. Defaults to \"landscaper\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"forceConflicts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ForceConflicts takes over the ownership of fields that are managed by another field manager. If false, the apply fails if a field with a different value is owned by another field manager.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_apis_deployer_utils_readinesschecks_CustomReadinessCheckConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
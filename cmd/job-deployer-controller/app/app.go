@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	jobctlr "github.com/gardener/landscaper/pkg/deployer/job"
+	"github.com/gardener/landscaper/pkg/version"
+)
+
+func NewJobDeployerControllerCommand(ctx context.Context) *cobra.Command {
+	options := NewOptions()
+
+	cmd := &cobra.Command{
+		Use:          "job-deployer",
+		Short:        fmt.Sprintf("Job Deployer is a controller that runs kubernetes jobs based on DeployItems of type %s", jobctlr.Type),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.Complete(); err != nil {
+				return err
+			}
+			return options.run(ctx)
+		},
+	}
+
+	options.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	o.DeployerOptions.Log.Info("Starting Job Deployer", lc.KeyVersion, version.Get().GitVersion)
+	if err := jobctlr.AddDeployerToManager(o.DeployerOptions.Log, o.DeployerOptions.LsMgr, o.DeployerOptions.HostMgr, o.Config); err != nil {
+		return fmt.Errorf("unable to setup job controller: %w", err)
+	}
+	return o.DeployerOptions.StartManagers(ctx)
+}
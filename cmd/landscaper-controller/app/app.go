@@ -44,6 +44,7 @@ import (
 	controllerruntimeMetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	installationsctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/controllers/targethealth"
 	"github.com/gardener/landscaper/pkg/landscaper/controllers/targetsync"
 
 	"github.com/gardener/landscaper/pkg/landscaper/crdmanager"
@@ -202,6 +203,10 @@ func (o *Options) run(ctx context.Context) error {
 		return fmt.Errorf("unable to register target sync controller: %w", err)
 	}
 
+	if err := targethealth.AddControllerToManager(ctrlLogger, lsMgr, o.Config.Controllers.Targets); err != nil {
+		return fmt.Errorf("unable to register target health controller: %w", err)
+	}
+
 	setupLogger.Info("starting the controllers")
 	eg, ctx := errgroup.WithContext(ctx)
 
@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/gardener/component-spec/bindings-go/ctf"
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"github.com/mandelsoft/vfs/pkg/projectionfs"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	componentsregistry "github.com/gardener/landscaper/pkg/landscaper/registry/components"
+	lsutils "github.com/gardener/landscaper/pkg/utils/landscaper"
+	"github.com/gardener/landscaper/pkg/version"
+)
+
+// NewLandscaperRenderCommand creates a new command that renders a blueprint locally
+func NewLandscaperRenderCommand(ctx context.Context) *cobra.Command {
+	options := NewOptions()
+
+	cmd := &cobra.Command{
+		Use:   "landscaper-render",
+		Short: "Renders a blueprint with its subinstallations and deploy items locally without a cluster",
+		Long: `Renders a blueprint with all of its subinstallations and deploy items locally without a cluster.
+The rendered installations, deploy items, imports, template states and exports are printed as yaml.
+Exports of deploy items and installations can be simulated with export templates.`,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.Complete(ctx); err != nil {
+				return err
+			}
+			return options.run(ctx, cmd.OutOrStdout())
+		},
+	}
+
+	options.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context, out io.Writer) error {
+	o.Log.Debug("Starting landscaper render", lc.KeyVersion, version.Get().GitVersion)
+	ctx = logging.NewContext(ctx, o.Log)
+
+	componentsPath, cleanup, err := o.prepareComponentDescriptors()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	registry, err := componentsregistry.NewLocalClient(componentsPath)
+	if err != nil {
+		return fmt.Errorf("unable to create local component registry for %s: %w", componentsPath, err)
+	}
+	repositoryContext, err := cdv2.NewUnstructured(componentsregistry.NewLocalRepository(componentsPath))
+	if err != nil {
+		return fmt.Errorf("unable to build repository context: %w", err)
+	}
+
+	cd, blobResolver, err := registry.ResolveWithBlobResolver(ctx, &repositoryContext, o.componentName, o.componentVersion)
+	if err != nil {
+		return fmt.Errorf("unable to resolve component %s:%s: %w", o.componentName, o.componentVersion, err)
+	}
+
+	cdList := &cdv2.ComponentDescriptorList{}
+	if err := o.collectComponents(ctx, registry, &repositoryContext, cd, cdList); err != nil {
+		return err
+	}
+
+	blueprint, err := o.getBlueprint(ctx, cd, blobResolver)
+	if err != nil {
+		return err
+	}
+
+	simulator, err := lsutils.NewInstallationSimulator(cdList, registry, &repositoryContext, o.exportTemplates)
+	if err != nil {
+		return fmt.Errorf("unable to create installation simulator: %w", err)
+	}
+	result := lsutils.NewSimulatorResult()
+	simulator.SetCallbacks(result)
+
+	if _, err := simulator.Run(cd, blueprint, o.dataImports, o.targetImports); err != nil {
+		return fmt.Errorf("unable to render blueprint: %w", err)
+	}
+
+	data, err := yaml.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("unable to encode rendered resources: %w", err)
+	}
+
+	if len(o.outputPath) != 0 {
+		if err := os.WriteFile(o.outputPath, data, 0644); err != nil {
+			return fmt.Errorf("unable to write rendered resources to %s: %w", o.outputPath, err)
+		}
+		return nil
+	}
+	_, err = out.Write(data)
+	return err
+}
+
+// prepareComponentDescriptors returns a directory that contains the component descriptors.
+// A ctf archive is extracted into a temporary directory that is removed by the returned cleanup function.
+func (o *options) prepareComponentDescriptors() (string, func(), error) {
+	info, err := os.Stat(o.componentDescriptorsPath)
+	if err != nil {
+		return "", nil, fmt.Errorf("unable to read component descriptors from %s: %w", o.componentDescriptorsPath, err)
+	}
+	if info.IsDir() {
+		return o.componentDescriptorsPath, func() {}, nil
+	}
+
+	tmpDir, err := os.MkdirTemp("", "landscaper-render-")
+	if err != nil {
+		return "", nil, fmt.Errorf("unable to create temporary directory: %w", err)
+	}
+	cleanup := func() {
+		if err := os.RemoveAll(tmpDir); err != nil {
+			o.Log.Error(err, "unable to remove temporary directory", "path", tmpDir)
+		}
+	}
+
+	if err := extractCTF(o.componentDescriptorsPath, tmpDir); err != nil {
+		cleanup()
+		return "", nil, err
+	}
+	return tmpDir, cleanup, nil
+}
+
+// extractCTF writes all component archives of the ctf archive at the given path to the given directory.
+func extractCTF(ctfPath, dir string) error {
+	fs := osfs.New()
+	archive, err := ctf.NewCTF(fs, ctfPath)
+	if err != nil {
+		return fmt.Errorf("unable to read ctf archive from %s: %w", ctfPath, err)
+	}
+	defer archive.Close()
+
+	i := 0
+	err = archive.Walk(func(ca *ctf.ComponentArchive) error {
+		i++
+		return ca.WriteToFilesystem(fs, filepath.Join(dir, fmt.Sprintf("component-%d", i)))
+	})
+	if err != nil {
+		return fmt.Errorf("unable to extract component archives from ctf archive %s: %w", ctfPath, err)
+	}
+	return nil
+}
+
+// collectComponents adds the given component descriptor and all transitively referenced component descriptors to the list.
+// Referenced components that are not available locally are skipped.
+func (o *options) collectComponents(ctx context.Context,
+	registry componentsregistry.TypedRegistry,
+	repositoryContext *cdv2.UnstructuredTypedObject,
+	cd *cdv2.ComponentDescriptor,
+	cdList *cdv2.ComponentDescriptorList) error {
+
+	for _, component := range cdList.Components {
+		if component.GetName() == cd.GetName() && component.GetVersion() == cd.GetVersion() {
+			return nil
+		}
+	}
+	cdList.Components = append(cdList.Components, *cd)
+
+	for _, ref := range cd.ComponentReferences {
+		refCd, err := registry.Resolve(ctx, repositoryContext, ref.ComponentName, ref.Version)
+		if err != nil {
+			if errors.Is(err, cdv2.NotFound) {
+				o.Log.Info("referenced component not found locally", lc.KeyCDName, ref.ComponentName, lc.KeyVersion, ref.Version)
+				continue
+			}
+			return fmt.Errorf("unable to resolve referenced component %s:%s: %w", ref.ComponentName, ref.Version, err)
+		}
+		if err := o.collectComponents(ctx, registry, repositoryContext, refCd, cdList); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getBlueprint reads the blueprint from the local blueprint directory or from the blueprint resource of the component.
+func (o *options) getBlueprint(ctx context.Context, cd *cdv2.ComponentDescriptor, blobResolver ctf.BlobResolver) (*blueprints.Blueprint, error) {
+	if len(o.blueprintPath) == 0 {
+		blueprint, err := blueprints.ResolveBlueprintFromBlobResolver(ctx, cd, blobResolver, o.blueprintResourceName)
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve blueprint resource %s of component %s:%s: %w", o.blueprintResourceName, cd.GetName(), cd.GetVersion(), err)
+		}
+		return blueprint, nil
+	}
+
+	blueprintFs, err := projectionfs.New(osfs.New(), o.blueprintPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create filesystem for blueprint %s: %w", o.blueprintPath, err)
+	}
+	blueprint, err := blueprints.NewFromFs(blueprintFs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read blueprint from %s: %w", o.blueprintPath, err)
+	}
+	return blueprint, nil
+}
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/gardener/component-spec/bindings-go/ctf"
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/landscaper/cmd/landscaper-render/app"
+	lsutils "github.com/gardener/landscaper/pkg/utils/landscaper"
+	"github.com/gardener/landscaper/pkg/utils/tar"
+)
+
+func TestConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Landscaper Render Command Test Suite")
+}
+
+const componentsDir = "../../../pkg/utils/landscaper/testdata/01-subinstallations"
+
+var _ = Describe("Render", func() {
+
+	render := func(componentDescriptors string, extraArgs ...string) *lsutils.SimulatorResult {
+		out := &bytes.Buffer{}
+		cmd := app.NewLandscaperRenderCommand(context.Background())
+		cmd.SetOut(out)
+		cmd.SetArgs(append([]string{
+			"--component-descriptors", componentDescriptors,
+			"--component-name", "example.com/root",
+			"--component-version", "v0.1.0",
+			"--data-imports", "./testdata/data-imports.yaml",
+			"--target-imports", "./testdata/target-imports.yaml",
+			"--export-templates", "./testdata/export-templates.yaml",
+		}, extraArgs...))
+		Expect(cmd.Execute()).To(Succeed())
+
+		result := &lsutils.SimulatorResult{}
+		Expect(yaml.Unmarshal(out.Bytes(), result)).To(Succeed())
+		return result
+	}
+
+	expectRenderedSubinstallations := func(result *lsutils.SimulatorResult) {
+		Expect(result.Installations).To(HaveLen(4))
+		Expect(result.Installations).To(HaveKey("root/subinst-a"))
+		Expect(result.Installations).To(HaveKey("root/subinst-b"))
+		Expect(result.Installations).To(HaveKey("root/subinst-c"))
+
+		Expect(result.DeployItems).To(HaveLen(2))
+		Expect(result.DeployItems).To(HaveKey("root/subinst-a/subinst-a-deploy"))
+		Expect(result.DeployItems).To(HaveKey("root/subinst-b/subinst-b-deploy"))
+
+		Expect(result.Imports).To(HaveKeyWithValue("root", HaveKeyWithValue("root-param-a", "value-a")))
+		Expect(result.DeployItemTemplateState).To(HaveKey("root/subinst-a"))
+
+		Expect(result.Exports).To(HaveKeyWithValue("root", HaveKeyWithValue("export-root-a", "subinst-a-deploy")))
+		Expect(result.Exports).To(HaveKeyWithValue("root", HaveKeyWithValue("export-root-c", "subinst-c")))
+	}
+
+	It("should render a blueprint of a component in a local directory", func() {
+		expectRenderedSubinstallations(render(componentsDir))
+	})
+
+	It("should render a local blueprint directory", func() {
+		result := render(componentsDir, "--blueprint", filepath.Join(componentsDir, "root/blobs/blueprint"))
+		expectRenderedSubinstallations(result)
+	})
+
+	It("should render a blueprint of a component in a ctf archive", func() {
+		ctfPath := filepath.Join(GinkgoT().TempDir(), "ctf.tar")
+		Expect(os.WriteFile(ctfPath, []byte{}, os.ModePerm)).To(Succeed())
+		archive, err := ctf.NewCTF(osfs.New(), ctfPath)
+		Expect(err).ToNot(HaveOccurred())
+		defer archive.Close()
+
+		componentDirs, err := os.ReadDir(componentsDir)
+		Expect(err).ToNot(HaveOccurred())
+		for _, dir := range componentDirs {
+			ca, err := ctf.ComponentArchiveFromPath(filepath.Join(componentsDir, dir.Name()))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(archive.AddComponentArchive(newComponentArchiveWithBlueprintBlob(ca.ComponentDescriptor, filepath.Join(componentsDir, dir.Name())), ctf.ArchiveFormatTar)).To(Succeed())
+		}
+		Expect(archive.Write()).To(Succeed())
+
+		expectRenderedSubinstallations(render(ctfPath))
+	})
+
+	It("should fail if the component cannot be found", func() {
+		cmd := app.NewLandscaperRenderCommand(context.Background())
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetArgs([]string{
+			"--component-descriptors", componentsDir,
+			"--component-name", "example.com/unknown",
+			"--component-version", "v0.1.0",
+		})
+		Expect(cmd.Execute()).To(HaveOccurred())
+	})
+
+})
+
+// newComponentArchiveWithBlueprintBlob creates a component archive that contains the blueprint directory of the
+// given component as gzipped tar blob.
+func newComponentArchiveWithBlueprintBlob(cd *cdv2.ComponentDescriptor, componentDir string) *ctf.ComponentArchive {
+	fs := memoryfs.New()
+	Expect(fs.MkdirAll(ctf.BlobsDirectoryName, os.ModePerm)).To(Succeed())
+	blob, err := fs.Create(ctf.BlobPath("blueprint"))
+	Expect(err).ToNot(HaveOccurred())
+	Expect(tar.BuildTarGzip(osfs.New(), filepath.Join(componentDir, "blobs/blueprint"), blob)).To(Succeed())
+	Expect(blob.Close()).To(Succeed())
+	Expect(vfs.Exists(fs, ctf.BlobPath("blueprint"))).To(BeTrue())
+	return ctf.NewComponentArchive(cd, fs)
+}
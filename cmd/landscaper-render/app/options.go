@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	flag "github.com/spf13/pflag"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lsutils "github.com/gardener/landscaper/pkg/utils/landscaper"
+)
+
+// options holds the landscaper render options
+type options struct {
+	Log logging.Logger
+
+	// componentDescriptorsPath is the path to a directory or a ctf archive that contains the component descriptors.
+	componentDescriptorsPath string
+	// componentName is the name of the component that contains the blueprint.
+	componentName string
+	// componentVersion is the version of the component that contains the blueprint.
+	componentVersion string
+	// blueprintPath is the path to a local blueprint directory.
+	// If it is not set, the blueprint resource of the component is used.
+	blueprintPath string
+	// blueprintResourceName is the name of the blueprint resource in the component descriptor.
+	blueprintResourceName string
+	// dataImportsPaths are paths to files that contain the data imports.
+	dataImportsPaths []string
+	// targetImportsPaths are paths to files that contain the target imports.
+	targetImportsPaths []string
+	// exportTemplatesPath is the path to a file that contains the export templates.
+	exportTemplatesPath string
+	// outputPath is the path to a file the rendered resources are written to.
+	outputPath string
+
+	dataImports     map[string]interface{}
+	targetImports   map[string]interface{}
+	exportTemplates lsutils.ExportTemplates
+}
+
+// ImportValues describes the content of a file with data or target imports.
+type ImportValues struct {
+	Imports map[string]interface{} `json:"imports"`
+}
+
+// NewOptions returns a new options instance
+func NewOptions() *options {
+	return &options{}
+}
+
+// AddFlags adds flags passed via command line
+func (o *options) AddFlags(fs *flag.FlagSet) {
+	fs.StringVarP(&o.componentDescriptorsPath, "component-descriptors", "c", "", "path to a directory or a ctf archive that contains the component descriptors")
+	fs.StringVar(&o.componentName, "component-name", "", "name of the component that contains the blueprint")
+	fs.StringVar(&o.componentVersion, "component-version", "", "version of the component that contains the blueprint")
+	fs.StringVarP(&o.blueprintPath, "blueprint", "b", "", "path to a local blueprint directory. If not set, the blueprint resource of the component is rendered")
+	fs.StringVar(&o.blueprintResourceName, "blueprint-resource", "blueprint", "name of the blueprint resource in the component descriptor")
+	fs.StringArrayVar(&o.dataImportsPaths, "data-imports", nil, "path to a yaml file with data imports of the form 'imports: {<import name>: <value>}'. Can be specified multiple times")
+	fs.StringArrayVar(&o.targetImportsPaths, "target-imports", nil, "path to a yaml file with target imports of the form 'imports: {<import name>: <target>}'. Can be specified multiple times")
+	fs.StringVar(&o.exportTemplatesPath, "export-templates", "", "path to a yaml file with export templates that simulate the exports of deploy items and installations")
+	fs.StringVarP(&o.outputPath, "output", "o", "", "path to a file the rendered resources are written to. Defaults to stdout")
+	logging.InitFlags(fs)
+}
+
+// Complete initializes the options instance and validates flags
+func (o *options) Complete(_ context.Context) error {
+	log, err := logging.NewCliLogger()
+	if err != nil {
+		return err
+	}
+	o.Log = log.WithName("render")
+
+	if err := o.validate(); err != nil {
+		return err
+	}
+
+	o.dataImports, err = readImports(o.dataImportsPaths)
+	if err != nil {
+		return fmt.Errorf("unable to read data imports: %w", err)
+	}
+	o.targetImports, err = readImports(o.targetImportsPaths)
+	if err != nil {
+		return fmt.Errorf("unable to read target imports: %w", err)
+	}
+
+	if len(o.exportTemplatesPath) != 0 {
+		data, err := os.ReadFile(o.exportTemplatesPath)
+		if err != nil {
+			return fmt.Errorf("unable to read export templates from %s: %w", o.exportTemplatesPath, err)
+		}
+		if err := yaml.Unmarshal(data, &o.exportTemplates); err != nil {
+			return fmt.Errorf("unable to decode export templates from %s: %w", o.exportTemplatesPath, err)
+		}
+	}
+	return nil
+}
+
+func (o *options) validate() error {
+	var allErrs []error
+	if len(o.componentDescriptorsPath) == 0 {
+		allErrs = append(allErrs, errors.New("a path to the component descriptors must be defined"))
+	}
+	if len(o.componentName) == 0 {
+		allErrs = append(allErrs, errors.New("a component name must be defined"))
+	}
+	if len(o.componentVersion) == 0 {
+		allErrs = append(allErrs, errors.New("a component version must be defined"))
+	}
+	if len(o.blueprintPath) == 0 && len(o.blueprintResourceName) == 0 {
+		allErrs = append(allErrs, errors.New("either a blueprint path or a blueprint resource name must be defined"))
+	}
+	return utilerrors.NewAggregate(allErrs)
+}
+
+// readImports reads and merges the imports of all given files.
+func readImports(paths []string) (map[string]interface{}, error) {
+	imports := map[string]interface{}{}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read imports from %s: %w", path, err)
+		}
+		values := &ImportValues{}
+		if err := yaml.Unmarshal(data, values); err != nil {
+			return nil, fmt.Errorf("unable to decode imports from %s: %w", path, err)
+		}
+		for key, value := range values.Imports {
+			imports[key] = value
+		}
+	}
+	return imports, nil
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	terraformctlr "github.com/gardener/landscaper/pkg/deployer/terraform"
+	"github.com/gardener/landscaper/pkg/version"
+)
+
+func NewTerraformDeployerControllerCommand(ctx context.Context) *cobra.Command {
+	options := NewOptions()
+
+	cmd := &cobra.Command{
+		Use:          "terraform-deployer",
+		Short:        fmt.Sprintf("Terraform Deployer is a controller that runs terraform based on DeployItems of type %s", terraformctlr.Type),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.Complete(); err != nil {
+				return err
+			}
+			return options.run(ctx)
+		},
+	}
+
+	options.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	o.DeployerOptions.Log.Info("Starting Terraform Deployer", lc.KeyVersion, version.Get().GitVersion)
+	if err := terraformctlr.AddDeployerToManager(o.DeployerOptions.Log, o.DeployerOptions.LsMgr, o.DeployerOptions.HostMgr, o.Config); err != nil {
+		return fmt.Errorf("unable to setup terraform controller: %w", err)
+	}
+	return o.DeployerOptions.StartManagers(ctx)
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/deployer/terraform/runner"
+	"github.com/gardener/landscaper/pkg/version"
+)
+
+func NewTerraformDeployerRunnerCommand(ctx context.Context) *cobra.Command {
+	options := NewOptions()
+
+	cmd := &cobra.Command{
+		Use:     "terraform-deployer-runner",
+		Short:   "Runner executes terraform for a deploy item of the terraform deployer",
+		Version: version.Get().GitVersion,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.Complete(); err != nil {
+				fmt.Print(err)
+				os.Exit(1)
+			}
+			if err := options.run(ctx); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	options.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	o.log.Info("Starting terraform deployer runner", lc.KeyVersion, version.Get().GitVersion)
+	input, err := runner.ReadInput(o.inputPath)
+	if err != nil {
+		return err
+	}
+
+	restConfig, err := clientcmd.BuildConfigFromFlags("", "")
+	if err != nil {
+		return err
+	}
+	kubeClient, err := client.New(restConfig, client.Options{
+		Scheme: scheme.Scheme,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to build kubernetes client: %w", err)
+	}
+
+	return runner.New(kubeClient, input, o.workDir).Run(logging.NewContext(ctx, o.log))
+}
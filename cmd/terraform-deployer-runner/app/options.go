@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	goflag "flag"
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+type options struct {
+	log logging.Logger
+
+	inputPath string
+	workDir   string
+}
+
+func NewOptions() *options {
+	return &options{}
+}
+
+func (o *options) AddFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.inputPath, "input", "", "path to the runner input")
+	fs.StringVar(&o.workDir, "work-dir", "", "working directory for the terraform configuration and state")
+	logging.InitFlags(fs)
+
+	flag.CommandLine.AddGoFlagSet(goflag.CommandLine)
+}
+
+// Complete parses all options and flags and initializes the basic functions
+func (o *options) Complete() error {
+	log, err := logging.GetLogger()
+	if err != nil {
+		return err
+	}
+	o.log = log
+	ctrl.SetLogger(log.Logr())
+
+	if len(o.inputPath) == 0 {
+		return fmt.Errorf("an input has to be defined")
+	}
+	if len(o.workDir) == 0 {
+		return fmt.Errorf("a working directory has to be defined")
+	}
+	return nil
+}
@@ -46,7 +46,7 @@ bash "${API_PROJECT_ROOT}"/vendor/k8s.io/code-generator/generate-internal-groups
   $PROJECT_MOD_ROOT/pkg/client \
   $PROJECT_MOD_ROOT/apis/deployer \
   $PROJECT_MOD_ROOT/apis/deployer \
-  "utils/continuousreconcile utils/readinesschecks utils/managedresource helm:v1alpha1 container:v1alpha1 manifest:v1alpha1 manifest:v1alpha2 mock:v1alpha1 core:v1alpha1" \
+  "utils/continuousreconcile utils/readinesschecks utils/managedresource helm:v1alpha1 container:v1alpha1 manifest:v1alpha1 manifest:v1alpha2 mock:v1alpha1 job:v1alpha1 terraform:v1alpha1 core:v1alpha1" \
   --go-header-file "${PROJECT_ROOT}/hack/boilerplate.go.txt"
 
 echo "> Generating openapi definitions"
@@ -66,6 +66,8 @@ ${GOPATH}/bin/openapi-gen "$@" \
   --input-dirs=github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2 \
   --input-dirs=github.com/gardener/landscaper/apis/deployer/container/v1alpha1 \
   --input-dirs=github.com/gardener/landscaper/apis/deployer/mock/v1alpha1 \
+  --input-dirs=github.com/gardener/landscaper/apis/deployer/job/v1alpha1 \
+  --input-dirs=github.com/gardener/landscaper/apis/deployer/terraform/v1alpha1 \
   --input-dirs=github.com/gardener/component-spec/bindings-go/apis/v2 \
   --input-dirs=k8s.io/api/core/v1 \
   --input-dirs=k8s.io/apimachinery/pkg/apis/meta/v1 \
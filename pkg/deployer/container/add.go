@@ -64,13 +64,14 @@ func AddControllerToManager(logger logging.Logger, hostMgr, lsMgr manager.Manage
 	}
 
 	err = deployerlib.Add(log, lsMgr, hostMgr, deployerlib.DeployerArgs{
-		Name:            Name,
-		Version:         version.Get().String(),
-		Identity:        config.Identity,
-		Type:            Type,
-		Deployer:        deployer,
-		TargetSelectors: config.TargetSelector,
-		Options:         options,
+		Name:                  Name,
+		Version:               version.Get().String(),
+		Identity:              config.Identity,
+		Type:                  Type,
+		Deployer:              deployer,
+		TargetSelectors:       config.TargetSelector,
+		FailOnUnhealthyTarget: config.FailOnUnhealthyTarget,
+		Options:               options,
 	})
 	if err != nil {
 		return err
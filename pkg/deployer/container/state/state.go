@@ -141,12 +141,13 @@ func (s *State) Restore(ctx context.Context) error {
 	log.Info("Restoring state from secrets", "secretCount", len(secretList.Items))
 	secrets := map[string][]*corev1.Secret{}
 	var newest *corev1.Secret
-	for _, secret := range secretList.Items {
+	for i := range secretList.Items {
+		secret := &secretList.Items[i]
 		if newest == nil || newest.CreationTimestamp.Before(&secret.CreationTimestamp) {
-			newest = &secret
+			newest = secret
 		}
 		uuidStr := secret.Annotations[container.ContainerDeployerStateUUIDAnnotation]
-		secrets[uuidStr] = append(secrets[uuidStr], &secret)
+		secrets[uuidStr] = append(secrets[uuidStr], secret)
 	}
 	if newest == nil {
 		return nil
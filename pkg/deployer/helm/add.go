@@ -36,12 +36,13 @@ func AddDeployerToManager(logger logging.Logger, lsMgr, hostMgr manager.Manager,
 	}
 
 	return deployerlib.Add(log, lsMgr, hostMgr, deployerlib.DeployerArgs{
-		Name:            Name,
-		Version:         version.Get().String(),
-		Identity:        config.Identity,
-		Type:            Type,
-		Deployer:        d,
-		TargetSelectors: config.TargetSelector,
-		Options:         options,
+		Name:                  Name,
+		Version:               version.Get().String(),
+		Identity:              config.Identity,
+		Type:                  Type,
+		Deployer:              d,
+		TargetSelectors:       config.TargetSelector,
+		FailOnUnhealthyTarget: config.FailOnUnhealthyTarget,
+		Options:               options,
 	})
 }
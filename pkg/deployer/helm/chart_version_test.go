@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"helm.sh/helm/v3/pkg/chart"
+	chartloader "helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/repo"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1/helper"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/deployer/helm"
+)
+
+var _ = Describe("Chart Version", func() {
+
+	var (
+		ctx    context.Context
+		server *httptest.Server
+		lsCtx  *lsv1alpha1.Context
+	)
+
+	BeforeEach(func() {
+		ctx = logging.NewContext(context.Background(), logging.Discard())
+
+		// serve the testchart in multiple versions from a helm chart repository
+		ch, err := chartloader.LoadDir("./testdata/testchart")
+		Expect(err).ToNot(HaveOccurred())
+		dir, err := os.MkdirTemp("", "charts-")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+
+		archives := map[string][]byte{}
+		index := repo.NewIndexFile()
+		for _, version := range []string{"1.4.0", "1.4.2", "1.5.0"} {
+			ch.Metadata.Version = version
+			archivePath, err := chartutil.Save(ch, dir)
+			Expect(err).ToNot(HaveOccurred())
+			archives["/"+ch.Name()+"-"+version+".tgz"], err = os.ReadFile(archivePath)
+			Expect(err).ToNot(HaveOccurred())
+			metadata := *ch.Metadata
+			index.Entries[ch.Name()] = append(index.Entries[ch.Name()], &repo.ChartVersion{
+				Metadata: &metadata,
+				URLs:     []string{ch.Name() + "-" + version + ".tgz"},
+			})
+		}
+		indexData, err := yaml.Marshal(index)
+		Expect(err).ToNot(HaveOccurred())
+
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path == "/index.yaml" {
+				_, _ = w.Write(indexData)
+				return
+			}
+			data, ok := archives[req.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(data)
+		}))
+
+		repoCredentials, err := json.Marshal(helmv1alpha1.HelmChartRepoCredentials{
+			Auths: []helmv1alpha1.Auth{
+				{
+					URL:          server.URL,
+					AuthHeader:   "Basic " + base64.StdEncoding.EncodeToString([]byte("user:pass")),
+					CustomCAData: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})),
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		lsCtx = &lsv1alpha1.Context{}
+		lsCtx.Name = lsv1alpha1.DefaultContextName
+		lsCtx.Configurations = map[string]lsv1alpha1.AnyJSON{
+			helmv1alpha1.HelmChartRepoCredentialsKey: lsv1alpha1.NewAnyJSON(repoCredentials),
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newHelm := func(version string, pin bool, status *helmv1alpha1.ProviderStatus) *helm.Helm {
+		kubeconfig, err := kutil.GenerateKubeconfigJSONBytes(testenv.Env.Config)
+		Expect(err).ToNot(HaveOccurred())
+		helmConfig := &helmv1alpha1.ProviderConfiguration{}
+		helmConfig.Kubeconfig = base64.StdEncoding.EncodeToString(kubeconfig)
+		helmConfig.Chart.HelmChartRepo = &helmv1alpha1.HelmChartRepo{
+			HelmChartRepoUrl: strings.TrimPrefix(server.URL, "https://"),
+			HelmChartName:    "testchart",
+			HelmChartVersion: version,
+			PinVersion:       pin,
+		}
+		helmConfig.Name = "foo"
+		helmConfig.Namespace = "foo"
+		providerConfig, err := helper.ProviderConfigurationToRawExtension(helmConfig)
+		Expect(err).ToNot(HaveOccurred())
+
+		item := &lsv1alpha1.DeployItem{}
+		item.Generation = 2
+		item.Spec.Configuration = providerConfig
+
+		h, err := helm.New(helmv1alpha1.Configuration{}, testenv.Client, testenv.Client, item, nil, lsCtx, nil)
+		Expect(err).ToNot(HaveOccurred())
+		h.ProviderStatus = status
+		return h
+	}
+
+	template := func(h *helm.Helm) *chart.Chart {
+		_, _, _, ch, err := h.Template(ctx, testenv.Client)
+		Expect(err).ToNot(HaveOccurred())
+		return ch
+	}
+
+	It("should resolve a version constraint against the repository index", func() {
+		ch := template(newHelm("~1.4", false, nil))
+		Expect(ch.Metadata.Version).To(Equal("1.4.2"))
+	})
+
+	It("should fail if no version matches the constraint", func() {
+		h := newHelm("~2.0", false, nil)
+		_, _, _, _, err := h.Template(ctx, testenv.Client)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should use the pinned version if the deploy item has not changed", func() {
+		status := &helmv1alpha1.ProviderStatus{
+			ChartVersion: &helmv1alpha1.ResolvedChartVersion{
+				Constraint:         "~1.4",
+				Version:            "1.4.0",
+				ObservedGeneration: 2,
+			},
+		}
+		ch := template(newHelm("~1.4", true, status))
+		Expect(ch.Metadata.Version).To(Equal("1.4.0"))
+	})
+
+	It("should resolve the constraint again if the deploy item has changed", func() {
+		status := &helmv1alpha1.ProviderStatus{
+			ChartVersion: &helmv1alpha1.ResolvedChartVersion{
+				Constraint:         "~1.4",
+				Version:            "1.4.0",
+				ObservedGeneration: 1,
+			},
+		}
+		ch := template(newHelm("~1.4", true, status))
+		Expect(ch.Metadata.Version).To(Equal("1.4.2"))
+	})
+
+	It("should resolve the constraint again if the version is not pinned", func() {
+		status := &helmv1alpha1.ProviderStatus{
+			ChartVersion: &helmv1alpha1.ResolvedChartVersion{
+				Constraint:         "~1.4",
+				Version:            "1.4.0",
+				ObservedGeneration: 2,
+			},
+		}
+		ch := template(newHelm("~1.4", false, status))
+		Expect(ch.Metadata.Version).To(Equal("1.4.2"))
+	})
+
+})
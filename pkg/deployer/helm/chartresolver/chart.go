@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
@@ -31,14 +32,19 @@ import (
 var NoChartDefinedError = errors.New("no chart was provided")
 
 // GetChart resolves the chart based on a chart access configuration.
+// References with the "oci://" scheme are resolved with the oci registry client.
 func GetChart(ctx context.Context, ociClient ociclient.Client,
-	helmChartRepoClient *helmchartrepo.HelmChartRepoClient, chartConfig *helmv1alpha1.Chart) (*chart.Chart, error) {
+	helmChartRepoClient *helmchartrepo.HelmChartRepoClient, ociRegistryClient *OCIRegistryClient,
+	chartConfig *helmv1alpha1.Chart) (*chart.Chart, error) {
 
 	if chartConfig.Archive != nil {
 		return getChartFromArchive(chartConfig.Archive)
 	}
 
 	if len(chartConfig.Ref) != 0 {
+		if IsOCIRegistryRef(chartConfig.Ref) {
+			return getChartFromOCIRegistry(ctx, ociRegistryClient, chartConfig.Ref, "")
+		}
 		return getChartFromOCIRef(ctx, ociClient, chartConfig.Ref)
 	}
 
@@ -48,6 +54,10 @@ func GetChart(ctx context.Context, ociClient ociclient.Client,
 	}
 
 	if chartConfig.HelmChartRepo != nil {
+		if IsOCIRegistryRef(chartConfig.HelmChartRepo.HelmChartRepoUrl) {
+			ref := strings.TrimSuffix(chartConfig.HelmChartRepo.HelmChartRepoUrl, "/") + "/" + chartConfig.HelmChartRepo.HelmChartName
+			return getChartFromOCIRegistry(ctx, ociRegistryClient, ref, chartConfig.HelmChartRepo.HelmChartVersion)
+		}
 		return getChartFromHelmChartRepo(ctx, helmChartRepoClient, chartConfig.HelmChartRepo)
 	}
 
@@ -86,6 +96,17 @@ func getChartFromArchive(archiveConfig *helmv1alpha1.ArchiveAccess) (*chart.Char
 	return nil, NoChartDefinedError
 }
 
+func getChartFromOCIRegistry(ctx context.Context, ociRegistryClient *OCIRegistryClient, ref, version string) (*chart.Chart, error) {
+	if ociRegistryClient == nil {
+		ociRegistryClient = NewOCIRegistryClient(nil, nil)
+	}
+	ch, err := ociRegistryClient.GetChart(ctx, ref, version)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve chart from %q: %w", ref, err)
+	}
+	return ch, nil
+}
+
 func getChartFromOCIRef(ctx context.Context, ociClient ociclient.Client, ref string) (*chart.Chart, error) {
 	ociAccess := cdv2.NewOCIRegistryAccess(ref)
 	access, err := cdv2.NewUnstructured(ociAccess)
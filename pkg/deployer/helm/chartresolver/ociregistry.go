@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chartresolver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/cli/cli/config/configfile"
+	dockerconfigtypes "github.com/docker/cli/cli/config/types"
+	"github.com/gardener/component-cli/ociclient/credentials"
+	"helm.sh/helm/v3/pkg/chart"
+	chartloader "helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/registry"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/deployer/helm/helmchartrepo"
+)
+
+// OCIRegistryScheme is the url scheme of helm charts that are stored in an oci registry.
+const OCIRegistryScheme = registry.OCIScheme + "://"
+
+// IsOCIRegistryRef returns whether the reference points to a helm chart in an oci registry ("oci://<host>/<path>[:<tag>]").
+func IsOCIRegistryRef(ref string) bool {
+	return registry.IsOCI(ref)
+}
+
+// OCIRegistryClient resolves helm charts from oci registries with helm's native registry client.
+// The credentials are taken from the helm chart repo credentials and from the oci keyring
+// that contains the registry pull secrets.
+type OCIRegistryClient struct {
+	keyring             credentials.OCIKeyring
+	helmChartRepoClient *helmchartrepo.HelmChartRepoClient
+}
+
+// NewOCIRegistryClient creates a new oci registry client.
+// The keyring and the helm chart repo client are optional.
+func NewOCIRegistryClient(keyring credentials.OCIKeyring, helmChartRepoClient *helmchartrepo.HelmChartRepoClient) *OCIRegistryClient {
+	return &OCIRegistryClient{
+		keyring:             keyring,
+		helmChartRepoClient: helmChartRepoClient,
+	}
+}
+
+// GetChart pulls the helm chart with the given oci reference.
+// If a version is given, it is resolved against the semver tags of the repository and may also be a constraint like "^1.2".
+// Otherwise the tag of the reference is used, or the latest semver tag if the reference has no tag.
+func (c *OCIRegistryClient) GetChart(ctx context.Context, ref, version string) (*chart.Chart, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "OCIRegistryClient.GetChart"})
+
+	repository, tag, err := ParseOCIRegistryRef(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	credentialsDir, err := os.MkdirTemp("", "helm-registry-")
+	if err != nil {
+		return nil, fmt.Errorf("unable to create directory for registry credentials: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(credentialsDir); err != nil {
+			logger.Error(err, "unable to remove registry credentials", "dir", credentialsDir)
+		}
+	}()
+	credentialsFile := filepath.Join(credentialsDir, registry.CredentialsFileBasename)
+	if err := c.writeCredentialsFile(ctx, credentialsFile, ref, repository); err != nil {
+		return nil, err
+	}
+
+	registryClient, err := registry.NewClient(registry.ClientOptCredentialsFile(credentialsFile))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create registry client: %w", err)
+	}
+
+	if len(version) != 0 || len(tag) == 0 {
+		tags, err := registryClient.Tags(repository)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list tags of %q: %w", ref, err)
+		}
+		tag, err = registry.GetTagMatchingVersionOrConstraint(tags, version)
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve version %q of %q: %w", version, ref, err)
+		}
+	}
+
+	// helm replaces the plus of semver build metadata with an underscore in tags
+	pullRef := repository + ":" + strings.ReplaceAll(tag, "+", "_")
+	logger.Debug("Pulling helm chart from oci registry", "ref", pullRef)
+	res, err := registryClient.Pull(pullRef, registry.PullOptWithChart(true))
+	if err != nil {
+		return nil, fmt.Errorf("unable to pull chart %q: %w", pullRef, err)
+	}
+
+	ch, err := chartloader.LoadArchive(bytes.NewReader(res.Chart.Data))
+	if err != nil {
+		return nil, fmt.Errorf("unable to load chart from archive: %w", err)
+	}
+	return ch, nil
+}
+
+// writeCredentialsFile writes a docker config file with the credentials for the registry of the repository.
+// Credentials of the helm chart repo credentials take precedence over the credentials of the keyring.
+func (c *OCIRegistryClient) writeCredentialsFile(ctx context.Context, path, ref, repository string) error {
+	host := strings.SplitN(repository, "/", 2)[0]
+
+	var username, password, identityToken string
+	if c.helmChartRepoClient != nil {
+		var err error
+		username, password, err = c.helmChartRepoClient.GetRegistryCredentials(ctx, ref)
+		if err != nil {
+			return fmt.Errorf("unable to get credentials for %q: %w", ref, err)
+		}
+	}
+	if len(username) == 0 && len(password) == 0 && c.keyring != nil {
+		if auth := c.keyring.Get(repository); auth != nil {
+			username, password, identityToken = auth.GetUsername(), auth.GetPassword(), auth.GetIdentityToken()
+		}
+	}
+
+	cfg := configfile.New(path)
+	if len(username) != 0 || len(password) != 0 {
+		cfg.AuthConfigs[host] = dockerconfigtypes.AuthConfig{
+			ServerAddress: host,
+			Username:      username,
+			Password:      password,
+		}
+	} else if len(identityToken) != 0 {
+		// an identity token of a docker config is a refresh token for the token service of the registry
+		cfg.AuthConfigs[host] = dockerconfigtypes.AuthConfig{
+			ServerAddress: host,
+			IdentityToken: identityToken,
+		}
+	}
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("unable to write registry credentials: %w", err)
+	}
+	return nil
+}
+
+// ParseOCIRegistryRef splits an oci registry reference like "oci://example.com/charts/mychart:1.0.0"
+// into the repository "example.com/charts/mychart" and the tag "1.0.0".
+// The tag is empty if the reference has no tag.
+func ParseOCIRegistryRef(ref string) (string, string, error) {
+	if !IsOCIRegistryRef(ref) {
+		return "", "", fmt.Errorf("%q is not an oci registry reference, it must start with %q", ref, OCIRegistryScheme)
+	}
+	repository := strings.TrimSuffix(strings.TrimPrefix(ref, OCIRegistryScheme), "/")
+	if !strings.Contains(repository, "/") {
+		return "", "", fmt.Errorf("oci registry reference %q has no repository", ref)
+	}
+	if strings.Contains(repository, "@") {
+		return "", "", fmt.Errorf("oci registry reference %q must not contain a digest", ref)
+	}
+
+	tag := ""
+	if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+		repository, tag = repository[:i], repository[i+1:]
+	}
+	return repository, tag, nil
+}
@@ -0,0 +1,294 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chartresolver_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+
+	"github.com/gardener/component-cli/ociclient/credentials"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencontainers/go-digest"
+	ocispecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	chartloader "helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/registry"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/deployer/helm/chartresolver"
+	"github.com/gardener/landscaper/pkg/deployer/helm/helmchartrepo"
+)
+
+// fakeChartRegistry is a minimal oci registry that serves the testchart in multiple versions
+// and requires basic authentication, or a bearer token if a token is set.
+type fakeChartRegistry struct {
+	repository string
+	username   string
+	password   string
+	token      string
+	manifests  map[string][]byte
+	blobs      map[string][]byte
+}
+
+func newFakeChartRegistry(repository, username, password string, versions ...string) *fakeChartRegistry {
+	r := &fakeChartRegistry{
+		repository: repository,
+		username:   username,
+		password:   password,
+		manifests:  map[string][]byte{},
+		blobs:      map[string][]byte{},
+	}
+
+	ch, err := chartloader.LoadDir("./testdata/testchart")
+	Expect(err).ToNot(HaveOccurred())
+	for _, version := range versions {
+		ch.Metadata.Version = version
+		dir, err := os.MkdirTemp("", "testchart-")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+		archivePath, err := chartutil.Save(ch, dir)
+		Expect(err).ToNot(HaveOccurred())
+		chartData, err := os.ReadFile(archivePath)
+		Expect(err).ToNot(HaveOccurred())
+		configData, err := json.Marshal(ch.Metadata)
+		Expect(err).ToNot(HaveOccurred())
+
+		manifest := ocispecv1.Manifest{
+			Config: r.addBlob(registry.ConfigMediaType, configData),
+			Layers: []ocispecv1.Descriptor{r.addBlob(registry.ChartLayerMediaType, chartData)},
+		}
+		manifest.SchemaVersion = 2
+		manifestData, err := json.Marshal(manifest)
+		Expect(err).ToNot(HaveOccurred())
+		r.manifests[strings.ReplaceAll(version, "+", "_")] = manifestData
+		r.manifests[digest.FromBytes(manifestData).String()] = manifestData
+	}
+	return r
+}
+
+func (r *fakeChartRegistry) addBlob(mediaType string, data []byte) ocispecv1.Descriptor {
+	desc := ocispecv1.Descriptor{
+		MediaType: mediaType,
+		Digest:    digest.FromBytes(data),
+		Size:      int64(len(data)),
+	}
+	r.blobs[desc.Digest.String()] = data
+	return desc
+}
+
+func (r *fakeChartRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if len(r.token) != 0 {
+		if req.Header.Get("Authorization") != "Bearer "+r.token {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="test"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+	} else if username, password, ok := req.BasicAuth(); !ok || username != r.username || password != r.password {
+		w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	prefix := "/v2/" + r.repository + "/"
+	if !strings.HasPrefix(req.URL.Path, prefix) {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	p := strings.TrimPrefix(req.URL.Path, prefix)
+
+	switch {
+	case p == "tags/list":
+		tags := make([]string, 0, len(r.manifests))
+		for tag := range r.manifests {
+			if _, err := digest.Parse(tag); err != nil {
+				tags = append(tags, tag)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"name": r.repository, "tags": tags})
+	case strings.HasPrefix(p, "manifests/"):
+		data, ok := r.manifests[strings.TrimPrefix(p, "manifests/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		r.writeContent(w, req, ocispecv1.MediaTypeImageManifest, data)
+	case strings.HasPrefix(p, "blobs/"):
+		data, ok := r.blobs[strings.TrimPrefix(p, "blobs/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		r.writeContent(w, req, "application/octet-stream", data)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (r *fakeChartRegistry) writeContent(w http.ResponseWriter, req *http.Request, mediaType string, data []byte) {
+	w.Header().Set("Content-Type", mediaType)
+	w.Header().Set("Content-Length", fmt.Sprint(len(data)))
+	w.Header().Set("Docker-Content-Digest", digest.FromBytes(data).String())
+	w.WriteHeader(http.StatusOK)
+	if req.Method != http.MethodHead {
+		_, _ = w.Write(data)
+	}
+}
+
+var _ = Describe("OCIRegistry", func() {
+
+	Context("ParseOCIRegistryRef", func() {
+		It("should split a reference into repository and tag", func() {
+			repository, tag, err := chartresolver.ParseOCIRegistryRef("oci://example.com:5000/charts/mychart:1.2.3")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(repository).To(Equal("example.com:5000/charts/mychart"))
+			Expect(tag).To(Equal("1.2.3"))
+		})
+
+		It("should return an empty tag if the reference has no tag", func() {
+			repository, tag, err := chartresolver.ParseOCIRegistryRef("oci://example.com:5000/charts/mychart")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(repository).To(Equal("example.com:5000/charts/mychart"))
+			Expect(tag).To(BeEmpty())
+		})
+
+		It("should fail for references without the oci scheme or repository", func() {
+			_, _, err := chartresolver.ParseOCIRegistryRef("example.com/charts/mychart:1.2.3")
+			Expect(err).To(HaveOccurred())
+			_, _, err = chartresolver.ParseOCIRegistryRef("oci://example.com")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("GetChart", func() {
+		var (
+			ctx    context.Context
+			server *httptest.Server
+			host   string
+		)
+
+		BeforeEach(func() {
+			ctx = logging.NewContext(context.Background(), logging.Discard())
+			server = httptest.NewServer(newFakeChartRegistry("charts/testchart", "user", "pass", "1.2.3", "1.3.0", "2.0.0"))
+			host = strings.TrimPrefix(server.URL, "http://")
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		newKeyring := func() credentials.OCIKeyring {
+			keyring, err := credentials.CreateOCIRegistryKeyring(nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(keyring.AddAuthConfig(host, credentials.AuthConfig{Username: "user", Password: "pass"})).To(Succeed())
+			return keyring
+		}
+
+		It("should pull the tag of a reference with credentials of the keyring", func() {
+			client := chartresolver.NewOCIRegistryClient(newKeyring(), nil)
+			ch, err := client.GetChart(ctx, fmt.Sprintf("oci://%s/charts/testchart:1.3.0", host), "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ch.Metadata.Name).To(Equal("testchart"))
+			Expect(ch.Metadata.Version).To(Equal("1.3.0"))
+		})
+
+		It("should pull the latest version if the reference has no tag", func() {
+			client := chartresolver.NewOCIRegistryClient(newKeyring(), nil)
+			ch, err := client.GetChart(ctx, fmt.Sprintf("oci://%s/charts/testchart", host), "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ch.Metadata.Version).To(Equal("2.0.0"))
+		})
+
+		It("should resolve a version constraint against the tags", func() {
+			client := chartresolver.NewOCIRegistryClient(newKeyring(), nil)
+			ch, err := client.GetChart(ctx, fmt.Sprintf("oci://%s/charts/testchart", host), "^1.2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ch.Metadata.Version).To(Equal("1.3.0"))
+		})
+
+		It("should fail if no tag matches the version constraint", func() {
+			client := chartresolver.NewOCIRegistryClient(newKeyring(), nil)
+			_, err := client.GetChart(ctx, fmt.Sprintf("oci://%s/charts/testchart", host), "~3.0")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail without credentials", func() {
+			client := chartresolver.NewOCIRegistryClient(nil, nil)
+			_, err := client.GetChart(ctx, fmt.Sprintf("oci://%s/charts/testchart:1.3.0", host), "")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should resolve a helm chart repo with credentials of the helm chart repo credentials", func() {
+			repoURL := fmt.Sprintf("oci://%s/charts", host)
+			repoCredentials, err := json.Marshal(helmv1alpha1.HelmChartRepoCredentials{
+				Auths: []helmv1alpha1.Auth{
+					{
+						URL:        repoURL,
+						AuthHeader: "Basic " + base64.StdEncoding.EncodeToString([]byte("user:pass")),
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			lsCtx := &lsv1alpha1.Context{}
+			lsCtx.Configurations = map[string]lsv1alpha1.AnyJSON{
+				helmv1alpha1.HelmChartRepoCredentialsKey: lsv1alpha1.NewAnyJSON(repoCredentials),
+			}
+			helmChartRepoClient, lsErr := helmchartrepo.NewHelmChartRepoClient(lsCtx, nil)
+			Expect(lsErr).ToNot(HaveOccurred())
+
+			chartAccess := &helmv1alpha1.Chart{
+				HelmChartRepo: &helmv1alpha1.HelmChartRepo{
+					HelmChartRepoUrl: repoURL,
+					HelmChartName:    "testchart",
+					HelmChartVersion: ">=1.2.3 <2.0.0",
+				},
+			}
+			ch, err := chartresolver.GetChart(ctx, nil, helmChartRepoClient,
+				chartresolver.NewOCIRegistryClient(nil, helmChartRepoClient), chartAccess)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ch.Metadata.Version).To(Equal("1.3.0"))
+		})
+
+		It("should reject bearer tokens of the helm chart repo credentials", func() {
+			tokenRegistry := newFakeChartRegistry("charts/testchart", "", "", "1.3.0")
+			tokenRegistry.token = "my-token"
+			tokenServer := httptest.NewServer(tokenRegistry)
+			defer tokenServer.Close()
+			tokenHost := strings.TrimPrefix(tokenServer.URL, "http://")
+
+			repoURL := fmt.Sprintf("oci://%s/charts", tokenHost)
+			repoCredentials, err := json.Marshal(helmv1alpha1.HelmChartRepoCredentials{
+				Auths: []helmv1alpha1.Auth{
+					{
+						URL:        repoURL,
+						AuthHeader: "Bearer my-token",
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			lsCtx := &lsv1alpha1.Context{}
+			lsCtx.Configurations = map[string]lsv1alpha1.AnyJSON{
+				helmv1alpha1.HelmChartRepoCredentialsKey: lsv1alpha1.NewAnyJSON(repoCredentials),
+			}
+			helmChartRepoClient, lsErr := helmchartrepo.NewHelmChartRepoClient(lsCtx, nil)
+			Expect(lsErr).ToNot(HaveOccurred())
+
+			client := chartresolver.NewOCIRegistryClient(nil, helmChartRepoClient)
+			_, err = client.GetChart(ctx, repoURL+"/testchart:1.3.0", "")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("bearer auth is not supported"))
+		})
+	})
+
+})
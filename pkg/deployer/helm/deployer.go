@@ -98,6 +98,24 @@ func (d *deployer) Abort(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv
 	return nil
 }
 
+func (d *deployer) DetectDrift(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) (time.Duration, error) {
+	helm, err := New(d.config, d.lsClient, d.hostClient, di, rt, lsCtx, d.sharedCache)
+	if err != nil {
+		return 0, err
+	}
+	return helm.DetectDrift(ctx)
+}
+
+func (d *deployer) Rollback(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget, revision int64) error {
+	helm, err := New(d.config, d.lsClient, d.hostClient, di, rt, lsCtx, d.sharedCache)
+	if err != nil {
+		return lserrors.NewWrappedError(err, "Rollback", "New", err.Error())
+	}
+	di.Status.Phase = lsv1alpha1.ExecutionPhaseProgressing
+
+	return helm.RollbackRelease(ctx, int(revision))
+}
+
 func (d *deployer) ExtensionHooks() extension.ReconcileExtensionHooks {
 	return d.hooks
 }
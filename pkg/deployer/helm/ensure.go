@@ -59,6 +59,9 @@ func (h *Helm) ApplyFiles(ctx context.Context, files, crds map[string]string, ex
 			ManagedResources: make(managedresource.ManagedResourceStatusList, 0),
 		}
 	}
+	// the drift of the previously applied manifests is outdated
+	h.ProviderStatus.Drift = nil
+	h.ProviderStatus.ChartVersion = h.resolvedChartVersion(ch)
 
 	manifests, err := h.createManifests(ctx, currOp, files, crds)
 	if err != nil {
@@ -67,6 +70,7 @@ func (h *Helm) ApplyFiles(ctx context.Context, files, crds map[string]string, ex
 
 	var (
 		managedResourceStatusList managedresource.ManagedResourceStatusList
+		realHelmDeployer          *realhelmdeployer.RealHelmDeployer
 		deployErr                 error
 	)
 
@@ -75,9 +79,15 @@ func (h *Helm) ApplyFiles(ctx context.Context, files, crds map[string]string, ex
 	if shouldUseRealHelmDeployer {
 		// apply helm
 		// convert manifests in ManagedResourceStatusList
-		realHelmDeployer := realhelmdeployer.NewRealHelmDeployer(ch, h.ProviderConfiguration,
+		realHelmDeployer = realhelmdeployer.NewRealHelmDeployer(ch, h.ProviderConfiguration,
 			h.TargetRestConfig, targetClientSet)
 		deployErr = realHelmDeployer.Deploy(ctx)
+		if err := h.updateReleaseStatus(ctx, realHelmDeployer); err != nil {
+			if deployErr == nil {
+				return err
+			}
+			logger.Error(err, "unable to get release status")
+		}
 		if deployErr == nil {
 			managedResourceStatusList, err = realHelmDeployer.GetManagedResourcesStatus(ctx, manifests)
 			if err != nil {
@@ -101,6 +111,12 @@ func (h *Helm) ApplyFiles(ctx context.Context, files, crds map[string]string, ex
 		return deployErr
 	}
 
+	if h.ProviderConfiguration.DriftDetection != nil {
+		if err := resourcemanager.StoreAppliedManifests(ctx, h.lsKubeClient, h.DeployItem, manifests); err != nil {
+			return lserrors.NewWrappedError(err, currOp, "StoreAppliedManifests", err.Error())
+		}
+	}
+
 	h.DeployItem.Status.ProviderStatus, err = kutil.ConvertToRawExtension(h.ProviderStatus, HelmScheme)
 	if err != nil {
 		return lserrors.NewWrappedError(err, currOp, "ProviderStatus", err.Error())
@@ -114,6 +130,12 @@ func (h *Helm) ApplyFiles(ctx context.Context, files, crds map[string]string, ex
 		return err
 	}
 
+	if realHelmDeployer != nil {
+		if err := h.testRelease(ctx, realHelmDeployer); err != nil {
+			return err
+		}
+	}
+
 	if err := h.readExportValues(ctx, currOp, targetClient, managedResourceStatusList, exports); err != nil {
 		return err
 	}
@@ -125,7 +147,18 @@ func (h *Helm) ApplyFiles(ctx context.Context, files, crds map[string]string, ex
 
 func (h *Helm) applyManifests(ctx context.Context, targetClient client.Client, targetClientSet kubernetes.Interface,
 	manifests []managedresource.Manifest) (*resourcemanager.ManifestApplier, error) {
-	applier := resourcemanager.NewManifestApplier(resourcemanager.ManifestApplierOptions{
+	applier := resourcemanager.NewManifestApplier(h.applierOptions(targetClient, targetClientSet, manifests))
+
+	err := applier.Apply(ctx)
+	h.ProviderStatus.ManagedResources = applier.GetManagedResourcesStatus()
+
+	return applier, err
+}
+
+// applierOptions returns the options to apply the given manifests to the target cluster.
+func (h *Helm) applierOptions(targetClient client.Client, targetClientSet kubernetes.Interface,
+	manifests []managedresource.Manifest) resourcemanager.ManifestApplierOptions {
+	return resourcemanager.ManifestApplierOptions{
 		Decoder:          serializer.NewCodecFactory(scheme.Scheme).UniversalDecoder(),
 		KubeClient:       targetClient,
 		Clientset:        targetClientSet,
@@ -133,17 +166,13 @@ func (h *Helm) applyManifests(ctx context.Context, targetClient client.Client, t
 		DeployItemName:   h.DeployItem.Name,
 		DeleteTimeout:    h.ProviderConfiguration.DeleteTimeout.Duration,
 		UpdateStrategy:   manifestv1alpha2.UpdateStrategy(h.ProviderConfiguration.UpdateStrategy),
+		ServerSideApply:  h.ProviderConfiguration.ServerSideApply,
 		Manifests:        manifests,
 		ManagedResources: h.ProviderStatus.ManagedResources,
 		Labels: map[string]string{
 			helmv1alpha1.ManagedDeployItemLabel: h.DeployItem.Name,
 		},
-	})
-
-	err := applier.Apply(ctx)
-	h.ProviderStatus.ManagedResources = applier.GetManagedResourcesStatus()
-
-	return applier, err
+	}
 }
 
 func (h *Helm) createManifests(ctx context.Context, currOp string, files, crds map[string]string) ([]managedresource.Manifest, error) {
@@ -195,6 +224,39 @@ func (h *Helm) createManifests(ctx context.Context, currOp string, files, crds m
 	return manifests, nil
 }
 
+// updateReleaseStatus records the status and the history of the release in the provider status.
+func (h *Helm) updateReleaseStatus(ctx context.Context, realHelmDeployer *realhelmdeployer.RealHelmDeployer) error {
+	release, err := realHelmDeployer.GetReleaseStatus(ctx)
+	if err != nil {
+		return lserrors.NewWrappedError(err, "UpdateReleaseStatus", "GetReleaseStatus", err.Error())
+	}
+	h.ProviderStatus.Release = release
+	return nil
+}
+
+// testRelease runs the helm tests of the release if they are enabled.
+// The last executions of the hooks of the release are recorded in the provider status.
+func (h *Helm) testRelease(ctx context.Context, realHelmDeployer *realhelmdeployer.RealHelmDeployer) error {
+	currOp := "TestRelease"
+
+	testErr := realHelmDeployer.Test(ctx)
+
+	hooks, err := realHelmDeployer.GetHooksStatus(ctx)
+	if err != nil {
+		if testErr != nil {
+			return testErr
+		}
+		return lserrors.NewWrappedError(err, currOp, "GetHooksStatus", err.Error())
+	}
+
+	h.ProviderStatus.Hooks = hooks
+	h.DeployItem.Status.ProviderStatus, err = kutil.ConvertToRawExtension(h.ProviderStatus, HelmScheme)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "ProviderStatus", err.Error())
+	}
+	return testErr
+}
+
 // checkResourcesReady checks if the managed resources are Ready/Healthy.
 func (h *Helm) checkResourcesReady(ctx context.Context, client client.Client, failOnMissingObject bool) error {
 
@@ -36,6 +36,7 @@ import (
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/deployer/helm/chartresolver"
 	"github.com/gardener/landscaper/pkg/deployer/helm/helmchartrepo"
+	"github.com/gardener/landscaper/pkg/deployer/helm/postrenderer"
 	"github.com/gardener/landscaper/pkg/deployer/lib"
 	"github.com/gardener/landscaper/pkg/utils"
 )
@@ -131,11 +132,15 @@ func (h *Helm) Template(ctx context.Context, lsClient client.Client) (map[string
 	// download chart
 	// todo: do caching of charts
 
-	ociClient, err := createOCIClient(ctx,
+	ociKeyring, err := createOCIKeyring(ctx,
 		h.lsKubeClient,
 		append(lib.GetRegistryPullSecretsFromContext(h.Context), h.DeployItem.Spec.RegistryPullSecrets...),
-		h.Configuration,
-		h.SharedCache)
+		h.Configuration)
+	if err != nil {
+		return nil, nil, nil, nil, lserrors.NewWrappedError(err, currOp, "BuildOCIKeyring", err.Error())
+	}
+
+	ociClient, err := createOCIClient(ctx, ociKeyring, h.Configuration, h.SharedCache)
 	if err != nil {
 		return nil, nil, nil, nil, lserrors.NewWrappedError(err, currOp, "BuildOCIClient", err.Error())
 	}
@@ -145,7 +150,9 @@ func (h *Helm) Template(ctx context.Context, lsClient client.Client) (map[string
 		return nil, nil, nil, nil, lsError
 	}
 
-	ch, err := chartresolver.GetChart(ctx, ociClient, helmChartRepoClient, &h.ProviderConfiguration.Chart)
+	ociRegistryClient := chartresolver.NewOCIRegistryClient(ociKeyring, helmChartRepoClient)
+
+	ch, err := chartresolver.GetChart(ctx, ociClient, helmChartRepoClient, ociRegistryClient, h.chartConfiguration())
 	if err != nil {
 		return nil, nil, nil, nil, lserrors.NewWrappedError(err, currOp, "GetHelmChart", err.Error())
 	}
@@ -175,6 +182,15 @@ func (h *Helm) Template(ctx context.Context, lsClient client.Client) (map[string
 			err, currOp, "RenderHelmValues", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
 	}
 
+	if postRenderer := postrenderer.New(h.ProviderConfiguration.PostRenderer); postRenderer != nil {
+		logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+		files, err = postRenderer.RenderFiles(logger, files)
+		if err != nil {
+			return nil, nil, nil, nil, lserrors.NewWrappedError(
+				err, currOp, "PostRender", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+		}
+	}
+
 	crds := map[string]string{}
 	for _, crd := range ch.CRDObjects() {
 		crds[crd.Filename] = string(crd.File.Data[:])
@@ -253,8 +269,41 @@ func (h *Helm) TargetClient(ctx context.Context) (*rest.Config, client.Client, k
 	return nil, nil, nil, errors.New("neither a target nor kubeconfig are defined")
 }
 
-func createOCIClient(ctx context.Context, client client.Client, registryPullSecrets []lsv1alpha1.ObjectReference, config helmv1alpha1.Configuration, sharedCache cache.Cache) (ociclient.Client, error) {
-	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "helmDeployerController.createOCIClient"})
+// chartConfiguration returns the chart configuration that is used to fetch the chart.
+// The version of a helm chart repo is replaced by the version that was resolved in a previous reconcile
+// if the version is pinned and the spec of the deploy item has not changed since then.
+func (h *Helm) chartConfiguration() *helmv1alpha1.Chart {
+	chartConfig := &h.ProviderConfiguration.Chart
+	helmChartRepo := chartConfig.HelmChartRepo
+	if helmChartRepo == nil || !helmChartRepo.PinVersion || h.ProviderStatus == nil || h.ProviderStatus.ChartVersion == nil {
+		return chartConfig
+	}
+
+	resolved := h.ProviderStatus.ChartVersion
+	if resolved.ObservedGeneration != h.DeployItem.Generation || resolved.Constraint != helmChartRepo.HelmChartVersion {
+		return chartConfig
+	}
+	pinned := chartConfig.DeepCopy()
+	pinned.HelmChartRepo.HelmChartVersion = resolved.Version
+	return pinned
+}
+
+// resolvedChartVersion returns the version of the chart that was resolved for the helm chart repo of the chart configuration.
+func (h *Helm) resolvedChartVersion(ch *chart.Chart) *helmv1alpha1.ResolvedChartVersion {
+	helmChartRepo := h.ProviderConfiguration.Chart.HelmChartRepo
+	if helmChartRepo == nil || ch == nil || ch.Metadata == nil {
+		return nil
+	}
+	return &helmv1alpha1.ResolvedChartVersion{
+		Constraint:         helmChartRepo.HelmChartVersion,
+		Version:            ch.Metadata.Version,
+		ObservedGeneration: h.DeployItem.Generation,
+	}
+}
+
+// createOCIKeyring creates the oci keyring with the configured oci config files and the given registry pull secrets.
+func createOCIKeyring(ctx context.Context, client client.Client, registryPullSecrets []lsv1alpha1.ObjectReference, config helmv1alpha1.Configuration) (*credentials.GeneralOciKeyring, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "helmDeployerController.createOCIKeyring"})
 
 	// resolve all pull secrets
 	secrets, err := kutil.ResolveSecrets(ctx, client, registryPullSecrets)
@@ -262,19 +311,21 @@ func createOCIClient(ctx context.Context, client client.Client, registryPullSecr
 		return nil, err
 	}
 
-	// always add an oci client to support unauthenticated requests
 	ociConfigFiles := make([]string, 0)
 	if config.OCI != nil {
 		ociConfigFiles = config.OCI.ConfigFiles
 	}
-	ociKeyring, err := credentials.NewBuilder(logger.WithName("ociKeyring").Logr()).
+	return credentials.NewBuilder(logger.WithName("ociKeyring").Logr()).
 		WithFS(osfs.New()).
 		FromConfigFiles(ociConfigFiles...).
 		FromPullSecrets(secrets...).
 		Build()
-	if err != nil {
-		return nil, err
-	}
+}
+
+func createOCIClient(ctx context.Context, ociKeyring credentials.OCIKeyring, config helmv1alpha1.Configuration, sharedCache cache.Cache) (ociclient.Client, error) {
+	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "helmDeployerController.createOCIClient"})
+
+	// always add an oci client to support unauthenticated requests
 	ociClient, err := ociclient.NewClient(logger.Logr(),
 		utils.WithConfiguration(config.OCI),
 		ociclient.WithKeyring(ociKeyring),
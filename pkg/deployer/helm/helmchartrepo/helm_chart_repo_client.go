@@ -235,6 +235,33 @@ func (c *HelmChartRepoClient) getAuthHeader(ctx context.Context, authData *helmv
 	return "", fmt.Errorf("failed to get auth header: neither auth header nor secret ref is set")
 }
 
+// GetRegistryCredentials returns the credentials that are configured for an oci registry url like "oci://example.com/charts".
+// Basic auth headers are returned as username and password. Bearer tokens are rejected, as helm's registry client
+// only supports them as refresh tokens for the token service of the registry.
+// Empty credentials are returned if no credentials are configured for the url.
+func (c *HelmChartRepoClient) GetRegistryCredentials(ctx context.Context, rawURL string) (string, string, error) {
+	authData := c.getAuthData(rawURL)
+	if authData == nil {
+		return "", "", nil
+	}
+
+	authHeader, err := c.getAuthHeader(ctx, authData)
+	if err != nil {
+		return "", "", err
+	}
+
+	switch {
+	case strings.HasPrefix(authHeader, "Basic "):
+		return c.decodeBasicAuthCredentials(strings.TrimPrefix(authHeader, "Basic "))
+	case strings.HasPrefix(authHeader, "Bearer "):
+		return "", "", lserrors.NewError("GetRegistryCredentials", "getAuthHeader",
+			fmt.Sprintf("bearer auth is not supported for oci registry %q: only basic auth is supported", rawURL),
+			lsv1alpha1.ErrorConfigurationProblem)
+	default:
+		return "", "", fmt.Errorf("unsupported auth header for oci registry %q: only basic auth is supported", rawURL)
+	}
+}
+
 func (c *HelmChartRepoClient) decodeBasicAuthCredentials(base64EncodedBasicAuthCredentials string) (string, string, lserrors.LsError) {
 	decodedCredentials, err := base64.StdEncoding.DecodeString(base64EncodedBasicAuthCredentials)
 	if err != nil {
@@ -97,7 +97,7 @@ func (h *HelmChartRepoResolver) findChartInRepoCatalog(repoCatalog *repo.IndexFi
 	}
 	cv, err := repoCatalog.Get(chartName, chartVersion)
 	if err != nil {
-		return "", fmt.Errorf("%s not found in repository", errMsg)
+		return "", fmt.Errorf("%s not found in repository: %w", errMsg, err)
 	}
 	if len(cv.URLs) == 0 {
 		return "", fmt.Errorf("%s has no downloadable URLs", errMsg)
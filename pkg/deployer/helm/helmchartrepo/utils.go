@@ -2,9 +2,14 @@ package helmchartrepo
 
 import "strings"
 
+const ociScheme = "oci://"
+
 func normalizeUrl(url string) string {
 	result := strings.TrimSpace(url)
 	result = strings.TrimSuffix(result, "/")
+	if strings.HasPrefix(result, ociScheme) {
+		return result
+	}
 	result = strings.TrimPrefix(result, "https://")
 	return "https://" + result
 }
@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postrenderer
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"sort"
+
+	"helm.sh/helm/v3/pkg/postrender"
+	"sigs.k8s.io/kustomize/api/konfig"
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+	"sigs.k8s.io/yaml"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+// RenderedFile is the name of the file that contains the post-rendered manifests of the templates of a chart.
+const RenderedFile = "post-renderer/manifests.yaml"
+
+// PostRenderer modifies rendered manifests as configured in the post-renderer of a helm provider configuration.
+// It implements helm's post-renderer interface, so that it is also used by the helm install and upgrade actions.
+type PostRenderer struct {
+	config *helmv1alpha1.PostRenderer
+}
+
+var _ postrender.PostRenderer = &PostRenderer{}
+
+// New creates a post-renderer for the given configuration.
+// Nil is returned if the configuration defines no post-rendering.
+func New(config *helmv1alpha1.PostRenderer) *PostRenderer {
+	if config == nil || (len(config.Patches) == 0 && config.Kustomize == nil) {
+		return nil
+	}
+	return &PostRenderer{config: config}
+}
+
+// Run applies the patches and then the kustomize overlay to the rendered manifests.
+func (p *PostRenderer) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, error) {
+	data := renderedManifests.Bytes()
+	if len(bytes.TrimSpace(data)) == 0 {
+		return renderedManifests, nil
+	}
+
+	var err error
+	if len(p.config.Patches) != 0 {
+		data, err = p.applyPatches(data)
+		if err != nil {
+			return nil, fmt.Errorf("unable to apply patches: %w", err)
+		}
+	}
+	if p.config.Kustomize != nil {
+		data, err = build(p.config.Kustomize.Files, data)
+		if err != nil {
+			return nil, fmt.Errorf("unable to apply kustomize overlay: %w", err)
+		}
+	}
+	return bytes.NewBuffer(data), nil
+}
+
+// RenderFiles post-renders the rendered templates of a chart, which are given as map of their file names to their content.
+// The post-rendered manifests are returned as the single file RenderedFile.
+func (p *PostRenderer) RenderFiles(log logging.Logger, files map[string]string) (map[string]string, error) {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	for _, name := range names {
+		objects, err := kutil.ParseFilesToRawExtension(log, map[string]string{name: files[name]})
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objects {
+			data, err := yaml.JSONToYAML(obj.Raw)
+			if err != nil {
+				return nil, fmt.Errorf("unable to encode manifest of %q: %w", name, err)
+			}
+			buf.WriteString("---\n")
+			buf.Write(data)
+		}
+	}
+
+	res, err := p.Run(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{RenderedFile: res.String()}, nil
+}
+
+// applyPatches applies the patches with a kustomization that contains the rendered manifests as resource.
+func (p *PostRenderer) applyPatches(manifests []byte) ([]byte, error) {
+	kustomization := types.Kustomization{
+		Resources: []string{helmv1alpha1.PostRendererManifestsFile},
+	}
+	for _, patch := range p.config.Patches {
+		kustomizePatch := types.Patch{Patch: patch.Patch}
+		if target := patch.Target; target != nil {
+			kustomizePatch.Target = &types.Selector{
+				ResId: resid.ResId{
+					Gvk:       resid.Gvk{Group: target.Group, Version: target.Version, Kind: target.Kind},
+					Name:      target.Name,
+					Namespace: target.Namespace,
+				},
+				LabelSelector:      target.LabelSelector,
+				AnnotationSelector: target.AnnotationSelector,
+			}
+		}
+		kustomization.Patches = append(kustomization.Patches, kustomizePatch)
+	}
+
+	data, err := yaml.Marshal(kustomization)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode kustomization: %w", err)
+	}
+	return build(map[string]string{konfig.DefaultKustomizationFileName(): string(data)}, manifests)
+}
+
+// build builds the kustomization at the root of the files.
+// The rendered manifests are added to the files as PostRendererManifestsFile.
+func build(files map[string]string, manifests []byte) ([]byte, error) {
+	fs := filesys.MakeFsInMemory()
+	for filePath, content := range files {
+		if err := fs.WriteFile(path.Join("/", filePath), []byte(content)); err != nil {
+			return nil, fmt.Errorf("unable to write file %q: %w", filePath, err)
+		}
+	}
+	if err := fs.WriteFile(path.Join("/", helmv1alpha1.PostRendererManifestsFile), manifests); err != nil {
+		return nil, fmt.Errorf("unable to write rendered manifests: %w", err)
+	}
+
+	resMap, err := krusty.MakeKustomizer(krusty.MakeDefaultOptions()).Run(fs, "/")
+	if err != nil {
+		return nil, fmt.Errorf("unable to build kustomization: %w", err)
+	}
+	return resMap.AsYaml()
+}
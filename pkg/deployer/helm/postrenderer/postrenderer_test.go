@@ -0,0 +1,212 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postrenderer_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/deployer/helm/postrenderer"
+)
+
+const (
+	deployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-deployment
+  namespace: default
+  labels:
+    app: my-app
+spec:
+  selector:
+    matchLabels:
+      app: my-app
+  template:
+    metadata:
+      labels:
+        app: my-app
+    spec:
+      containers:
+      - name: app
+        image: docker.io/library/nginx:1.23
+`
+	configMap = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-cm
+  namespace: default
+data:
+  key: val
+`
+)
+
+var _ = Describe("PostRenderer", func() {
+
+	run := func(config *helmv1alpha1.PostRenderer, manifests ...string) []byte {
+		var buf bytes.Buffer
+		for _, m := range manifests {
+			buf.WriteString("---\n" + m)
+		}
+		res, err := postrenderer.New(config).Run(&buf)
+		Expect(err).ToNot(HaveOccurred())
+		return res.Bytes()
+	}
+
+	decode := func(data []byte) ([]*appsv1.Deployment, []*corev1.ConfigMap) {
+		objects, err := kutil.DecodeObjects(logging.Discard(), "test", data)
+		Expect(err).ToNot(HaveOccurred())
+		var (
+			deployments []*appsv1.Deployment
+			configMaps  []*corev1.ConfigMap
+		)
+		for _, obj := range objects {
+			raw, err := obj.MarshalJSON()
+			Expect(err).ToNot(HaveOccurred())
+			switch obj.GetKind() {
+			case "Deployment":
+				d := &appsv1.Deployment{}
+				Expect(yaml.Unmarshal(raw, d)).To(Succeed())
+				deployments = append(deployments, d)
+			case "ConfigMap":
+				cm := &corev1.ConfigMap{}
+				Expect(yaml.Unmarshal(raw, cm)).To(Succeed())
+				configMaps = append(configMaps, cm)
+			}
+		}
+		return deployments, configMaps
+	}
+
+	It("should return nil if no post-rendering is configured", func() {
+		Expect(postrenderer.New(nil)).To(BeNil())
+		Expect(postrenderer.New(&helmv1alpha1.PostRenderer{})).To(BeNil())
+	})
+
+	It("should apply a strategic merge patch to the resources of the target", func() {
+		config := &helmv1alpha1.PostRenderer{
+			Patches: []helmv1alpha1.PostRendererPatch{
+				{
+					Target: &helmv1alpha1.PatchTarget{Kind: "Deployment"},
+					Patch: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: not-important
+spec:
+  template:
+    spec:
+      tolerations:
+      - key: dedicated
+        operator: Exists
+`,
+				},
+			},
+		}
+
+		deployments, configMaps := decode(run(config, deployment, configMap))
+		Expect(deployments).To(HaveLen(1))
+		Expect(deployments[0].Spec.Template.Spec.Tolerations).To(ConsistOf(corev1.Toleration{
+			Key:      "dedicated",
+			Operator: corev1.TolerationOpExists,
+		}))
+		Expect(deployments[0].Spec.Template.Spec.Containers).To(HaveLen(1))
+		Expect(configMaps).To(HaveLen(1))
+		Expect(configMaps[0].Data).To(HaveKeyWithValue("key", "val"))
+	})
+
+	It("should apply a JSON6902 patch to the resources selected by labels", func() {
+		config := &helmv1alpha1.PostRenderer{
+			Patches: []helmv1alpha1.PostRendererPatch{
+				{
+					Target: &helmv1alpha1.PatchTarget{LabelSelector: "app=my-app"},
+					Patch:  `[{"op": "add", "path": "/metadata/labels/team", "value": "platform"}]`,
+				},
+			},
+		}
+
+		deployments, configMaps := decode(run(config, deployment, configMap))
+		Expect(deployments[0].Labels).To(HaveKeyWithValue("team", "platform"))
+		Expect(configMaps[0].Labels).ToNot(HaveKey("team"))
+	})
+
+	It("should apply a kustomize overlay after the patches", func() {
+		config := &helmv1alpha1.PostRenderer{
+			Patches: []helmv1alpha1.PostRendererPatch{
+				{
+					Patch: `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-cm
+data:
+  other: val
+`,
+				},
+			},
+			Kustomize: &helmv1alpha1.KustomizePostRenderer{
+				Files: map[string]string{
+					"kustomization.yaml": `resources:
+- helm-output.yaml
+labels:
+- pairs:
+    team: platform
+images:
+- name: docker.io/library/nginx
+  newName: registry.example.com/library/nginx
+`,
+				},
+			},
+		}
+
+		deployments, configMaps := decode(run(config, deployment, configMap))
+		Expect(deployments[0].Labels).To(HaveKeyWithValue("team", "platform"))
+		Expect(deployments[0].Spec.Template.Spec.Containers[0].Image).To(Equal("registry.example.com/library/nginx:1.23"))
+		Expect(configMaps[0].Labels).To(HaveKeyWithValue("team", "platform"))
+		Expect(configMaps[0].Data).To(HaveKeyWithValue("other", "val"))
+	})
+
+	It("should fail if the overlay does not contain a kustomization", func() {
+		config := &helmv1alpha1.PostRenderer{
+			Kustomize: &helmv1alpha1.KustomizePostRenderer{
+				Files: map[string]string{"other.yaml": configMap},
+			},
+		}
+		_, err := postrenderer.New(config).Run(bytes.NewBufferString(deployment))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should post-render the templates of a chart into a single file", func() {
+		config := &helmv1alpha1.PostRenderer{
+			Patches: []helmv1alpha1.PostRendererPatch{
+				{
+					Target: &helmv1alpha1.PatchTarget{Kind: "ConfigMap", Name: "my-.*"},
+					Patch:  `[{"op": "replace", "path": "/data/key", "value": "patched"}]`,
+				},
+			},
+		}
+		files := map[string]string{
+			"mychart/templates/deployment.yaml": deployment,
+			"mychart/templates/configmap.yaml":  configMap,
+			"mychart/templates/NOTES.txt":       "Thank you for installing mychart.",
+			"mychart/templates/_helpers.tpl":    "",
+		}
+
+		res, err := postrenderer.New(config).RenderFiles(logging.Discard(), files)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(HaveLen(1))
+		Expect(res).To(HaveKey(postrenderer.RenderedFile))
+
+		deployments, configMaps := decode([]byte(res[postrenderer.RenderedFile]))
+		Expect(deployments).To(HaveLen(1))
+		Expect(configMaps).To(HaveLen(1))
+		Expect(configMaps[0].Data).To(HaveKeyWithValue("key", "patched"))
+	})
+
+})
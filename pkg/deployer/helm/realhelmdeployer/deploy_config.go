@@ -101,3 +101,27 @@ func newUninstallConfiguration(conf *helmv1alpha1.HelmDeploymentConfiguration) (
 
 	return uninstallConf, nil
 }
+
+func newTestConfiguration(conf *helmv1alpha1.HelmDeploymentConfiguration) (*helmv1alpha1.HelmTestConfiguration, error) {
+	currOp := "NewTestConfiguration"
+
+	testConf := &helmv1alpha1.HelmTestConfiguration{}
+
+	if conf != nil && len(conf.Test) > 0 {
+		rawConf, err := json.Marshal(conf.Test)
+		if err != nil {
+			return nil, lserror.NewWrappedError(err, currOp, "MarshalConfig", err.Error())
+		}
+
+		if err := json.Unmarshal(rawConf, testConf); err != nil {
+			return nil, lserror.NewWrappedError(err, currOp, "UnmarshalConfig", err.Error())
+		}
+	}
+
+	// set defaults
+	if testConf.Timeout == nil {
+		testConf.Timeout = &lsv1alpha1.Duration{Duration: defaultTimeout}
+	}
+
+	return testConf, nil
+}
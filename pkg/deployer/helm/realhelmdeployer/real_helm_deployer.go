@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
@@ -37,6 +38,7 @@ import (
 	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/deployer/helm/postrenderer"
 	"github.com/gardener/landscaper/pkg/deployer/lib/resourcemanager"
 )
 
@@ -50,6 +52,7 @@ type RealHelmDeployer struct {
 	createNamespace    bool
 	targetRestConfig   *rest.Config
 	apiResourceHandler *resourcemanager.ApiResourceHandler
+	postRenderer       *postrenderer.PostRenderer
 }
 
 func NewRealHelmDeployer(ch *chart.Chart, providerConfig *helmv1alpha1.ProviderConfiguration, targetRestConfig *rest.Config,
@@ -65,6 +68,7 @@ func NewRealHelmDeployer(ch *chart.Chart, providerConfig *helmv1alpha1.ProviderC
 		createNamespace:    providerConfig.CreateNamespace,
 		targetRestConfig:   targetRestConfig,
 		apiResourceHandler: resourcemanager.CreateApiResourceHandler(clientset),
+		postRenderer:       postrenderer.New(providerConfig.PostRenderer),
 	}
 }
 
@@ -138,6 +142,9 @@ func (c *RealHelmDeployer) installRelease(ctx context.Context, values map[string
 	install.Namespace = c.defaultNamespace
 	install.CreateNamespace = c.createNamespace
 	install.Atomic = installConfig.Atomic
+	if c.postRenderer != nil {
+		install.PostRenderer = c.postRenderer
+	}
 	install.Timeout = installConfig.Timeout.Duration
 
 	logger.Info(fmt.Sprintf("installing helm chart release %s", c.releaseName))
@@ -176,6 +183,9 @@ func (c *RealHelmDeployer) upgradeRelease(ctx context.Context, values map[string
 	upgrade.MaxHistory = 10
 	upgrade.Atomic = upgradeConfig.Atomic
 	upgrade.Timeout = upgradeConfig.Timeout.Duration
+	if c.postRenderer != nil {
+		upgrade.PostRenderer = c.postRenderer
+	}
 
 	logger.Info(fmt.Sprintf("upgrading helm chart release %s", c.releaseName))
 
@@ -191,6 +201,180 @@ func (c *RealHelmDeployer) upgradeRelease(ctx context.Context, values map[string
 	return rel, nil
 }
 
+// Test runs the helm tests of the release if they are enabled.
+// A failed test is returned as error with the error code ErrorHelmTestFailed.
+func (c *RealHelmDeployer) Test(ctx context.Context) error {
+	currOp := "TestHelmRelease"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+
+	testConfig, err := newTestConfiguration(c.helmConfig)
+	if err != nil {
+		return err
+	}
+	if !testConfig.Enabled {
+		return nil
+	}
+
+	actionConfig, err := c.initActionConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	test := action.NewReleaseTesting(actionConfig)
+	test.Namespace = c.defaultNamespace
+	test.Timeout = testConfig.Timeout.Duration
+
+	logger.Info(fmt.Sprintf("testing helm chart release %s", c.releaseName))
+
+	rel, err := test.Run(c.releaseName)
+	if err != nil {
+		message := fmt.Sprintf("helm chart release test failed: %s", err.Error())
+		logger.Info(message)
+		if rel == nil {
+			return lserror.NewWrappedError(err, currOp, "Test", message)
+		}
+		return lserror.NewWrappedError(err, currOp, "Test", message, lsv1alpha1.ErrorHelmTestFailed)
+	}
+
+	logger.Info(fmt.Sprintf("%s successfully tested in %s", c.releaseName, c.defaultNamespace))
+
+	return nil
+}
+
+// GetHooksStatus returns the last executions of the hooks of the release.
+func (c *RealHelmDeployer) GetHooksStatus(ctx context.Context) ([]helmv1alpha1.HookStatus, error) {
+	rel, err := c.getRelease(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return hooksStatus(rel.Hooks), nil
+}
+
+// hooksStatus converts the hooks of a release into their status.
+func hooksStatus(hooks []*release.Hook) []helmv1alpha1.HookStatus {
+	result := make([]helmv1alpha1.HookStatus, 0, len(hooks))
+	for _, hook := range hooks {
+		status := helmv1alpha1.HookStatus{
+			Name:  hook.Name,
+			Kind:  hook.Kind,
+			Phase: string(hook.LastRun.Phase),
+		}
+		for _, event := range hook.Events {
+			status.Events = append(status.Events, string(event))
+		}
+		if !hook.LastRun.StartedAt.IsZero() {
+			startedAt := metav1.NewTime(hook.LastRun.StartedAt.Time)
+			status.StartedAt = &startedAt
+		}
+		if !hook.LastRun.CompletedAt.IsZero() {
+			completedAt := metav1.NewTime(hook.LastRun.CompletedAt.Time)
+			status.CompletedAt = &completedAt
+		}
+		result = append(result, status)
+	}
+
+	return result
+}
+
+// Rollback rolls the release back to the given revision and returns the new release.
+func (c *RealHelmDeployer) Rollback(ctx context.Context, revision int) (*release.Release, error) {
+	currOp := "RollbackHelmRelease"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+
+	// Validate that the release actually belongs to the namespace
+	if _, err := c.getRelease(ctx); err != nil {
+		return nil, err
+	}
+
+	actionConfig, err := c.initActionConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	upgradeConfig, err := newUpgradeConfiguration(c.helmConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	rollback := action.NewRollback(actionConfig)
+	rollback.Version = revision
+	rollback.MaxHistory = 10
+	rollback.Timeout = upgradeConfig.Timeout.Duration
+
+	logger.Info(fmt.Sprintf("rolling back helm chart release %s to revision %d", c.releaseName, revision))
+
+	if err := rollback.Run(c.releaseName); err != nil {
+		message := fmt.Sprintf("unable to roll back helm chart release: %s", err.Error())
+		logger.Info(message)
+		return nil, lserror.NewWrappedError(err, currOp, "Rollback", message)
+	}
+
+	logger.Info(fmt.Sprintf("%s successfully rolled back to revision %d in %s", c.releaseName, revision, c.defaultNamespace))
+
+	return c.getRelease(ctx)
+}
+
+// GetReleaseStatus returns the status of the release including its history.
+func (c *RealHelmDeployer) GetReleaseStatus(ctx context.Context) (*helmv1alpha1.ReleaseStatus, error) {
+	currOp := "GetHelmReleaseHistory"
+
+	actionConfig, err := c.initActionConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	releases, err := action.NewHistory(actionConfig).Run(c.releaseName)
+	if err != nil {
+		return nil, lserror.NewWrappedError(err, currOp, "GetHistory", err.Error())
+	}
+
+	return releaseStatus(releases), nil
+}
+
+// releaseStatus converts the revisions of a release into the release status.
+// The newest revision is the current revision of the release.
+func releaseStatus(releases []*release.Release) *helmv1alpha1.ReleaseStatus {
+	if len(releases) == 0 {
+		return nil
+	}
+
+	sorted := make([]*release.Release, len(releases))
+	copy(sorted, releases)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Version > sorted[j].Version
+	})
+
+	status := &helmv1alpha1.ReleaseStatus{
+		Name:      sorted[0].Name,
+		Namespace: sorted[0].Namespace,
+		History:   make([]helmv1alpha1.ReleaseRevision, 0, len(sorted)),
+	}
+	for _, rel := range sorted {
+		revision := helmv1alpha1.ReleaseRevision{
+			Revision: int64(rel.Version),
+		}
+		if rel.Chart != nil && rel.Chart.Metadata != nil {
+			revision.ChartVersion = rel.Chart.Metadata.Version
+			revision.AppVersion = rel.Chart.Metadata.AppVersion
+		}
+		if rel.Info != nil {
+			revision.Status = rel.Info.Status.String()
+			revision.Description = rel.Info.Description
+			if !rel.Info.LastDeployed.IsZero() {
+				updated := metav1.NewTime(rel.Info.LastDeployed.Time)
+				revision.Updated = &updated
+			}
+		}
+		status.History = append(status.History, revision)
+	}
+
+	status.Revision = status.History[0].Revision
+	status.Status = status.History[0].Status
+	status.ChartVersion = status.History[0].ChartVersion
+	return status
+}
+
 func (c *RealHelmDeployer) deleteRelease(ctx context.Context) error {
 	currOp := "DeleteHelmRelease"
 	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package realhelmdeployer
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	helmtime "helm.sh/helm/v3/pkg/time"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+)
+
+var _ = Describe("RealHelmDeployer", func() {
+
+	Context("Test Configuration", func() {
+		It("should disable the tests by default", func() {
+			conf, err := newTestConfiguration(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(conf.Enabled).To(BeFalse())
+			Expect(conf.Timeout.Duration).To(Equal(defaultTimeout))
+		})
+
+		It("should parse the test configuration", func() {
+			conf, err := newTestConfiguration(&helmv1alpha1.HelmDeploymentConfiguration{
+				Test: map[string]lsv1alpha1.AnyJSON{
+					"enabled": lsv1alpha1.NewAnyJSON(json.RawMessage(`true`)),
+					"timeout": lsv1alpha1.NewAnyJSON(json.RawMessage(`"2m"`)),
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(conf.Enabled).To(BeTrue())
+			Expect(conf.Timeout.Duration).To(Equal(2 * time.Minute))
+		})
+	})
+
+	Context("Hooks Status", func() {
+		It("should convert the last executions of the hooks", func() {
+			started := time.Date(2022, 10, 18, 12, 0, 0, 0, time.UTC)
+			hooks := []*release.Hook{
+				{
+					Name:   "migrate",
+					Kind:   "Job",
+					Events: []release.HookEvent{release.HookPostInstall, release.HookPostUpgrade},
+					LastRun: release.HookExecution{
+						StartedAt:   helmtime.Time{Time: started},
+						CompletedAt: helmtime.Time{Time: started.Add(time.Minute)},
+						Phase:       release.HookPhaseSucceeded,
+					},
+				},
+				{
+					Name:   "test-connection",
+					Kind:   "Pod",
+					Events: []release.HookEvent{release.HookTest},
+				},
+			}
+
+			status := hooksStatus(hooks)
+			Expect(status).To(HaveLen(2))
+			Expect(status[0].Name).To(Equal("migrate"))
+			Expect(status[0].Kind).To(Equal("Job"))
+			Expect(status[0].Events).To(ConsistOf("post-install", "post-upgrade"))
+			Expect(status[0].Phase).To(Equal("Succeeded"))
+			Expect(status[0].StartedAt.Time).To(BeTemporally("==", started))
+			Expect(status[0].CompletedAt.Time).To(BeTemporally("==", started.Add(time.Minute)))
+
+			Expect(status[1].Name).To(Equal("test-connection"))
+			Expect(status[1].Events).To(ConsistOf("test"))
+			Expect(status[1].Phase).To(BeEmpty())
+			Expect(status[1].StartedAt).To(BeNil())
+			Expect(status[1].CompletedAt).To(BeNil())
+		})
+	})
+
+	Context("Release Status", func() {
+		newRelease := func(revision int, status release.Status, chartVersion, description string, deployed time.Time) *release.Release {
+			return &release.Release{
+				Name:      "my-release",
+				Namespace: "my-namespace",
+				Version:   revision,
+				Chart: &chart.Chart{
+					Metadata: &chart.Metadata{Name: "mychart", Version: chartVersion, AppVersion: "v" + chartVersion},
+				},
+				Info: &release.Info{
+					Status:       status,
+					Description:  description,
+					LastDeployed: helmtime.Time{Time: deployed},
+				},
+			}
+		}
+
+		It("should convert the revisions of a release ordered from the newest to the oldest", func() {
+			deployed := time.Date(2022, 10, 18, 12, 0, 0, 0, time.UTC)
+			releases := []*release.Release{
+				newRelease(2, release.StatusSuperseded, "1.1.0", "Upgrade complete", deployed.Add(time.Hour)),
+				newRelease(3, release.StatusDeployed, "1.0.0", "Rollback to 1", deployed.Add(2*time.Hour)),
+				newRelease(1, release.StatusSuperseded, "1.0.0", "Install complete", deployed),
+			}
+
+			status := releaseStatus(releases)
+			Expect(status).ToNot(BeNil())
+			Expect(status.Name).To(Equal("my-release"))
+			Expect(status.Namespace).To(Equal("my-namespace"))
+			Expect(status.Revision).To(BeEquivalentTo(3))
+			Expect(status.Status).To(Equal("deployed"))
+			Expect(status.ChartVersion).To(Equal("1.0.0"))
+
+			Expect(status.History).To(HaveLen(3))
+			Expect(status.History[0].Revision).To(BeEquivalentTo(3))
+			Expect(status.History[0].Description).To(Equal("Rollback to 1"))
+			Expect(status.History[0].Updated.Time).To(BeTemporally("==", deployed.Add(2*time.Hour)))
+			Expect(status.History[1].Revision).To(BeEquivalentTo(2))
+			Expect(status.History[1].Status).To(Equal("superseded"))
+			Expect(status.History[1].ChartVersion).To(Equal("1.1.0"))
+			Expect(status.History[1].AppVersion).To(Equal("v1.1.0"))
+			Expect(status.History[2].Revision).To(BeEquivalentTo(1))
+		})
+
+		It("should return no status for a release without revisions", func() {
+			Expect(releaseStatus(nil)).To(BeNil())
+		})
+	})
+
+})
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+	"fmt"
+
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/deployer/helm/realhelmdeployer"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// RollbackRelease rolls the helm release back to the given revision.
+// The managed resources and the exports are computed from the rolled back release,
+// so that the result is handled like the result of a normal reconcile.
+func (h *Helm) RollbackRelease(ctx context.Context, revision int) error {
+	currOp := "RollbackRelease"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+
+	if !pointer.BoolDeref(h.ProviderConfiguration.HelmDeployment, true) {
+		return lserrors.NewError(currOp, "CheckHelmDeployment",
+			"a rollback is only possible for deploy items with helmDeployment enabled", lsv1alpha1.ErrorConfigurationProblem)
+	}
+
+	_, targetClient, targetClientSet, err := h.TargetClient(ctx)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "TargetClusterClient", err.Error())
+	}
+
+	if h.ProviderStatus == nil {
+		h.ProviderStatus = &helmv1alpha1.ProviderStatus{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: helmv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "ProviderStatus",
+			},
+			ManagedResources: make(managedresource.ManagedResourceStatusList, 0),
+		}
+	}
+	// the drift of the previously applied manifests is outdated
+	h.ProviderStatus.Drift = nil
+
+	realHelmDeployer := realhelmdeployer.NewRealHelmDeployer(nil, h.ProviderConfiguration,
+		h.TargetRestConfig, targetClientSet)
+	rel, rollbackErr := realHelmDeployer.Rollback(ctx, revision)
+	if err := h.updateReleaseStatus(ctx, realHelmDeployer); err != nil {
+		if rollbackErr == nil {
+			return err
+		}
+		logger.Error(err, "unable to get release status")
+	}
+	if rollbackErr != nil {
+		h.DeployItem.Status.ProviderStatus, err = kutil.ConvertToRawExtension(h.ProviderStatus, HelmScheme)
+		if err != nil {
+			logger.Error(err, "unable to encode status")
+		}
+		return rollbackErr
+	}
+
+	crds := map[string]string{}
+	for _, crd := range rel.Chart.CRDObjects() {
+		crds[crd.Filename] = string(crd.File.Data[:])
+	}
+	manifests, err := h.createManifests(ctx, currOp, releaseutil.SplitManifests(rel.Manifest), crds)
+	if err != nil {
+		return err
+	}
+	managedResourceStatusList, err := realHelmDeployer.GetManagedResourcesStatus(ctx, manifests)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "GetManagedResourcesStatus", err.Error())
+	}
+	h.ProviderStatus.ManagedResources = managedResourceStatusList
+	h.ProviderStatus.ChartVersion = h.resolvedChartVersion(rel.Chart)
+
+	h.DeployItem.Status.ProviderStatus, err = kutil.ConvertToRawExtension(h.ProviderStatus, HelmScheme)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "ProviderStatus", err.Error())
+	}
+
+	if err := h.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000052, h.DeployItem); err != nil {
+		return lserrors.NewWrappedError(err, currOp, "UpdateStatus", err.Error())
+	}
+
+	if err := h.checkResourcesReady(ctx, targetClient, false); err != nil {
+		return err
+	}
+
+	options := chartutil.ReleaseOptions{
+		Name:      rel.Name,
+		Namespace: rel.Namespace,
+		Revision:  rel.Version,
+	}
+	values, err := chartutil.ToRenderValues(rel.Chart, rel.Config, options, nil)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "RenderHelmValues", err.Error())
+	}
+	exports, err := h.constructExportsFromValues(values)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "ConstructExportFromValues", err.Error())
+	}
+	if err := h.readExportValues(ctx, currOp, targetClient, managedResourceStatusList, exports); err != nil {
+		return err
+	}
+
+	logger.Info(fmt.Sprintf("release %s rolled back to revision %d", rel.Name, revision))
+	h.DeployItem.Status.Phase = lsv1alpha1.ExecutionPhaseSucceeded
+
+	return nil
+}
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/pointer"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1/helper"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/deployer/helm"
+)
+
+var _ = Describe("Rollback", func() {
+
+	It("should fail for deploy items without helm deployment", func() {
+		ctx := logging.NewContext(context.Background(), logging.Discard())
+
+		helmConfig := &helmv1alpha1.ProviderConfiguration{}
+		helmConfig.Chart.Ref = "example.com/charts/mychart:1.0.0"
+		helmConfig.Name = "foo"
+		helmConfig.Namespace = "foo"
+		helmConfig.HelmDeployment = pointer.Bool(false)
+		providerConfig, err := helper.ProviderConfigurationToRawExtension(helmConfig)
+		Expect(err).ToNot(HaveOccurred())
+
+		item := &lsv1alpha1.DeployItem{}
+		item.Spec.Configuration = providerConfig
+
+		h, err := helm.New(helmv1alpha1.Configuration{}, testenv.Client, testenv.Client, item, nil, nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = h.RollbackRelease(ctx, 1)
+		Expect(err).To(HaveOccurred())
+		lsErr, ok := lserrors.IsError(err)
+		Expect(ok).To(BeTrue())
+		Expect(lsErr.LandscaperError().Codes).To(ContainElement(lsv1alpha1.ErrorConfigurationProblem))
+	})
+
+})
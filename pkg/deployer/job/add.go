@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package job
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	jobv1alpha1 "github.com/gardener/landscaper/apis/deployer/job/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	deployerlib "github.com/gardener/landscaper/pkg/deployer/lib"
+	"github.com/gardener/landscaper/pkg/version"
+)
+
+// AddDeployerToManager adds a new job deployer to a controller manager.
+func AddDeployerToManager(logger logging.Logger, lsMgr, hostMgr manager.Manager, config jobv1alpha1.Configuration) error {
+	log := logger.WithName("job")
+	d, err := NewDeployer(
+		log,
+		lsMgr.GetClient(),
+		hostMgr.GetClient(),
+		config,
+	)
+	if err != nil {
+		return err
+	}
+
+	options := controller.Options{
+		MaxConcurrentReconciles: config.Controller.Workers,
+	}
+	if config.Controller.CacheSyncTimeout != nil {
+		options.CacheSyncTimeout = config.Controller.CacheSyncTimeout.Duration
+	}
+
+	return deployerlib.Add(log, lsMgr, hostMgr, deployerlib.DeployerArgs{
+		Name:                  Name,
+		Version:               version.Get().String(),
+		Identity:              config.Identity,
+		Type:                  Type,
+		Deployer:              d,
+		TargetSelectors:       config.TargetSelector,
+		FailOnUnhealthyTarget: config.FailOnUnhealthyTarget,
+		Options:               options,
+	})
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package job
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	jobv1alpha1 "github.com/gardener/landscaper/apis/deployer/job/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	deployerlib "github.com/gardener/landscaper/pkg/deployer/lib"
+	"github.com/gardener/landscaper/pkg/deployer/lib/extension"
+)
+
+// NewDeployer creates a new deployer that reconciles deploy items of type job.
+func NewDeployer(log logging.Logger,
+	lsKubeClient client.Client,
+	hostKubeClient client.Client,
+	config jobv1alpha1.Configuration) (deployerlib.Deployer, error) {
+
+	return &deployer{
+		log:        log,
+		lsClient:   lsKubeClient,
+		hostClient: hostKubeClient,
+		config:     config,
+		hooks:      extension.ReconcileExtensionHooks{},
+	}, nil
+}
+
+type deployer struct {
+	log        logging.Logger
+	lsClient   client.Client
+	hostClient client.Client
+	config     jobv1alpha1.Configuration
+	hooks      extension.ReconcileExtensionHooks
+}
+
+func (d *deployer) Reconcile(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
+	job, err := New(d.lsClient, d.hostClient, &d.config, di, rt)
+	if err != nil {
+		return err
+	}
+	job.Context = lsCtx
+	return job.Reconcile(ctx)
+}
+
+func (d *deployer) Delete(ctx context.Context, _ *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
+	job, err := New(d.lsClient, d.hostClient, &d.config, di, rt)
+	if err != nil {
+		return err
+	}
+	return job.Delete(ctx)
+}
+
+func (d *deployer) Abort(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
+	d.log.Info("abort is not yet implemented")
+	return nil
+}
+
+func (d *deployer) ExtensionHooks() extension.ReconcileExtensionHooks {
+	return d.hooks
+}
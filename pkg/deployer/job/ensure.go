@@ -0,0 +1,313 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package job
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	jobv1alpha1 "github.com/gardener/landscaper/apis/deployer/job/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	deployerlib "github.com/gardener/landscaper/pkg/deployer/lib"
+	health "github.com/gardener/landscaper/pkg/deployer/lib/readinesscheck"
+	"github.com/gardener/landscaper/pkg/deployer/lib/resourcemanager"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// Reconcile runs the job of the deploy item on the target cluster and waits for its completion.
+// A job that already ran with the same configuration is not started again unless it has failed.
+func (j *Job) Reconcile(ctx context.Context) error {
+	currOp := "ReconcileJob"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+
+	j.DeployItem.Status.Phase = lsv1alpha1.ExecutionPhaseProgressing
+
+	targetClient, err := j.TargetClient(ctx)
+	if err != nil {
+		return lserrors.NewWrappedError(err,
+			currOp, "TargetClusterClient", err.Error())
+	}
+
+	if j.ProviderStatus == nil {
+		j.ProviderStatus = &jobv1alpha1.ProviderStatus{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: jobv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "ProviderStatus",
+			},
+		}
+	}
+
+	job, err := j.jobFromConfiguration()
+	if err != nil {
+		return lserrors.NewWrappedError(err,
+			currOp, "ParseJob", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+	key := kutil.ObjectKeyFromObject(job)
+
+	// a job that was created for a previous configuration with another name is not needed anymore
+	if ref := j.ProviderStatus.Job; ref != nil && kutil.ObjectKey(ref.Name, ref.Namespace) != key {
+		if err := j.deleteManagedJob(ctx, targetClient, kutil.ObjectKey(ref.Name, ref.Namespace)); err != nil {
+			return lserrors.NewWrappedError(err,
+				currOp, "DeletePreviousJob", err.Error())
+		}
+	}
+
+	existing := &batchv1.Job{}
+	if err := targetClient.Get(ctx, key, existing); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return lserrors.NewWrappedError(err,
+				currOp, "GetJob", err.Error())
+		}
+		existing = nil
+	}
+
+	if existing != nil {
+		if !kutil.HasLabelWithValue(existing, jobv1alpha1.ManagedDeployItemLabel, j.DeployItem.Name) {
+			err := fmt.Errorf("job %s already exists and is not managed by the deploy item", key.String())
+			return lserrors.NewWrappedError(err,
+				currOp, "ValidateJobOwnership", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+		}
+
+		if existing.GetAnnotations()[jobv1alpha1.ConfigHashAnnotation] == job.GetAnnotations()[jobv1alpha1.ConfigHashAnnotation] &&
+			!health.IsJobFailedError(health.CheckJob(existing)) {
+			logger.Debug("Job with the current configuration already exists", lc.KeyResource, key.String())
+			job = existing
+		} else {
+			logger.Info("Delete outdated job", lc.KeyResource, key.String())
+			if err := j.deleteJob(ctx, targetClient, key); err != nil {
+				return lserrors.NewWrappedError(err,
+					currOp, "DeleteOutdatedJob", err.Error())
+			}
+			existing = nil
+		}
+	}
+
+	if existing == nil {
+		logger.Info("Create job", lc.KeyResource, key.String())
+		if err := targetClient.Create(ctx, job); err != nil {
+			return lserrors.NewWrappedError(err,
+				currOp, "CreateJob", err.Error())
+		}
+	}
+
+	j.setJobStatus(job)
+	if err := j.encodeProviderStatus(); err != nil {
+		return lserrors.NewWrappedError(err,
+			currOp, "ProviderStatus", err.Error())
+	}
+	if err := j.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000173, j.DeployItem); err != nil {
+		return lserrors.NewWrappedError(err,
+			currOp, "UpdateStatus", err.Error())
+	}
+
+	waitErr := j.waitForJob(ctx, targetClient, key)
+	if err := targetClient.Get(ctx, key, job); err != nil {
+		return lserrors.NewWrappedError(err,
+			currOp, "GetJob", err.Error())
+	}
+	j.setJobStatus(job)
+	if err := j.encodeProviderStatus(); err != nil {
+		return lserrors.NewWrappedError(err,
+			currOp, "ProviderStatus", err.Error())
+	}
+	if j.ProviderStatus.Phase == jobv1alpha1.JobPhaseFailed {
+		return lserrors.NewError(currOp, "CheckJob", j.ProviderStatus.Message, lsv1alpha1.ErrorJobFailed)
+	}
+	if waitErr != nil {
+		return lserrors.NewWrappedError(waitErr,
+			currOp, "CheckJob", waitErr.Error(), lsv1alpha1.ErrorReadinessCheckTimeout)
+	}
+
+	if j.ProviderConfiguration.Exports != nil {
+		opts := resourcemanager.ExporterOptions{
+			KubeClient:          targetClient,
+			InterruptionChecker: deployerlib.NewInterruptionChecker(j.DeployItem, j.lsKubeClient),
+		}
+		if j.Configuration.Export.DefaultTimeout != nil {
+			opts.DefaultTimeout = &j.Configuration.Export.DefaultTimeout.Duration
+		}
+		exporter := resourcemanager.NewExporter(opts)
+		exports, err := exporter.Export(ctx, j.ProviderConfiguration.Exports)
+		if err != nil {
+			return lserrors.NewWrappedError(err, currOp, "ReadExportValues", err.Error())
+		}
+
+		if err := deployerlib.CreateOrUpdateExport(ctx, j.Writer(), j.lsKubeClient, j.DeployItem, exports); err != nil {
+			return err
+		}
+	}
+
+	j.DeployItem.Status.Phase = lsv1alpha1.ExecutionPhaseSucceeded
+
+	return nil
+}
+
+// Delete deletes the job of the deploy item from the target cluster.
+func (j *Job) Delete(ctx context.Context) error {
+	currOp := "DeleteJob"
+	j.DeployItem.Status.Phase = lsv1alpha1.ExecutionPhaseDeleting
+
+	if j.ProviderStatus == nil || j.ProviderStatus.Job == nil {
+		controllerutil.RemoveFinalizer(j.DeployItem, lsv1alpha1.LandscaperFinalizer)
+		return j.Writer().UpdateDeployItem(ctx, read_write_layer.W000174, j.DeployItem)
+	}
+
+	targetClient, err := j.TargetClient(ctx)
+	if err != nil {
+		return lserrors.NewWrappedError(err,
+			currOp, "TargetClusterClient", err.Error())
+	}
+
+	key := kutil.ObjectKey(j.ProviderStatus.Job.Name, j.ProviderStatus.Job.Namespace)
+	if err := j.deleteManagedJob(ctx, targetClient, key); err != nil {
+		return lserrors.NewWrappedError(err,
+			currOp, "DeleteJob", err.Error())
+	}
+
+	controllerutil.RemoveFinalizer(j.DeployItem, lsv1alpha1.LandscaperFinalizer)
+	return j.Writer().UpdateDeployItem(ctx, read_write_layer.W000175, j.DeployItem)
+}
+
+// jobFromConfiguration decodes the job of the provider configuration.
+// The job is labeled with the deploy item and annotated with the hash of its configuration.
+func (j *Job) jobFromConfiguration() (*batchv1.Job, error) {
+	job := &batchv1.Job{}
+	if err := yaml.Unmarshal(j.ProviderConfiguration.Job.Raw, job); err != nil {
+		return nil, fmt.Errorf("unable to decode job: %w", err)
+	}
+
+	data, err := json.Marshal(job)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode job: %w", err)
+	}
+	hash := sha256.Sum256(data)
+
+	kutil.SetMetaDataLabel(job, jobv1alpha1.ManagedDeployItemLabel, j.DeployItem.Name)
+	metav1.SetMetaDataAnnotation(&job.ObjectMeta, jobv1alpha1.ConfigHashAnnotation, hex.EncodeToString(hash[:]))
+	return job, nil
+}
+
+// waitForJob waits until the job has completed.
+// Waiting is aborted if the job fails.
+func (j *Job) waitForJob(ctx context.Context, targetClient client.Client, key types.NamespacedName) error {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(batchv1.SchemeGroupVersion.WithKind("Job"))
+	obj.SetName(key.Name)
+	obj.SetNamespace(key.Namespace)
+
+	return health.WaitForObjectsReady(ctx, j.ProviderConfiguration.Timeout.Duration, targetClient,
+		[]*unstructured.Unstructured{obj}, checkJob, deployerlib.NewInterruptionChecker(j.DeployItem, j.lsKubeClient))
+}
+
+// checkJob checks whether the job has completed.
+// In contrast to a not yet completed job, a failed job is not reported as not ready so that waiting for it is aborted.
+func checkJob(u *unstructured.Unstructured) error {
+	job := &batchv1.Job{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, job); err != nil {
+		return err
+	}
+	if err := health.CheckJob(job); err != nil {
+		if health.IsJobFailedError(err) {
+			return err
+		}
+		return health.NewObjectNotReadyError(u, err)
+	}
+	return nil
+}
+
+// deleteManagedJob deletes the job with the given key if it is managed by the deploy item.
+func (j *Job) deleteManagedJob(ctx context.Context, targetClient client.Client, key types.NamespacedName) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+	job := &batchv1.Job{}
+	if err := targetClient.Get(ctx, key, job); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if !kutil.HasLabelWithValue(job, jobv1alpha1.ManagedDeployItemLabel, j.DeployItem.Name) {
+		logger.Info("Job is not managed by the deploy item, skip delete", lc.KeyResource, key.String())
+		return nil
+	}
+	return j.deleteJob(ctx, targetClient, key)
+}
+
+// deleteJob deletes the job with the given key together with its pods and waits until the job is gone.
+func (j *Job) deleteJob(ctx context.Context, targetClient client.Client, key types.NamespacedName) error {
+	job := &batchv1.Job{}
+	job.SetName(key.Name)
+	job.SetNamespace(key.Namespace)
+	if err := targetClient.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	return wait.PollImmediate(time.Second, j.ProviderConfiguration.DeleteTimeout.Duration, func() (bool, error) {
+		if err := targetClient.Get(ctx, key, job); err != nil {
+			if apierrors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		return false, nil
+	})
+}
+
+// setJobStatus sets the provider status from the status of the given job.
+func (j *Job) setJobStatus(job *batchv1.Job) {
+	j.ProviderStatus.Job = &lsv1alpha1.TypedObjectReference{
+		APIVersion: batchv1.SchemeGroupVersion.String(),
+		Kind:       "Job",
+		ObjectReference: lsv1alpha1.ObjectReference{
+			Name:      job.GetName(),
+			Namespace: job.GetNamespace(),
+		},
+	}
+	j.ProviderStatus.StartTime = job.Status.StartTime
+	j.ProviderStatus.CompletionTime = job.Status.CompletionTime
+	j.ProviderStatus.Message = ""
+
+	err := health.CheckJob(job)
+	switch {
+	case err == nil:
+		j.ProviderStatus.Phase = jobv1alpha1.JobPhaseSucceeded
+	case health.IsJobFailedError(err):
+		j.ProviderStatus.Phase = jobv1alpha1.JobPhaseFailed
+		j.ProviderStatus.Message = err.Error()
+	default:
+		j.ProviderStatus.Phase = jobv1alpha1.JobPhaseRunning
+	}
+}
+
+func (j *Job) encodeProviderStatus() error {
+	var err error
+	j.DeployItem.Status.ProviderStatus, err = kutil.ConvertToRawExtension(j.ProviderStatus, Scheme)
+	return err
+}
+
+func (j *Job) Writer() *read_write_layer.Writer {
+	return read_write_layer.NewWriter(j.lsKubeClient)
+}
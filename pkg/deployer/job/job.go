@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package job
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	jobinstall "github.com/gardener/landscaper/apis/deployer/job/install"
+	jobv1alpha1 "github.com/gardener/landscaper/apis/deployer/job/v1alpha1"
+	jobvalidation "github.com/gardener/landscaper/apis/deployer/job/validation"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/deployer/lib"
+	"github.com/gardener/landscaper/pkg/utils"
+)
+
+const (
+	Type lsv1alpha1.DeployItemType = "landscaper.gardener.cloud/job"
+	Name string                    = "job.deployer.landscaper.gardener.cloud"
+)
+
+var Scheme = runtime.NewScheme()
+
+func init() {
+	jobinstall.Install(Scheme)
+}
+
+// Job is the internal representation of a DeployItem of Type Job
+type Job struct {
+	lsKubeClient   client.Client
+	hostKubeClient client.Client
+	Configuration  *jobv1alpha1.Configuration
+
+	DeployItem            *lsv1alpha1.DeployItem
+	Target                *lsv1alpha1.ResolvedTarget
+	Context               *lsv1alpha1.Context
+	ProviderConfiguration *jobv1alpha1.ProviderConfiguration
+	ProviderStatus        *jobv1alpha1.ProviderStatus
+
+	TargetKubeClient client.Client
+}
+
+// NewDeployItemBuilder creates a new deployitem builder for job deployitems
+func NewDeployItemBuilder() *utils.DeployItemBuilder {
+	return utils.NewDeployItemBuilder(string(Type)).Scheme(Scheme)
+}
+
+// New creates a new internal job item
+func New(lsKubeClient client.Client,
+	hostKubeClient client.Client,
+	configuration *jobv1alpha1.Configuration,
+	item *lsv1alpha1.DeployItem,
+	rt *lsv1alpha1.ResolvedTarget) (*Job, error) {
+
+	config := &jobv1alpha1.ProviderConfiguration{}
+	currOp := "InitJobOperation"
+	jobDecoder := api.NewDecoder(Scheme)
+	if _, _, err := jobDecoder.Decode(item.Spec.Configuration.Raw, nil, config); err != nil {
+		return nil, lserrors.NewWrappedError(err,
+			currOp, "ParseProviderConfiguration", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+
+	if err := jobvalidation.ValidateProviderConfiguration(config); err != nil {
+		return nil, lserrors.NewWrappedError(err,
+			currOp, "ValidateProviderConfiguration", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+
+	var status *jobv1alpha1.ProviderStatus
+	if item.Status.ProviderStatus != nil {
+		status = &jobv1alpha1.ProviderStatus{}
+		if _, _, err := jobDecoder.Decode(item.Status.ProviderStatus.Raw, nil, status); err != nil {
+			return nil, lserrors.NewWrappedError(err,
+				currOp, "ParseProviderStatus", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+		}
+	}
+
+	return &Job{
+		lsKubeClient:          lsKubeClient,
+		hostKubeClient:        hostKubeClient,
+		Configuration:         configuration,
+		DeployItem:            item,
+		Target:                rt,
+		ProviderConfiguration: config,
+		ProviderStatus:        status,
+	}, nil
+}
+
+// TargetClient returns a client for the cluster the job is run on.
+func (j *Job) TargetClient(ctx context.Context) (client.Client, error) {
+	if j.TargetKubeClient != nil {
+		return j.TargetKubeClient, nil
+	}
+
+	var kubeconfigBytes []byte
+	// use the configured kubeconfig over the target if defined
+	if len(j.ProviderConfiguration.Kubeconfig) != 0 {
+		kubeconfig, err := base64.StdEncoding.DecodeString(j.ProviderConfiguration.Kubeconfig)
+		if err != nil {
+			return nil, err
+		}
+		kubeconfigBytes = kubeconfig
+	} else if j.Target != nil {
+		targetConfig := &targettypes.KubernetesClusterTargetConfig{}
+		if err := yaml.Unmarshal([]byte(j.Target.Content), targetConfig); err != nil {
+			return nil, fmt.Errorf("unable to parse target configuration: %w", err)
+		}
+
+		kubeconfig, err := lib.GetKubeconfigFromTargetConfig(ctx, targetConfig, j.Target.Namespace, j.lsKubeClient)
+		if err != nil {
+			return nil, err
+		}
+		kubeconfigBytes = kubeconfig
+	} else {
+		return nil, errors.New("neither a target nor kubeconfig are defined")
+	}
+
+	kubeconfig, err := clientcmd.NewClientConfigFromBytes(kubeconfigBytes)
+	if err != nil {
+		return nil, err
+	}
+	restConfig, err := kubeconfig.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	kubeClient, err := client.New(restConfig, client.Options{})
+	if err != nil {
+		return nil, err
+	}
+
+	j.TargetKubeClient = kubeClient
+	return kubeClient, nil
+}
@@ -0,0 +1,308 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package job_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	jobv1alpha1 "github.com/gardener/landscaper/apis/deployer/job/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/deployer/job"
+)
+
+var _ = Describe("Job", func() {
+
+	var (
+		ctx          context.Context
+		lsClient     client.Client
+		targetClient client.Client
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		lsClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+		targetClient = fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	})
+
+	newJobManifest := func(image string) *batchv1.Job {
+		j := &batchv1.Job{}
+		j.APIVersion = "batch/v1"
+		j.Kind = "Job"
+		j.Name = "migration"
+		j.Namespace = "default"
+		j.Spec.Template.Spec.RestartPolicy = corev1.RestartPolicyNever
+		j.Spec.Template.Spec.Containers = []corev1.Container{
+			{
+				Name:  "migrate",
+				Image: image,
+			},
+		}
+		return j
+	}
+
+	newDeployItem := func(config *jobv1alpha1.ProviderConfiguration) *lsv1alpha1.DeployItem {
+		if config.Timeout == nil {
+			config.Timeout = &lsv1alpha1.Duration{Duration: 10 * time.Millisecond}
+		}
+		item, err := job.NewDeployItemBuilder().
+			Key("default", "myitem").
+			ProviderConfig(config).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(lsClient.Create(ctx, item)).To(Succeed())
+		return item
+	}
+
+	newJob := func(item *lsv1alpha1.DeployItem) *job.Job {
+		j, err := job.New(lsClient, lsClient, &jobv1alpha1.Configuration{}, item, nil)
+		Expect(err).ToNot(HaveOccurred())
+		j.TargetKubeClient = targetClient
+		return j
+	}
+
+	complete := func(key client.ObjectKey) {
+		j := &batchv1.Job{}
+		Expect(targetClient.Get(ctx, key, j)).To(Succeed())
+		j.Status.Succeeded = 1
+		j.Status.Conditions = []batchv1.JobCondition{
+			{
+				Type:   batchv1.JobComplete,
+				Status: corev1.ConditionTrue,
+			},
+		}
+		Expect(targetClient.Status().Update(ctx, j)).To(Succeed())
+	}
+
+	fail := func(key client.ObjectKey) {
+		j := &batchv1.Job{}
+		Expect(targetClient.Get(ctx, key, j)).To(Succeed())
+		j.Status.Failed = 1
+		j.Status.Conditions = []batchv1.JobCondition{
+			{
+				Type:    batchv1.JobFailed,
+				Status:  corev1.ConditionTrue,
+				Reason:  "BackoffLimitExceeded",
+				Message: "Job has reached the specified backoff limit",
+			},
+		}
+		Expect(targetClient.Status().Update(ctx, j)).To(Succeed())
+	}
+
+	providerStatus := func(item *lsv1alpha1.DeployItem) *jobv1alpha1.ProviderStatus {
+		status := &jobv1alpha1.ProviderStatus{}
+		Expect(json.Unmarshal(item.Status.ProviderStatus.Raw, status)).To(Succeed())
+		return status
+	}
+
+	errorCodes := func(err error) []lsv1alpha1.ErrorCode {
+		lsErr, ok := err.(lserrors.LsError)
+		Expect(ok).To(BeTrue())
+		return lsErr.LandscaperError().Codes
+	}
+
+	key := kutil.ObjectKey("migration", "default")
+
+	It("should create the job and wait for its completion", func() {
+		rawJob, err := kutil.ConvertToRawExtension(newJobManifest("migrate:v1"), scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+		item := newDeployItem(&jobv1alpha1.ProviderConfiguration{Job: rawJob})
+
+		err = newJob(item).Reconcile(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(errorCodes(err)).To(ContainElement(lsv1alpha1.ErrorReadinessCheckTimeout))
+
+		createdJob := &batchv1.Job{}
+		Expect(targetClient.Get(ctx, key, createdJob)).To(Succeed())
+		Expect(createdJob.Labels).To(HaveKeyWithValue(jobv1alpha1.ManagedDeployItemLabel, item.Name))
+		Expect(createdJob.Annotations).To(HaveKey(jobv1alpha1.ConfigHashAnnotation))
+		Expect(providerStatus(item).Phase).To(Equal(jobv1alpha1.JobPhaseRunning))
+
+		complete(key)
+		Expect(newJob(item).Reconcile(ctx)).To(Succeed())
+		Expect(item.Status.Phase).To(Equal(lsv1alpha1.ExecutionPhaseSucceeded))
+
+		status := providerStatus(item)
+		Expect(status.Phase).To(Equal(jobv1alpha1.JobPhaseSucceeded))
+		Expect(status.Job).ToNot(BeNil())
+		Expect(status.Job.Name).To(Equal("migration"))
+		Expect(status.Job.Namespace).To(Equal("default"))
+
+		reusedJob := &batchv1.Job{}
+		Expect(targetClient.Get(ctx, key, reusedJob)).To(Succeed())
+		Expect(reusedJob.UID).To(Equal(createdJob.UID))
+	})
+
+	It("should rerun a failed job", func() {
+		rawJob, err := kutil.ConvertToRawExtension(newJobManifest("migrate:v1"), scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+		item := newDeployItem(&jobv1alpha1.ProviderConfiguration{Job: rawJob})
+		Expect(newJob(item).Reconcile(ctx)).ToNot(Succeed())
+		fail(key)
+
+		err = newJob(item).Reconcile(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(errorCodes(err)).To(ContainElement(lsv1alpha1.ErrorReadinessCheckTimeout))
+
+		obj := &batchv1.Job{}
+		Expect(targetClient.Get(ctx, key, obj)).To(Succeed())
+		Expect(obj.Status.Failed).To(BeZero())
+		Expect(providerStatus(item).Phase).To(Equal(jobv1alpha1.JobPhaseRunning))
+	})
+
+	It("should recreate the job if its configuration changes", func() {
+		rawJob, err := kutil.ConvertToRawExtension(newJobManifest("migrate:v1"), scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+		item := newDeployItem(&jobv1alpha1.ProviderConfiguration{Job: rawJob})
+		Expect(newJob(item).Reconcile(ctx)).ToNot(Succeed())
+		complete(key)
+
+		oldJob := &batchv1.Job{}
+		Expect(targetClient.Get(ctx, key, oldJob)).To(Succeed())
+
+		rawJob, err = kutil.ConvertToRawExtension(newJobManifest("migrate:v2"), scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+		item.Spec.Configuration, err = kutil.ConvertToRawExtension(&jobv1alpha1.ProviderConfiguration{
+			Job:     rawJob,
+			Timeout: &lsv1alpha1.Duration{Duration: 10 * time.Millisecond},
+		}, job.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(newJob(item).Reconcile(ctx)).ToNot(Succeed())
+
+		newJob := &batchv1.Job{}
+		Expect(targetClient.Get(ctx, key, newJob)).To(Succeed())
+		Expect(newJob.Spec.Template.Spec.Containers[0].Image).To(Equal("migrate:v2"))
+		Expect(newJob.Annotations[jobv1alpha1.ConfigHashAnnotation]).ToNot(Equal(oldJob.Annotations[jobv1alpha1.ConfigHashAnnotation]))
+	})
+
+	It("should not overwrite a job that is not managed by the deploy item", func() {
+		existing := newJobManifest("other:v1")
+		Expect(targetClient.Create(ctx, existing)).To(Succeed())
+
+		rawJob, err := kutil.ConvertToRawExtension(newJobManifest("migrate:v1"), scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+		item := newDeployItem(&jobv1alpha1.ProviderConfiguration{Job: rawJob})
+
+		err = newJob(item).Reconcile(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(errorCodes(err)).To(ContainElement(lsv1alpha1.ErrorConfigurationProblem))
+
+		obj := &batchv1.Job{}
+		Expect(targetClient.Get(ctx, key, obj)).To(Succeed())
+		Expect(obj.Spec.Template.Spec.Containers[0].Image).To(Equal("other:v1"))
+	})
+
+	It("should export values from a resource written by the job", func() {
+		rawJob, err := kutil.ConvertToRawExtension(newJobManifest("migrate:v1"), scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+		item := newDeployItem(&jobv1alpha1.ProviderConfiguration{
+			Job: rawJob,
+			Exports: &managedresource.Exports{
+				Exports: []managedresource.Export{
+					{
+						Key:      "version",
+						JSONPath: ".data.version",
+						FromResource: &lsv1alpha1.TypedObjectReference{
+							APIVersion: "v1",
+							Kind:       "ConfigMap",
+							ObjectReference: lsv1alpha1.ObjectReference{
+								Name:      "migration-result",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+		})
+		Expect(newJob(item).Reconcile(ctx)).ToNot(Succeed())
+
+		complete(key)
+		cm := &corev1.ConfigMap{}
+		cm.Name = "migration-result"
+		cm.Namespace = "default"
+		cm.Data = map[string]string{"version": "42"}
+		Expect(targetClient.Create(ctx, cm)).To(Succeed())
+
+		Expect(newJob(item).Reconcile(ctx)).To(Succeed())
+		Expect(item.Status.ExportReference).ToNot(BeNil())
+
+		export := &corev1.Secret{}
+		Expect(lsClient.Get(ctx, item.Status.ExportReference.NamespacedName(), export)).To(Succeed())
+		var exportData map[string]interface{}
+		Expect(json.Unmarshal(export.Data[lsv1alpha1.DataObjectSecretDataKey], &exportData)).To(Succeed())
+		Expect(exportData).To(HaveKeyWithValue("version", "42"))
+	})
+
+	It("should delete the job", func() {
+		rawJob, err := kutil.ConvertToRawExtension(newJobManifest("migrate:v1"), scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+		item := newDeployItem(&jobv1alpha1.ProviderConfiguration{Job: rawJob})
+		item.Finalizers = []string{lsv1alpha1.LandscaperFinalizer}
+		Expect(lsClient.Update(ctx, item)).To(Succeed())
+		Expect(newJob(item).Reconcile(ctx)).ToNot(Succeed())
+		Expect(targetClient.Get(ctx, key, &batchv1.Job{})).To(Succeed())
+
+		Expect(newJob(item).Delete(ctx)).To(Succeed())
+		err = targetClient.Get(ctx, key, &batchv1.Job{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		Expect(item.Finalizers).ToNot(ContainElement(lsv1alpha1.LandscaperFinalizer))
+	})
+
+	It("should reject a provider configuration without a job", func() {
+		item := &lsv1alpha1.DeployItem{}
+		item.Spec.Configuration = &runtime.RawExtension{
+			Raw: []byte(`{"apiVersion": "job.deployer.landscaper.gardener.cloud/v1alpha1", "kind": "ProviderConfiguration"}`),
+		}
+		_, err := job.New(lsClient, lsClient, &jobv1alpha1.Configuration{}, item, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(errorCodes(err)).To(ContainElement(lsv1alpha1.ErrorConfigurationProblem))
+	})
+
+	It("should fail if the job fails", func() {
+		rawJob, err := kutil.ConvertToRawExtension(newJobManifest("migrate:v1"), scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+		item := newDeployItem(&jobv1alpha1.ProviderConfiguration{Job: rawJob})
+		Expect(newJob(item).Reconcile(ctx)).ToNot(Succeed())
+
+		// the job fails while the deployer waits for its completion
+		j := newJob(item)
+		j.TargetKubeClient = &failOnGetClient{Client: targetClient, fail: func() { fail(key) }}
+		err = j.Reconcile(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(errorCodes(err)).To(ContainElement(lsv1alpha1.ErrorJobFailed))
+		Expect(providerStatus(item).Phase).To(Equal(jobv1alpha1.JobPhaseFailed))
+		Expect(providerStatus(item).Message).To(ContainSubstring("BackoffLimitExceeded"))
+	})
+})
+
+// failOnGetClient is a client that lets the job fail before the job is read by the readiness check for the first time.
+type failOnGetClient struct {
+	client.Client
+	fail   func()
+	failed bool
+}
+
+func (c *failOnGetClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+	if _, ok := obj.(*batchv1.Job); !ok && !c.failed {
+		c.failed = true
+		c.fail()
+	}
+	return c.Client.Get(ctx, key, obj)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/robfig/cron/v3"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
 	cr "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/deployer/lib/extension"
@@ -44,6 +45,12 @@ func ContinuousReconcileExtension(nextReconcile func(context.Context, time.Time,
 			return nil, nil
 		}
 
+		// continuous reconciliation is paused while the installation of the deploy item is suspended
+		if lsv1alpha1helper.IsSuspended(di.ObjectMeta) {
+			logger.Info("Continuous reconciliation paused by annotation", "annotation", lsv1alpha1.SuspendedAnnotation)
+			return nil, nil
+		}
+
 		nextRaw, err := nextReconcile(ctx, di.Status.LastReconcileTime.Time, di)
 		if err != nil {
 			return nil, fmt.Errorf("unable to check whether reconciliation is due: %w", err)
@@ -7,6 +7,7 @@ package lib
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -15,6 +16,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -22,11 +24,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	controllerruntimeMetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
@@ -34,12 +38,19 @@ import (
 	"github.com/gardener/landscaper/pkg/deployer/lib/extension"
 	"github.com/gardener/landscaper/pkg/deployer/lib/targetresolver"
 	secretresolver "github.com/gardener/landscaper/pkg/deployer/lib/targetresolver/secret"
+	tokenrequestresolver "github.com/gardener/landscaper/pkg/deployer/lib/targetresolver/tokenrequest"
 	"github.com/gardener/landscaper/pkg/deployer/lib/targetselector"
+	"github.com/gardener/landscaper/pkg/events"
+	"github.com/gardener/landscaper/pkg/metrics"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 	"github.com/gardener/landscaper/pkg/version"
 )
 
+// UnhealthyTargetMaxAge is the maximal age of a failed health check of a target that lets its deploy items fail fast.
+// Older results are ignored as the target might have recovered since then.
+const UnhealthyTargetMaxAge = time.Minute
+
 // Deployer defines a controller that acts upon deployitems.
 type Deployer interface {
 	// Reconcile the deployitem.
@@ -52,6 +63,20 @@ type Deployer interface {
 	ExtensionHooks() extension.ReconcileExtensionHooks
 }
 
+// DriftDetector is an optional interface of a Deployer that detects drift of the deployed resources.
+type DriftDetector interface {
+	// DetectDrift compares the deployed resources of a succeeded deploy item with their last applied state.
+	// It returns the duration after which the drift detection is due again, or zero if drift detection is not configured.
+	DetectDrift(ctx context.Context, lsContext *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, target *lsv1alpha1.ResolvedTarget) (time.Duration, error)
+}
+
+// Rollbacker is an optional interface of a Deployer that rolls back the deployed state of a deploy item.
+type Rollbacker interface {
+	// Rollback rolls the deployed state of the deploy item back to the given revision.
+	// It is called instead of Reconcile by the job of a deploy item with the rollback operation annotation.
+	Rollback(ctx context.Context, lsContext *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, target *lsv1alpha1.ResolvedTarget, revision int64) error
+}
+
 // DeployerArgs defines the deployer arguments for the initializing a generic deployer controller.
 type DeployerArgs struct {
 	Name            string
@@ -60,7 +85,11 @@ type DeployerArgs struct {
 	Type            lsv1alpha1.DeployItemType
 	Deployer        Deployer
 	TargetSelectors []lsv1alpha1.TargetSelector
-	Options         ctrl.Options
+	// FailOnUnhealthyTarget lets deploy items fail fast with a recoverable error,
+	// if a recent health check of the landscaper has found their target unreachable.
+	// It is disabled by default, as the health check runs on the landscaper and not on the deployer.
+	FailOnUnhealthyTarget bool
+	Options               ctrl.Options
 }
 
 // Default defaults deployer arguments
@@ -101,8 +130,16 @@ func Add(log logging.Logger, lsMgr, hostMgr manager.Manager, args DeployerArgs)
 		hostMgr.GetScheme(),
 		args)
 
+	lsClientset, err := kubernetes.NewForConfig(lsMgr.GetConfig())
+	if err != nil {
+		return fmt.Errorf("unable to create clientset for the landscaper cluster: %w", err)
+	}
+	con.tokenRequestResolver = tokenrequestresolver.New(lsClientset.CoreV1())
+
 	log = log.Reconciles("", "DeployItem").WithValues(lc.KeyDeployItemType, string(args.Type))
 
+	metrics.RegisterLifecycleMetrics(controllerruntimeMetrics.Registry)
+
 	return builder.ControllerManagedBy(lsMgr).
 		For(&lsv1alpha1.DeployItem{}, builder.WithPredicates(NewTypePredicate(args.Type))).
 		WithOptions(args.Options).
@@ -117,12 +154,17 @@ type controller struct {
 	// deployerType defines the deployer type the deployer is responsible for.
 	deployerType    lsv1alpha1.DeployItemType
 	targetSelectors []lsv1alpha1.TargetSelector
+	// failOnUnhealthyTarget defines whether deploy items with an unreachable target fail fast.
+	failOnUnhealthyTarget bool
 
 	lsClient        client.Client
 	lsScheme        *runtime.Scheme
 	lsEventRecorder record.EventRecorder
 	hostClient      client.Client
 	hostScheme      *runtime.Scheme
+	// tokenRequestResolver resolves kubernetes cluster token request targets.
+	// Token request targets are not supported if it is not set.
+	tokenRequestResolver *tokenrequestresolver.TokenRequestResolver
 }
 
 // NewController creates a new generic deployitem controller.
@@ -140,17 +182,19 @@ func NewController(lsClient client.Client,
 			Name:     args.Name,
 			Version:  args.Version,
 		},
-		targetSelectors: args.TargetSelectors,
-		lsClient:        lsClient,
-		lsScheme:        lsScheme,
-		lsEventRecorder: lsEventRecorder,
-		hostClient:      hostClient,
-		hostScheme:      hostScheme,
+		targetSelectors:       args.TargetSelectors,
+		failOnUnhealthyTarget: args.FailOnUnhealthyTarget,
+		lsClient:              lsClient,
+		lsScheme:              lsScheme,
+		lsEventRecorder:       lsEventRecorder,
+		hostClient:            hostClient,
+		hostScheme:            hostScheme,
 	}
 }
 
 func (c *controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
 	logger, ctx := logging.MustStartReconcileFromContext(ctx, req, nil)
+	defer metrics.ObserveReconcileDuration(c.info.Name, time.Now())
 
 	var err error
 
@@ -197,9 +241,24 @@ func (c *controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, nil
 	}
 
+	// The rollback of a deploy item of an execution is started by a new job of the execution.
+	if lsv1alpha1helper.HasOperation(di.ObjectMeta, lsv1alpha1.RollbackOperation) && !isManagedByExecution(di) &&
+		di.Status.GetJobID() == di.Status.JobIDFinished && di.DeletionTimestamp.IsZero() {
+
+		logger.Info("generating a new jobID, because of a rollback annotation")
+		di.Status.JobID = uuid.New().String()
+		now := metav1.Now()
+		di.Status.JobIDGenerationTime = &now
+		if err := c.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000181, di); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		return reconcile.Result{}, nil
+	}
+
 	if di.Status.GetJobID() == di.Status.JobIDFinished {
 		logger.Info("deploy item not reconciled because no new job ID")
-		return reconcile.Result{}, nil
+		return c.detectDrift(ctx, lsCtx, di, rt)
 	}
 
 	if di.Status.DeployItemPhase == lsv1alpha1.DeployItemPhaseSucceeded ||
@@ -208,9 +267,16 @@ func (c *controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 
 		// The deployitem has a new jobID, but the phase is still finished from before
 
+		// a suspended deploy item does not start new jobs, but running jobs and deletions are continued
+		if lsv1alpha1helper.IsSuspended(di.ObjectMeta) && di.DeletionTimestamp.IsZero() {
+			logger.Info("deploy item is suspended: postponing new job", lc.KeyJobID, di.Status.GetJobID())
+			return reconcile.Result{}, nil
+		}
+
 		if di.DeletionTimestamp.IsZero() {
 			if di.Spec.UpdateOnChangeOnly &&
 				di.GetGeneration() == di.Status.ObservedGeneration &&
+				!lsv1alpha1helper.HasOperation(di.ObjectMeta, lsv1alpha1.RollbackOperation) &&
 				di.Status.DeployItemPhase == lsv1alpha1.DeployItemPhaseSucceeded {
 
 				// deployitem is unchanged and succeeded, and no reconcile desired in this case
@@ -222,7 +288,7 @@ func (c *controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			// initialize deployitem for reconcile
 			di.Status.Phase = lsv1alpha1.ExecutionPhaseInit
 			di.Status.DeployItemPhase = lsv1alpha1.DeployItemPhaseProgressing
-			if err := c.updateDiForNewReconcile(ctx, di); err != nil {
+			if err := c.updateDiForNewReconcile(ctx, di, old.Status.DeployItemPhase); err != nil {
 				return reconcile.Result{}, err
 			}
 		} else {
@@ -230,7 +296,7 @@ func (c *controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			// initialize deployitem for delete
 			di.Status.Phase = lsv1alpha1.ExecutionPhaseInit
 			di.Status.DeployItemPhase = lsv1alpha1.DeployItemPhaseDeleting
-			if err := c.updateDiForNewReconcile(ctx, di); err != nil {
+			if err := c.updateDiForNewReconcile(ctx, di, old.Status.DeployItemPhase); err != nil {
 				return reconcile.Result{}, err
 			}
 		}
@@ -251,6 +317,47 @@ func (c *controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	}
 }
 
+// detectDrift runs the drift detection of the deployer for succeeded deploy items
+// and requeues the deploy item when the next drift detection is due.
+func (c *controller) detectDrift(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem,
+	rt *lsv1alpha1.ResolvedTarget) (reconcile.Result, error) {
+	detector, ok := c.deployer.(DriftDetector)
+	if !ok {
+		return reconcile.Result{}, nil
+	}
+	if !di.DeletionTimestamp.IsZero() || di.Status.DeployItemPhase != lsv1alpha1.DeployItemPhaseSucceeded {
+		return reconcile.Result{}, nil
+	}
+
+	requeueAfter, err := detector.DetectDrift(ctx, lsCtx, di, rt)
+	if err != nil {
+		// a failed drift detection does not fail the deploy item, so it is only shown in its conditions
+		if updateErr := c.updateDriftDetectionCondition(ctx, di, lsv1alpha1.ConditionFalse, "DriftDetectionFailed", err.Error()); updateErr != nil {
+			return reconcile.Result{}, updateErr
+		}
+		return reconcile.Result{}, fmt.Errorf("unable to detect drift: %w", err)
+	}
+	if condition := lsv1alpha1helper.GetCondition(di.Status.Conditions, lsv1alpha1.DriftDetectionCondition); condition != nil &&
+		condition.Status == lsv1alpha1.ConditionFalse {
+		if err := c.updateDriftDetectionCondition(ctx, di, lsv1alpha1.ConditionTrue, "DriftDetectionSucceeded", "Drift detection succeeded"); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// updateDriftDetectionCondition sets the drift detection condition of the deploy item and updates its status if the condition has changed.
+func (c *controller) updateDriftDetectionCondition(ctx context.Context, di *lsv1alpha1.DeployItem,
+	status lsv1alpha1.ConditionStatus, reason, message string) error {
+	condition := lsv1alpha1helper.GetOrInitCondition(di.Status.Conditions, lsv1alpha1.DriftDetectionCondition)
+	if condition.Status == status && condition.Reason == reason && condition.Message == message {
+		return nil
+	}
+	di.Status.Conditions = lsv1alpha1helper.MergeConditions(di.Status.Conditions,
+		lsv1alpha1helper.UpdatedCondition(condition, status, reason, message))
+	return c.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000180, di)
+}
+
 func (c *controller) handleReconcileResult(ctx context.Context, err lserrors.LsError, oldDeployItem, deployItem *lsv1alpha1.DeployItem) error {
 	return HandleReconcileResult(ctx, err, oldDeployItem, deployItem, c.lsClient, c.lsEventRecorder)
 }
@@ -281,7 +388,15 @@ func (c *controller) checkTargetResponsibilityAndResolve(ctx context.Context, lo
 	// resolve Target reference, if any
 	var rt *lsv1alpha1.ResolvedTarget
 	if target != nil {
-		if target.Spec.SecretRef != nil {
+		if target.Spec.Type == targettypes.KubernetesClusterTokenRequestTargetType {
+			if c.tokenRequestResolver == nil {
+				return nil, false, fmt.Errorf("target '%s/%s' of type %s is not supported by the deployer", target.Namespace, target.Name, target.Spec.Type)
+			}
+			rt, err = c.tokenRequestResolver.Resolve(ctx, target)
+			if err != nil {
+				return nil, false, fmt.Errorf("error resolving token request target '%s/%s': %w", target.Namespace, target.Name, err)
+			}
+		} else if target.Spec.SecretRef != nil {
 			sr := secretresolver.New(c.lsClient)
 			rt, err = sr.Resolve(ctx, target)
 			if err != nil {
@@ -303,7 +418,10 @@ func (c *controller) checkTargetResponsibility(ctx context.Context, log logging.
 	}
 	log.Debug("Found target. Checking responsibility")
 	target := &lsv1alpha1.Target{}
-	deployItem.Spec.Target.Namespace = deployItem.Namespace
+	// targets that are imported from other namespaces keep their namespace.
+	if len(deployItem.Spec.Target.Namespace) == 0 {
+		deployItem.Spec.Target.Namespace = deployItem.Namespace
+	}
 	if err := c.lsClient.Get(ctx, deployItem.Spec.Target.NamespacedName(), target); err != nil {
 		return nil, false, fmt.Errorf("unable to get target for deployitem: %w", err)
 	}
@@ -332,10 +450,94 @@ func (c *controller) reconcile(ctx context.Context, lsCtx *lsv1alpha1.Context, d
 		}
 	}
 
+	// fail fast instead of running into opaque errors when a recent health check found the target unreachable.
+	// The error is recoverable, so the deploy item is retried until the target is reachable again.
+	if c.failOnUnhealthyTarget && rt != nil && rt.Target != nil && lsv1alpha1helper.IsTargetUnhealthy(rt.Target, UnhealthyTargetMaxAge) {
+		return lserrors.NewError("Reconcile", "CheckTargetHealth",
+			fmt.Sprintf("target %s/%s is not reachable: %s", rt.Target.Namespace, rt.Target.Name, rt.Target.Status.Message),
+			lsv1alpha1.ErrorTargetUnhealthy)
+	}
+
+	if lsv1alpha1helper.HasOperation(deployItem.ObjectMeta, lsv1alpha1.RollbackOperation) {
+		return c.rollback(ctx, lsCtx, deployItem, rt)
+	}
+
+	// a rolled back deploy item keeps the rolled back revision until its spec changes
+	if rolledBackGeneration, ok := deployItem.Annotations[lsv1alpha1.RolledBackGenerationAnnotation]; ok {
+		if rolledBackGeneration == strconv.FormatInt(deployItem.Generation, 10) {
+			logger, _ := logging.FromContextOrNew(ctx, nil)
+			logger.Info("deploy item not reconciled because it has been rolled back and its spec is unchanged")
+			deployItem.Status.Phase = lsv1alpha1.ExecutionPhaseSucceeded
+			return nil
+		}
+
+		delete(deployItem.Annotations, lsv1alpha1.RolledBackGenerationAnnotation)
+		if err := c.Writer().UpdateDeployItem(ctx, read_write_layer.W000183, deployItem); err != nil {
+			return lserrors.NewWrappedError(err, "Reconcile", "RemoveRolledBackGeneration", err.Error())
+		}
+	}
+
 	err := c.deployer.Reconcile(ctx, lsCtx, deployItem, rt)
 	return lserrors.BuildLsErrorOrNil(err, "reconcile", "Reconcile")
 }
 
+// rollback rolls the deployed state of the deploy item back to the revision of the rollback revision annotation.
+// The rollback operation is removed before the rollback, so a failed rollback is not retried but fails the deploy item.
+// A successful rollback pins the deploy item to the rolled back revision until its generation changes.
+func (c *controller) rollback(ctx context.Context, lsCtx *lsv1alpha1.Context, deployItem *lsv1alpha1.DeployItem,
+	rt *lsv1alpha1.ResolvedTarget) lserrors.LsError {
+	currOp := "Rollback"
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	value := deployItem.Annotations[lsv1alpha1.RollbackRevisionAnnotation]
+	delete(deployItem.Annotations, lsv1alpha1.OperationAnnotation)
+	delete(deployItem.Annotations, lsv1alpha1.RollbackRevisionAnnotation)
+	if err := c.Writer().UpdateDeployItem(ctx, read_write_layer.W000172, deployItem); err != nil {
+		return lserrors.NewWrappedError(err, currOp, "RemoveRollbackOperation", err.Error())
+	}
+
+	lsErr := c.runRollback(ctx, lsCtx, deployItem, rt, value)
+	if lsErr != nil {
+		logger.Info("rollback failed", lc.KeyError, lsErr.Error())
+		deployItem.Status.Phase = lsv1alpha1.ExecutionPhaseFailed
+		return lsErr
+	}
+
+	metav1.SetMetaDataAnnotation(&deployItem.ObjectMeta, lsv1alpha1.RolledBackGenerationAnnotation,
+		strconv.FormatInt(deployItem.Generation, 10))
+	if err := c.Writer().UpdateDeployItem(ctx, read_write_layer.W000182, deployItem); err != nil {
+		return lserrors.NewWrappedError(err, currOp, "SetRolledBackGeneration", err.Error())
+	}
+	return nil
+}
+
+func (c *controller) runRollback(ctx context.Context, lsCtx *lsv1alpha1.Context, deployItem *lsv1alpha1.DeployItem,
+	rt *lsv1alpha1.ResolvedTarget, value string) lserrors.LsError {
+	currOp := "Rollback"
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	rollbacker, ok := c.deployer.(Rollbacker)
+	if !ok {
+		return lserrors.NewError(currOp, "CheckRollbackSupport",
+			fmt.Sprintf("the deployer %q does not support rollbacks", c.info.Name), lsv1alpha1.ErrorConfigurationProblem)
+	}
+
+	if len(value) == 0 {
+		return lserrors.NewError(currOp, "GetRollbackRevision",
+			fmt.Sprintf("the annotation %q is required for a rollback", lsv1alpha1.RollbackRevisionAnnotation),
+			lsv1alpha1.ErrorConfigurationProblem)
+	}
+	revision, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "ParseRollbackRevision",
+			fmt.Sprintf("invalid revision %q: %s", value, err.Error()), lsv1alpha1.ErrorConfigurationProblem)
+	}
+
+	logger.Info("rolling back deploy item", "revision", revision)
+	err = rollbacker.Rollback(ctx, lsCtx, deployItem, rt, revision)
+	return lserrors.BuildLsErrorOrNil(err, currOp, "Rollback")
+}
+
 func (c *controller) delete(ctx context.Context, lsCtx *lsv1alpha1.Context, deployItem *lsv1alpha1.DeployItem,
 	rt *lsv1alpha1.ResolvedTarget) lserrors.LsError {
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
@@ -361,13 +563,15 @@ func (c *controller) Writer() *read_write_layer.Writer {
 	return read_write_layer.NewWriter(c.lsClient)
 }
 
-func (c *controller) updateDiForNewReconcile(ctx context.Context, di *lsv1alpha1.DeployItem) error {
+func (c *controller) updateDiForNewReconcile(ctx context.Context, di *lsv1alpha1.DeployItem, oldPhase lsv1alpha1.DeployItemPhase) error {
 	c.updateDiValuesForNewReconcile(ctx, di)
 
 	if err := c.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000004, di); err != nil {
 		return err
 	}
 
+	metrics.RecordDeployItemPhaseTransition(di, oldPhase)
+	events.RecordDeployItemPhaseTransition(c.lsEventRecorder, di, oldPhase)
 	return nil
 }
 
@@ -391,6 +595,12 @@ func (c *controller) removeTestReconcileAnnotation(ctx context.Context, di *lsv1
 	return nil
 }
 
+// isManagedByExecution returns true if the deploy item is controlled by an execution.
+func isManagedByExecution(di *lsv1alpha1.DeployItem) bool {
+	owner := metav1.GetControllerOf(di)
+	return owner != nil && owner.Kind == "Execution"
+}
+
 // typePredicate is a predicate definition that does only react on deployitem of the specific type.
 type typePredicate struct {
 	Type lsv1alpha1.DeployItemType
@@ -0,0 +1,325 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/deployer/lib/extension"
+	"github.com/gardener/landscaper/pkg/utils"
+)
+
+const testDeployItemType lsv1alpha1.DeployItemType = "landscaper.gardener.cloud/test"
+
+// testDeployer records the resolved targets of the reconciled deploy items.
+// Its reconciliation and drift detection fail with the configured errors.
+type testDeployer struct {
+	reconciledTargets   []*lsv1alpha1.ResolvedTarget
+	rolledBackRevisions []int64
+	reconcileErr        error
+	driftErr            error
+}
+
+func (d *testDeployer) Reconcile(_ context.Context, _ *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
+	d.reconciledTargets = append(d.reconciledTargets, rt)
+	if d.reconcileErr != nil {
+		return d.reconcileErr
+	}
+	di.Status.Phase = lsv1alpha1.ExecutionPhaseSucceeded
+	return nil
+}
+
+func (d *testDeployer) Delete(_ context.Context, _ *lsv1alpha1.Context, _ *lsv1alpha1.DeployItem, _ *lsv1alpha1.ResolvedTarget) error {
+	return nil
+}
+
+func (d *testDeployer) Abort(_ context.Context, _ *lsv1alpha1.Context, _ *lsv1alpha1.DeployItem, _ *lsv1alpha1.ResolvedTarget) error {
+	return nil
+}
+
+func (d *testDeployer) DetectDrift(_ context.Context, _ *lsv1alpha1.Context, _ *lsv1alpha1.DeployItem, _ *lsv1alpha1.ResolvedTarget) (time.Duration, error) {
+	return time.Minute, d.driftErr
+}
+
+func (d *testDeployer) Rollback(_ context.Context, _ *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, _ *lsv1alpha1.ResolvedTarget, revision int64) error {
+	d.rolledBackRevisions = append(d.rolledBackRevisions, revision)
+	di.Status.Phase = lsv1alpha1.ExecutionPhaseSucceeded
+	return nil
+}
+
+func (d *testDeployer) ExtensionHooks() extension.ReconcileExtensionHooks {
+	return nil
+}
+
+var _ = Describe("Controller", func() {
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+		recorder   *record.FakeRecorder
+		deployer   *testDeployer
+		ctrl       *controller
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+		deployer = &testDeployer{}
+		args := DeployerArgs{Type: testDeployItemType, Deployer: deployer}
+		args.Default()
+		recorder = record.NewFakeRecorder(1024)
+		ctrl = NewController(kubeClient, api.LandscaperScheme, recorder, kubeClient, api.LandscaperScheme, args)
+
+		for _, ns := range []string{"tenant", "platform"} {
+			lsCtx := &lsv1alpha1.Context{}
+			lsCtx.Name = lsv1alpha1.DefaultContextName
+			lsCtx.Namespace = ns
+			Expect(kubeClient.Create(ctx, lsCtx)).To(Succeed())
+
+			target, err := utils.NewTargetBuilder("landscaper.gardener.cloud/test").
+				Key(ns, "my-cluster").
+				Config(map[string]string{"namespace": ns}).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kubeClient.Create(ctx, target)).To(Succeed())
+		}
+	})
+
+	createDeployItem := func(target *lsv1alpha1.ObjectReference) *lsv1alpha1.DeployItem {
+		di := &lsv1alpha1.DeployItem{}
+		di.Name = "my-di"
+		di.Namespace = "tenant"
+		di.Spec.Type = testDeployItemType
+		di.Spec.Target = target
+		di.Spec.Context = lsv1alpha1.DefaultContextName
+		Expect(kubeClient.Create(ctx, di)).To(Succeed())
+		di.Status.JobID = "job-1"
+		Expect(kubeClient.Status().Update(ctx, di)).To(Succeed())
+		return di
+	}
+
+	reconcileDeployItem := func(di *lsv1alpha1.DeployItem) {
+		_, err := ctrl.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(di)})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(di), di)).To(Succeed())
+	}
+
+	Context("Targets", func() {
+
+		It("should resolve a target that is imported from another namespace", func() {
+			di := createDeployItem(&lsv1alpha1.ObjectReference{Name: "my-cluster", Namespace: "platform"})
+
+			reconcileDeployItem(di)
+			Expect(di.Status.DeployItemPhase).To(Equal(lsv1alpha1.DeployItemPhaseSucceeded))
+			Expect(deployer.reconciledTargets).To(HaveLen(1))
+			Expect(deployer.reconciledTargets[0].Target.Namespace).To(Equal("platform"))
+			Expect(deployer.reconciledTargets[0].Content).To(ContainSubstring("platform"))
+		})
+
+		It("should resolve a target without namespace in the namespace of the deploy item", func() {
+			di := createDeployItem(&lsv1alpha1.ObjectReference{Name: "my-cluster"})
+
+			reconcileDeployItem(di)
+			Expect(deployer.reconciledTargets).To(HaveLen(1))
+			Expect(deployer.reconciledTargets[0].Target.Namespace).To(Equal("tenant"))
+		})
+	})
+
+	Context("Target Health", func() {
+
+		setTargetHealth := func(reachable bool, checkTime time.Time) {
+			target := &lsv1alpha1.Target{}
+			Expect(kubeClient.Get(ctx, client.ObjectKey{Name: "my-cluster", Namespace: "tenant"}, target)).To(Succeed())
+			target.Status.ObservedGeneration = target.Generation
+			target.Status.LastCheckTime = &metav1.Time{Time: checkTime}
+			target.Status.Reachable = reachable
+			target.Status.Message = "connection refused"
+			Expect(kubeClient.Status().Update(ctx, target)).To(Succeed())
+		}
+
+		It("should ignore the health of the target if the fail fast is not enabled", func() {
+			setTargetHealth(false, time.Now())
+			di := createDeployItem(&lsv1alpha1.ObjectReference{Name: "my-cluster"})
+
+			reconcileDeployItem(di)
+			Expect(di.Status.DeployItemPhase).To(Equal(lsv1alpha1.DeployItemPhaseSucceeded))
+			Expect(deployer.reconciledTargets).To(HaveLen(1))
+		})
+
+		It("should fail with a recoverable error if a recent health check of the target failed", func() {
+			ctrl.failOnUnhealthyTarget = true
+			setTargetHealth(false, time.Now())
+			di := createDeployItem(&lsv1alpha1.ObjectReference{Name: "my-cluster"})
+
+			reconcileDeployItem(di)
+			Expect(deployer.reconciledTargets).To(BeEmpty())
+			Expect(di.Status.DeployItemPhase).To(Equal(lsv1alpha1.DeployItemPhaseProgressing))
+			Expect(di.Status.LastError).ToNot(BeNil())
+			Expect(di.Status.LastError.Codes).To(ContainElement(lsv1alpha1.ErrorTargetUnhealthy))
+			Expect(lserrors.ContainsAnyErrorCode(di.Status.LastError.Codes, lsv1alpha1.UnrecoverableErrorCodes)).To(BeFalse())
+
+			// the deploy item is retried and succeeds once the target is reachable again
+			setTargetHealth(true, time.Now())
+			reconcileDeployItem(di)
+			Expect(di.Status.DeployItemPhase).To(Equal(lsv1alpha1.DeployItemPhaseSucceeded))
+			Expect(deployer.reconciledTargets).To(HaveLen(1))
+		})
+
+		It("should ignore an outdated failed health check of the target", func() {
+			ctrl.failOnUnhealthyTarget = true
+			setTargetHealth(false, time.Now().Add(-2*UnhealthyTargetMaxAge))
+			di := createDeployItem(&lsv1alpha1.ObjectReference{Name: "my-cluster"})
+
+			reconcileDeployItem(di)
+			Expect(di.Status.DeployItemPhase).To(Equal(lsv1alpha1.DeployItemPhaseSucceeded))
+			Expect(deployer.reconciledTargets).To(HaveLen(1))
+		})
+	})
+
+	Context("Events", func() {
+
+		It("should report the error of a failed deploy item only with the event of the failed phase", func() {
+			deployer.reconcileErr = lserrors.NewError("Reconcile", "Deploy", "deploy failed", lsv1alpha1.ErrorConfigurationProblem)
+			di := createDeployItem(&lsv1alpha1.ObjectReference{Name: "my-cluster"})
+
+			reconcileDeployItem(di)
+			Expect(di.Status.DeployItemPhase).To(Equal(lsv1alpha1.DeployItemPhaseFailed))
+			close(recorder.Events)
+			warnings := []string{}
+			for event := range recorder.Events {
+				if strings.HasPrefix(event, corev1.EventTypeWarning) {
+					warnings = append(warnings, event)
+				}
+			}
+			Expect(warnings).To(HaveLen(1))
+			Expect(warnings[0]).To(ContainSubstring("deploy failed"))
+		})
+	})
+
+	Context("Rollback", func() {
+
+		It("should start a job for a rollback and keep the rolled back revision until the spec changes", func() {
+			di := createDeployItem(&lsv1alpha1.ObjectReference{Name: "my-cluster"})
+			reconcileDeployItem(di)
+			Expect(deployer.reconciledTargets).To(HaveLen(1))
+
+			metav1.SetMetaDataAnnotation(&di.ObjectMeta, lsv1alpha1.OperationAnnotation, string(lsv1alpha1.RollbackOperation))
+			metav1.SetMetaDataAnnotation(&di.ObjectMeta, lsv1alpha1.RollbackRevisionAnnotation, "3")
+			Expect(kubeClient.Update(ctx, di)).To(Succeed())
+
+			// the rollback annotation starts a new job
+			reconcileDeployItem(di)
+			Expect(di.Status.GetJobID()).ToNot(Equal(di.Status.JobIDFinished))
+			Expect(di.Status.JobIDGenerationTime).ToNot(BeNil())
+			reconcileDeployItem(di)
+			Expect(deployer.rolledBackRevisions).To(ConsistOf(int64(3)))
+			Expect(di.Status.DeployItemPhase).To(Equal(lsv1alpha1.DeployItemPhaseSucceeded))
+			Expect(di.Annotations).ToNot(HaveKey(lsv1alpha1.OperationAnnotation))
+			Expect(di.Annotations).To(HaveKeyWithValue(lsv1alpha1.RolledBackGenerationAnnotation, strconv.FormatInt(di.Generation, 10)))
+
+			// further jobs keep the rolled back revision
+			di.Status.JobID = "job-2"
+			Expect(kubeClient.Status().Update(ctx, di)).To(Succeed())
+			reconcileDeployItem(di)
+			Expect(di.Status.DeployItemPhase).To(Equal(lsv1alpha1.DeployItemPhaseSucceeded))
+			Expect(deployer.reconciledTargets).To(HaveLen(1))
+
+			// a changed spec is reconciled again
+			di.Generation++
+			Expect(kubeClient.Update(ctx, di)).To(Succeed())
+			di.Status.JobID = "job-3"
+			Expect(kubeClient.Status().Update(ctx, di)).To(Succeed())
+			reconcileDeployItem(di)
+			Expect(di.Status.DeployItemPhase).To(Equal(lsv1alpha1.DeployItemPhaseSucceeded))
+			Expect(deployer.reconciledTargets).To(HaveLen(2))
+			Expect(di.Annotations).ToNot(HaveKey(lsv1alpha1.RolledBackGenerationAnnotation))
+		})
+
+		It("should not start a job for a rollback of a deploy item of an execution", func() {
+			di := createDeployItem(&lsv1alpha1.ObjectReference{Name: "my-cluster"})
+			reconcileDeployItem(di)
+
+			isController := true
+			di.OwnerReferences = []metav1.OwnerReference{{APIVersion: lsv1alpha1.SchemeGroupVersion.String(),
+				Kind: "Execution", Name: "my-exec", UID: "abc", Controller: &isController}}
+			metav1.SetMetaDataAnnotation(&di.ObjectMeta, lsv1alpha1.OperationAnnotation, string(lsv1alpha1.RollbackOperation))
+			metav1.SetMetaDataAnnotation(&di.ObjectMeta, lsv1alpha1.RollbackRevisionAnnotation, "3")
+			Expect(kubeClient.Update(ctx, di)).To(Succeed())
+
+			// the job is started by the execution
+			reconcileDeployItem(di)
+			Expect(di.Status.GetJobID()).To(Equal(di.Status.JobIDFinished))
+			Expect(deployer.rolledBackRevisions).To(BeEmpty())
+
+			di.Status.JobID = "job-2"
+			Expect(kubeClient.Status().Update(ctx, di)).To(Succeed())
+			reconcileDeployItem(di)
+			Expect(deployer.rolledBackRevisions).To(ConsistOf(int64(3)))
+			Expect(di.Status.DeployItemPhase).To(Equal(lsv1alpha1.DeployItemPhaseSucceeded))
+			Expect(di.Annotations).ToNot(HaveKey(lsv1alpha1.OperationAnnotation))
+		})
+	})
+
+	Context("Suspension", func() {
+
+		It("should not start a new job of a suspended deploy item", func() {
+			di := createDeployItem(&lsv1alpha1.ObjectReference{Name: "my-cluster"})
+			lsv1alpha1helper.SetSuspended(&di.ObjectMeta, true)
+			Expect(kubeClient.Update(ctx, di)).To(Succeed())
+
+			reconcileDeployItem(di)
+			Expect(di.Status.DeployItemPhase).To(BeEmpty())
+			Expect(deployer.reconciledTargets).To(BeEmpty())
+
+			// the job is started when the deploy item is resumed
+			lsv1alpha1helper.SetSuspended(&di.ObjectMeta, false)
+			Expect(kubeClient.Update(ctx, di)).To(Succeed())
+			reconcileDeployItem(di)
+			Expect(di.Status.DeployItemPhase).To(Equal(lsv1alpha1.DeployItemPhaseSucceeded))
+			Expect(deployer.reconciledTargets).To(HaveLen(1))
+		})
+	})
+
+	Context("Drift Detection", func() {
+
+		It("should show a failed drift detection in the conditions of the deploy item", func() {
+			di := createDeployItem(&lsv1alpha1.ObjectReference{Name: "my-cluster"})
+			reconcileDeployItem(di)
+			Expect(di.Status.DeployItemPhase).To(Equal(lsv1alpha1.DeployItemPhaseSucceeded))
+
+			deployer.driftErr = errors.New("target not reachable")
+			_, err := ctrl.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(di)})
+			Expect(err).To(HaveOccurred())
+			Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(di), di)).To(Succeed())
+			condition := lsv1alpha1helper.GetCondition(di.Status.Conditions, lsv1alpha1.DriftDetectionCondition)
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Status).To(Equal(lsv1alpha1.ConditionFalse))
+			Expect(condition.Message).To(Equal("target not reachable"))
+			Expect(di.Status.DeployItemPhase).To(Equal(lsv1alpha1.DeployItemPhaseSucceeded))
+
+			deployer.driftErr = nil
+			reconcileDeployItem(di)
+			condition = lsv1alpha1helper.GetCondition(di.Status.Conditions, lsv1alpha1.DriftDetectionCondition)
+			Expect(condition.Status).To(Equal(lsv1alpha1.ConditionTrue))
+		})
+	})
+})
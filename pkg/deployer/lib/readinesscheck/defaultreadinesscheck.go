@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -372,3 +373,48 @@ func CheckReplicationController(rc *corev1.ReplicationController) error {
 	}
 	return nil
 }
+
+func getJobCondition(conditions []batchv1.JobCondition, conditionType batchv1.JobConditionType) *batchv1.JobCondition {
+	for _, condition := range conditions {
+		if condition.Type == conditionType {
+			return &condition
+		}
+	}
+	return nil
+}
+
+// JobFailedError is returned by CheckJob if the given Job has failed.
+type JobFailedError struct {
+	Reason  string
+	Message string
+}
+
+// Error implements the go error interface.
+func (e *JobFailedError) Error() string {
+	return fmt.Sprintf("job failed due to %s: %s", e.Reason, e.Message)
+}
+
+// IsJobFailedError checks whether the given error is a JobFailedError.
+func IsJobFailedError(err error) bool {
+	_, ok := err.(*JobFailedError)
+	return ok
+}
+
+// CheckJob checks whether the given Job is ready.
+// A Job is considered ready if it has the JobComplete condition set to true.
+// A JobFailedError is returned if the Job has the JobFailed condition set to true.
+func CheckJob(job *batchv1.Job) error {
+	if condition := getJobCondition(job.Status.Conditions, batchv1.JobFailed); condition != nil && condition.Status == corev1.ConditionTrue {
+		return &JobFailedError{
+			Reason:  condition.Reason,
+			Message: condition.Message,
+		}
+	}
+
+	condition := getJobCondition(job.Status.Conditions, batchv1.JobComplete)
+	if condition == nil {
+		return fmt.Errorf("job has not completed (%d active, %d succeeded, %d failed)",
+			job.Status.Active, job.Status.Succeeded, job.Status.Failed)
+	}
+	return checkConditionState(string(batchv1.JobComplete), string(corev1.ConditionTrue), string(condition.Status), condition.Reason, condition.Message)
+}
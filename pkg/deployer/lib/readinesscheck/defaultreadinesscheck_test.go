@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -253,4 +254,34 @@ var _ = Describe("Default readiness checks", func() {
 			}, HaveOccurred()),
 		)
 	})
+
+	Describe("CheckJob", func() {
+		DescribeTable("job",
+			func(job *batchv1.Job, matcher types.GomegaMatcher) {
+				err := readinesscheck.CheckJob(job)
+				Expect(err).To(matcher)
+			},
+			Entry("completed", &batchv1.Job{
+				Status: batchv1.JobStatus{Succeeded: 1, Conditions: []batchv1.JobCondition{
+					{
+						Type:   batchv1.JobComplete,
+						Status: corev1.ConditionTrue,
+					},
+				}},
+			}, BeNil()),
+			Entry("running", &batchv1.Job{
+				Status: batchv1.JobStatus{Active: 1},
+			}, HaveOccurred()),
+			Entry("failed", &batchv1.Job{
+				Status: batchv1.JobStatus{Failed: 1, Conditions: []batchv1.JobCondition{
+					{
+						Type:    batchv1.JobFailed,
+						Status:  corev1.ConditionTrue,
+						Reason:  "BackoffLimitExceeded",
+						Message: "Job has reached the specified backoff limit",
+					},
+				}},
+			}, WithTransform(readinesscheck.IsJobFailedError, BeTrue())),
+		)
+	})
 })
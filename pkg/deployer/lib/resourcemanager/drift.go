@@ -0,0 +1,421 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcemanager
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/api"
+)
+
+// DriftDetectorOptions describes the options for the drift detection.
+type DriftDetectorOptions struct {
+	Decoder    runtime.Decoder
+	KubeClient client.Client
+
+	// Manifests are the last applied manifests.
+	Manifests []managedresource.Manifest
+	// ManagedResources are the resources that have been created or updated with the manifests.
+	ManagedResources managedresource.ManagedResourceStatusList
+}
+
+// Drift describes a managed resource that differs from its last applied manifest.
+type Drift struct {
+	managedresource.DriftedResource
+	// Manifest is the last applied manifest of the resource.
+	Manifest managedresource.Manifest
+}
+
+// fieldPath is the path to a field of an object.
+// Index segments of lists are formatted as "[i]".
+type fieldPath []string
+
+func (p fieldPath) String() string {
+	sb := strings.Builder{}
+	for i, seg := range p {
+		if i != 0 && !strings.HasPrefix(seg, "[") {
+			sb.WriteString(".")
+		}
+		sb.WriteString(seg)
+	}
+	return sb.String()
+}
+
+func (p fieldPath) child(seg string) fieldPath {
+	child := make(fieldPath, len(p), len(p)+1)
+	copy(child, p)
+	return append(child, seg)
+}
+
+// DetectDrift compares the live state of the managed resources with their last applied manifests.
+// Only the fields that are defined in the manifests are compared, so that fields that are defaulted by the
+// api server or that are added by other controllers are not reported as drift.
+// The status and all metadata except labels and annotations are ignored.
+func DetectDrift(ctx context.Context, opts DriftDetectorOptions) ([]Drift, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil, lc.KeyMethod, "DetectDrift")
+
+	drifts := make([]Drift, 0)
+	for _, manifest := range opts.Manifests {
+		if manifest.Policy == managedresource.IgnorePolicy || manifest.Manifest == nil {
+			continue
+		}
+		desired := &unstructured.Unstructured{}
+		if _, _, err := opts.Decoder.Decode(manifest.Manifest.Raw, nil, desired); err != nil {
+			return nil, fmt.Errorf("error while decoding manifest: %w", err)
+		}
+
+		ref, ok := findManagedResource(opts.ManagedResources, desired)
+		if !ok {
+			logger.Debug("Manifest has not been applied yet", lc.KeyResource, kutil.ObjectKeyFromObject(desired).String())
+			continue
+		}
+
+		live := kutil.ObjectFromCoreObjectReference(&ref.Resource)
+		if err := opts.KubeClient.Get(ctx, kutil.ObjectKey(ref.Resource.Name, ref.Resource.Namespace), live); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("unable to get object %s %s: %w", live.GroupVersionKind().String(), live.GetName(), err)
+			}
+			drifts = append(drifts, Drift{
+				DriftedResource: managedresource.DriftedResource{
+					Resource: ref.Resource,
+					Missing:  true,
+				},
+				Manifest: manifest,
+			})
+			continue
+		}
+
+		paths := driftedFields(desired, live)
+		if len(paths) == 0 {
+			continue
+		}
+
+		fields := make([]string, len(paths))
+		for i, path := range paths {
+			fields[i] = path.String()
+		}
+		managers, err := fieldManagers(live, paths)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the field managers of object %s %s: %w", live.GroupVersionKind().String(), live.GetName(), err)
+		}
+		logger.Info("Detected drifted resource", lc.KeyResource, kutil.ObjectKeyFromObject(live).String(),
+			lc.KeyResourceKind, live.GetKind(), "fields", fields, "managers", managers)
+		drifts = append(drifts, Drift{
+			DriftedResource: managedresource.DriftedResource{
+				Resource: ref.Resource,
+				Fields:   fields,
+				Managers: managers,
+			},
+			Manifest: manifest,
+		})
+	}
+	return drifts, nil
+}
+
+// CorrectDrift re-applies the last applied manifests of the drifted resources with the given applier options.
+// The managed resources of the options are ignored, so that no other resources are deleted.
+func CorrectDrift(ctx context.Context, opts ManifestApplierOptions, drifts []Drift) error {
+	opts.Manifests = make([]managedresource.Manifest, len(drifts))
+	for i := range drifts {
+		opts.Manifests[i] = drifts[i].Manifest
+	}
+	opts.ManagedResources = managedresource.ManagedResourceStatusList{}
+	if err := NewManifestApplier(opts).Apply(ctx); err != nil {
+		return err
+	}
+	for i := range drifts {
+		drifts[i].Corrected = true
+	}
+	return nil
+}
+
+// AppliedManifestsSecretName returns the name of the secret that stores the last applied manifests of the deploy item.
+func AppliedManifestsSecretName(deployItem *lsv1alpha1.DeployItem) string {
+	return fmt.Sprintf("%s-applied-manifests", deployItem.Name)
+}
+
+// StoreAppliedManifests stores the compressed applied manifests of the deploy item in a secret that is owned by the deploy item.
+// The drift detection compares the managed resources with the stored manifests,
+// so that charts and kustomizations do not have to be fetched again for every drift detection.
+func StoreAppliedManifests(ctx context.Context, kubeClient client.Client, deployItem *lsv1alpha1.DeployItem,
+	manifests []managedresource.Manifest) error {
+	data, err := json.Marshal(manifests)
+	if err != nil {
+		return fmt.Errorf("unable to encode applied manifests: %w", err)
+	}
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	if _, err := writer.Write(data); err != nil {
+		return fmt.Errorf("unable to compress applied manifests: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("unable to compress applied manifests: %w", err)
+	}
+
+	secret := &corev1.Secret{}
+	secret.Name = AppliedManifestsSecretName(deployItem)
+	secret.Namespace = deployItem.Namespace
+	if _, err := controllerutil.CreateOrUpdate(ctx, kubeClient, secret, func() error {
+		secret.Data = map[string][]byte{
+			lsv1alpha1.DataObjectSecretDataKey: compressed.Bytes(),
+		}
+		return controllerutil.SetOwnerReference(deployItem, secret, api.LandscaperScheme)
+	}); err != nil {
+		return fmt.Errorf("unable to store applied manifests: %w", err)
+	}
+	return nil
+}
+
+// LoadAppliedManifests returns the applied manifests that have been stored for the deploy item.
+// Nil is returned if no manifests have been stored yet.
+func LoadAppliedManifests(ctx context.Context, kubeClient client.Client, deployItem *lsv1alpha1.DeployItem) ([]managedresource.Manifest, error) {
+	secret := &corev1.Secret{}
+	if err := kubeClient.Get(ctx, kutil.ObjectKey(AppliedManifestsSecretName(deployItem), deployItem.Namespace), secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to get applied manifests: %w", err)
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(secret.Data[lsv1alpha1.DataObjectSecretDataKey]))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decompress applied manifests: %w", err)
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decompress applied manifests: %w", err)
+	}
+	manifests := []managedresource.Manifest{}
+	if err := json.Unmarshal(data, &manifests); err != nil {
+		return nil, fmt.Errorf("unable to decode applied manifests: %w", err)
+	}
+	return manifests, nil
+}
+
+// NewDriftStatus creates the drift status of a drift detection at the given time.
+func NewDriftStatus(checkTime time.Time, drifts []Drift) *managedresource.DriftStatus {
+	status := &managedresource.DriftStatus{
+		LastCheckTime: metav1.NewTime(checkTime),
+	}
+	for _, drift := range drifts {
+		status.DriftedResources = append(status.DriftedResources, drift.DriftedResource)
+	}
+	return status
+}
+
+// findManagedResource returns the managed resource that has been applied with the given manifest.
+func findManagedResource(managedResources managedresource.ManagedResourceStatusList, obj *unstructured.Unstructured) (managedresource.ManagedResourceStatus, bool) {
+	for _, mr := range managedResources {
+		if mr.Resource.APIVersion == obj.GetAPIVersion() &&
+			mr.Resource.Kind == obj.GetKind() &&
+			mr.Resource.Name == obj.GetName() &&
+			(len(obj.GetNamespace()) == 0 || mr.Resource.Namespace == obj.GetNamespace()) {
+			return mr, true
+		}
+	}
+	return managedresource.ManagedResourceStatus{}, false
+}
+
+// driftedFields returns the paths of all fields of the desired object that differ in the live object.
+func driftedFields(desired, live *unstructured.Unstructured) []fieldPath {
+	paths := make([]fieldPath, 0)
+	for _, key := range sortedKeys(desired.Object) {
+		switch key {
+		case "apiVersion", "kind", "status":
+			continue
+		case "metadata":
+			desiredMeta, _ := desired.Object[key].(map[string]interface{})
+			liveMeta, _ := live.Object[key].(map[string]interface{})
+			for _, metaKey := range []string{"labels", "annotations"} {
+				paths = append(paths, diffValues(fieldPath{key, metaKey}, desiredMeta[metaKey], liveMeta[metaKey])...)
+			}
+		case "stringData":
+			if desired.GetKind() != "Secret" {
+				paths = append(paths, diffValues(fieldPath{key}, desired.Object[key], live.Object[key])...)
+				continue
+			}
+			// the api server merges the string data of secrets into their data
+			stringData, _ := desired.Object[key].(map[string]interface{})
+			liveData, _ := live.Object["data"].(map[string]interface{})
+			for _, dataKey := range sortedKeys(stringData) {
+				value, _ := stringData[dataKey].(string)
+				if liveData[dataKey] != base64.StdEncoding.EncodeToString([]byte(value)) {
+					paths = append(paths, fieldPath{"data", dataKey})
+				}
+			}
+		default:
+			paths = append(paths, diffValues(fieldPath{key}, desired.Object[key], live.Object[key])...)
+		}
+	}
+	return paths
+}
+
+// diffValues compares the desired value with the live value and returns the paths of the differing fields.
+// Maps are compared by the keys of the desired value, lists element by element.
+func diffValues(path fieldPath, desired, live interface{}) []fieldPath {
+	switch d := desired.(type) {
+	case nil:
+		return nil
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			if len(d) == 0 && live == nil {
+				return nil
+			}
+			return []fieldPath{path}
+		}
+		paths := make([]fieldPath, 0)
+		for _, key := range sortedKeys(d) {
+			paths = append(paths, diffValues(path.child(key), d[key], l[key])...)
+		}
+		return paths
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok || len(l) != len(d) {
+			if len(d) == 0 && live == nil {
+				return nil
+			}
+			return []fieldPath{path}
+		}
+		paths := make([]fieldPath, 0)
+		for i := range d {
+			paths = append(paths, diffValues(path.child(fmt.Sprintf("[%d]", i)), d[i], l[i])...)
+		}
+		return paths
+	default:
+		if !equalScalars(desired, live) {
+			return []fieldPath{path}
+		}
+		return nil
+	}
+}
+
+// equalScalars compares two scalar values.
+// Numbers are compared by their value and quantities are compared in their canonical form,
+// as they are normalized by the api server (e.g. "1000m" is stored as "1").
+func equalScalars(desired, live interface{}) bool {
+	if reflect.DeepEqual(desired, live) {
+		return true
+	}
+	if d, ok := toFloat(desired); ok {
+		if l, ok := toFloat(live); ok {
+			return d == l
+		}
+	}
+	d, ok := toQuantity(desired)
+	if !ok {
+		return false
+	}
+	l, ok := toQuantity(live)
+	if !ok {
+		return false
+	}
+	return d.Cmp(l) == 0
+}
+
+func toFloat(val interface{}) (float64, bool) {
+	switch v := val.(type) {
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+func toQuantity(val interface{}) (resource.Quantity, bool) {
+	var str string
+	switch v := val.(type) {
+	case string:
+		str = v
+	case int64:
+		str = strconv.FormatInt(v, 10)
+	case float64:
+		str = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return resource.Quantity{}, false
+	}
+	q, err := resource.ParseQuantity(str)
+	if err != nil {
+		return resource.Quantity{}, false
+	}
+	return q, true
+}
+
+// fieldManagers returns the managers that own the given fields of the object.
+// Fields of list elements are attributed to the managers that own any field of the list.
+func fieldManagers(obj *unstructured.Unstructured, paths []fieldPath) ([]string, error) {
+	managers := sets.NewString()
+	for _, entry := range obj.GetManagedFields() {
+		if entry.FieldsV1 == nil {
+			continue
+		}
+		fields := map[string]interface{}{}
+		if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+			return nil, err
+		}
+		for _, path := range paths {
+			if ownsField(fields, path) {
+				managers.Insert(entry.Manager)
+				break
+			}
+		}
+	}
+	return managers.List(), nil
+}
+
+// ownsField checks whether the managed fields contain the given path.
+func ownsField(fields map[string]interface{}, path fieldPath) bool {
+	current := fields
+	for _, seg := range path {
+		if strings.HasPrefix(seg, "[") {
+			// list elements are identified by keys or values in the managed fields, so the whole list is considered.
+			return len(current) != 0
+		}
+		next, ok := current["f:"+seg].(map[string]interface{})
+		if !ok {
+			return false
+		}
+		current = next
+	}
+	return true
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
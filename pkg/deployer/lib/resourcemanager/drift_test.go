@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcemanager_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/deployer/lib/resourcemanager"
+	"github.com/gardener/landscaper/test/utils/envtest"
+)
+
+var _ = Describe("DriftDetection", func() {
+
+	var (
+		state       *envtest.State
+		ctx         context.Context
+		cm          *corev1.ConfigMap
+		applierOpts resourcemanager.ManifestApplierOptions
+	)
+
+	BeforeEach(func() {
+		var err error
+		ctx = logging.NewContextWithDiscard(context.TODO())
+		state, err = testenv.InitState(ctx)
+		Expect(err).ToNot(HaveOccurred())
+
+		cm = &corev1.ConfigMap{}
+		cm.Name = "my-cm"
+		cm.Namespace = state.Namespace
+		cm.Data = map[string]string{
+			"key": "val",
+		}
+		cmRaw, err := kutil.ConvertToRawExtension(cm, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+
+		applierOpts = resourcemanager.ManifestApplierOptions{
+			Decoder:          api.NewDecoder(scheme.Scheme),
+			KubeClient:       testenv.Client,
+			Clientset:        clientset,
+			DefaultNamespace: state.Namespace,
+			DeleteTimeout:    10 * time.Second,
+			UpdateStrategy:   manifestv1alpha2.UpdateStrategyUpdate,
+			Manifests: []managedresource.Manifest{
+				{
+					Manifest: cmRaw,
+				},
+			},
+			ManagedResources: managedresource.ManagedResourceStatusList{},
+		}
+		applierOpts.ManagedResources, err = resourcemanager.ApplyManifests(ctx, applierOpts)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(state.CleanupState(ctx))
+	})
+
+	detectorOptions := func() resourcemanager.DriftDetectorOptions {
+		return resourcemanager.DriftDetectorOptions{
+			Decoder:          applierOpts.Decoder,
+			KubeClient:       testenv.Client,
+			Manifests:        applierOpts.Manifests,
+			ManagedResources: applierOpts.ManagedResources,
+		}
+	}
+
+	It("should not report resources that only differ in fields that are not defined in the manifest", func() {
+		res := &corev1.ConfigMap{}
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), res)).To(Succeed())
+		res.Data["other"] = "val"
+		Expect(testenv.Client.Update(ctx, res)).To(Succeed())
+
+		drifts, err := resourcemanager.DetectDrift(ctx, detectorOptions())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(drifts).To(BeEmpty())
+	})
+
+	It("should report the drifted fields and their managers and correct them", func() {
+		res := &corev1.ConfigMap{}
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), res)).To(Succeed())
+		res.Data["key"] = "edited"
+		Expect(testenv.Client.Update(ctx, res, client.FieldOwner("sre"))).To(Succeed())
+
+		drifts, err := resourcemanager.DetectDrift(ctx, detectorOptions())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(drifts).To(HaveLen(1))
+		Expect(drifts[0].Resource.Name).To(Equal("my-cm"))
+		Expect(drifts[0].Fields).To(ConsistOf("data.key"))
+		Expect(drifts[0].Managers).To(ConsistOf("sre"))
+
+		Expect(resourcemanager.CorrectDrift(ctx, applierOpts, drifts)).To(Succeed())
+		Expect(drifts[0].Corrected).To(BeTrue())
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), res)).To(Succeed())
+		Expect(res.Data).To(HaveKeyWithValue("key", "val"))
+	})
+
+	It("should report and recreate a deleted resource", func() {
+		Expect(testenv.Client.Delete(ctx, cm)).To(Succeed())
+
+		drifts, err := resourcemanager.DetectDrift(ctx, detectorOptions())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(drifts).To(HaveLen(1))
+		Expect(drifts[0].Missing).To(BeTrue())
+
+		Expect(resourcemanager.CorrectDrift(ctx, applierOpts, drifts)).To(Succeed())
+		res := &corev1.ConfigMap{}
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), res)).To(Succeed())
+		Expect(res.Data).To(HaveKeyWithValue("key", "val"))
+	})
+
+	It("should store and load the applied manifests of a deploy item", func() {
+		di := &lsv1alpha1.DeployItem{}
+		di.Name = "my-di"
+		di.Namespace = state.Namespace
+		di.UID = "1234"
+
+		manifests, err := resourcemanager.LoadAppliedManifests(ctx, testenv.Client, di)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(manifests).To(BeNil())
+
+		Expect(resourcemanager.StoreAppliedManifests(ctx, testenv.Client, di, applierOpts.Manifests)).To(Succeed())
+		manifests, err = resourcemanager.LoadAppliedManifests(ctx, testenv.Client, di)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(manifests).To(HaveLen(1))
+		Expect(manifests[0].Manifest.Raw).To(MatchJSON(applierOpts.Manifests[0].Manifest.Raw))
+
+		secret := &corev1.Secret{}
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKey(resourcemanager.AppliedManifestsSecretName(di), state.Namespace), secret)).To(Succeed())
+		Expect(secret.OwnerReferences).To(HaveLen(1))
+		Expect(secret.OwnerReferences[0].Name).To(Equal("my-di"))
+	})
+})
@@ -28,6 +28,7 @@ import (
 
 	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
@@ -51,9 +52,11 @@ type ManifestApplierOptions struct {
 	Clientset        kubernetes.Interface
 	DefaultNamespace string
 
-	DeployItemName   string
-	DeleteTimeout    time.Duration
-	UpdateStrategy   manifestv1alpha2.UpdateStrategy
+	DeployItemName string
+	DeleteTimeout  time.Duration
+	UpdateStrategy manifestv1alpha2.UpdateStrategy
+	// ServerSideApply configures the server-side apply if the update strategy is "serverSideApply".
+	ServerSideApply  *managedresource.ServerSideApplyConfiguration
 	Manifests        []managedresource.Manifest
 	ManagedResources managedresource.ManagedResourceStatusList
 	// Labels defines additional labels that are automatically injected into all resources.
@@ -69,6 +72,7 @@ type ManifestApplier struct {
 	deployItemName   string
 	deleteTimeout    time.Duration
 	updateStrategy   manifestv1alpha2.UpdateStrategy
+	serverSideApply  *managedresource.ServerSideApplyConfiguration
 	manifests        []managedresource.Manifest
 	managedResources managedresource.ManagedResourceStatusList
 	labels           map[string]string
@@ -84,6 +88,9 @@ type ManifestApplier struct {
 	apiResourceHandler *ApiResourceHandler
 }
 
+// DefaultFieldManager is the field manager that is used for server-side apply if none is configured.
+const DefaultFieldManager = "landscaper"
+
 const (
 	ExecutionGroupCRD = iota
 	ExecutionGroupClusterwide
@@ -110,6 +117,7 @@ func NewManifestApplier(opts ManifestApplierOptions) *ManifestApplier {
 		deployItemName:     opts.DeployItemName,
 		deleteTimeout:      opts.DeleteTimeout,
 		updateStrategy:     opts.UpdateStrategy,
+		serverSideApply:    opts.ServerSideApply,
 		manifests:          opts.Manifests,
 		managedResources:   opts.ManagedResources,
 		labels:             opts.Labels,
@@ -165,9 +173,14 @@ func (a *ManifestApplier) Apply(ctx context.Context) error {
 	}
 
 	if len(allErrs) != 0 {
+		// the error codes of the single errors are lost in the aggregate, therefore they are collected here.
+		codes := []lsv1alpha1.ErrorCode{}
+		for _, err := range allErrs {
+			codes = append(codes, lserrors.CollectErrorCodes(err)...)
+		}
 		aggErr := apimacherrors.NewAggregate(allErrs)
-		return lserrors.NewWrappedError(apimacherrors.NewAggregate(allErrs),
-			"ApplyObjects", "ApplyNewObject", aggErr.Error())
+		return lserrors.NewWrappedError(aggErr,
+			"ApplyObjects", "ApplyNewObject", aggErr.Error(), codes...)
 	}
 
 	// remove old objects
@@ -231,7 +244,11 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 			obj.SetAnnotations(objAnnotations)
 		}
 
-		if err := a.kubeClient.Create(ctx, obj); err != nil {
+		if a.updateStrategy == manifestv1alpha2.UpdateStrategyServerSideApply {
+			if err := a.applyServerSide(ctx, obj); err != nil {
+				return nil, err
+			}
+		} else if err := a.kubeClient.Create(ctx, obj); err != nil {
 			return nil, fmt.Errorf("unable to create resource %s: %w", key.String(), err)
 		}
 		return &managedresource.ManagedResourceStatus{
@@ -303,12 +320,51 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 		if err := a.kubeClient.Update(ctx, &currObj); err != nil {
 			return mr, fmt.Errorf("unable to update resource %s: %w", key.String(), err)
 		}
+	case manifestv1alpha2.UpdateStrategyServerSideApply:
+		// inject manifest specific labels
+		a.injectLabels(obj)
+		kutil.SetMetaDataLabel(obj, manifestv1alpha2.ManagedDeployItemLabel, a.deployItemName)
+
+		if err := a.applyServerSide(ctx, obj); err != nil {
+			return mr, err
+		}
 	default:
 		return mr, fmt.Errorf("%s is not a valid update strategy", a.updateStrategy)
 	}
 	return mr, nil
 }
 
+// applyServerSide applies the object with server-side apply using the configured field manager.
+// Conflicts with other field managers are reported with the error code ERR_SERVER_SIDE_APPLY_CONFLICT.
+func (a *ManifestApplier) applyServerSide(ctx context.Context, obj *unstructured.Unstructured) error {
+	fieldManager := DefaultFieldManager
+	opts := []client.PatchOption{}
+	if a.serverSideApply != nil {
+		if len(a.serverSideApply.FieldManager) != 0 {
+			fieldManager = a.serverSideApply.FieldManager
+		}
+		if a.serverSideApply.ForceConflicts {
+			opts = append(opts, client.ForceOwnership)
+		}
+	}
+	opts = append(opts, client.FieldOwner(fieldManager))
+
+	// the managed fields and the resource version must not be part of an apply request
+	obj.SetManagedFields(nil)
+	obj.SetResourceVersion("")
+
+	key := kutil.ObjectKeyFromObject(obj)
+	if err := a.kubeClient.Patch(ctx, obj, client.Apply, opts...); err != nil {
+		if apierrors.IsConflict(err) {
+			return lserrors.NewWrappedError(err, "ApplyObject", "ServerSideApplyConflict",
+				fmt.Sprintf("unable to apply resource %s as field manager %q: %s", key.String(), fieldManager, err.Error()),
+				lsv1alpha1.ErrorServerSideApplyConflict)
+		}
+		return fmt.Errorf("unable to apply resource %s: %w", key.String(), err)
+	}
+	return nil
+}
+
 func (a *ManifestApplier) injectLabels(obj client.Object) {
 	if len(a.labels) == 0 {
 		return
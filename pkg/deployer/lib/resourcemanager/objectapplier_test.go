@@ -15,8 +15,10 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
 	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	lserrors "github.com/gardener/landscaper/apis/errors"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/api"
@@ -711,4 +713,115 @@ var _ = Describe("ObjectApplier", func() {
 		Expect(cmRead.Data).To(HaveKeyWithValue("addedKey", "val1"))
 		Expect(cmRead.Annotations).To(HaveKeyWithValue("modified", "True"))
 	})
+
+	It("should apply a configmap server-side and keep the fields of other field managers", func() {
+		cm := &corev1.ConfigMap{}
+		cm.Name = "my-cm"
+		cm.Namespace = state.Namespace
+		cm.Data = map[string]string{
+			"key": "val",
+		}
+		cmRaw, err := kutil.ConvertToRawExtension(cm, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+
+		opts := resourcemanager.ManifestApplierOptions{
+			Decoder:          api.NewDecoder(scheme.Scheme),
+			KubeClient:       testenv.Client,
+			Clientset:        clientset,
+			DefaultNamespace: state.Namespace,
+			DeleteTimeout:    10 * time.Second,
+			UpdateStrategy:   manifestv1alpha2.UpdateStrategyServerSideApply,
+			Manifests: []managedresource.Manifest{
+				{
+					Manifest: cmRaw,
+				},
+			},
+			ManagedResources: managedresource.ManagedResourceStatusList{},
+		}
+		managedResources, err := resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+
+		// another field manager adds a field
+		other := &corev1.ConfigMap{}
+		other.APIVersion = "v1"
+		other.Kind = "ConfigMap"
+		other.Name = cm.Name
+		other.Namespace = cm.Namespace
+		other.Data = map[string]string{
+			"other": "val",
+		}
+		Expect(testenv.Client.Patch(ctx, other, client.Apply, client.FieldOwner("other"))).To(Succeed())
+
+		cm.Data["key"] = "modified"
+		cmRaw, err = kutil.ConvertToRawExtension(cm, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+		opts.Manifests = []managedresource.Manifest{
+			{
+				Manifest: cmRaw,
+			},
+		}
+		opts.ManagedResources = managedResources
+		_, err = resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+
+		res := &corev1.ConfigMap{}
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), res)).To(Succeed())
+		Expect(res.Data).To(HaveKeyWithValue("key", "modified"))
+		Expect(res.Data).To(HaveKeyWithValue("other", "val"))
+	})
+
+	It("should report a conflict with another field manager and force the ownership if configured", func() {
+		cm := &corev1.ConfigMap{}
+		cm.Name = "my-cm"
+		cm.Namespace = state.Namespace
+		cm.Data = map[string]string{
+			"key": "val",
+		}
+		cmRaw, err := kutil.ConvertToRawExtension(cm, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+
+		opts := resourcemanager.ManifestApplierOptions{
+			Decoder:          api.NewDecoder(scheme.Scheme),
+			KubeClient:       testenv.Client,
+			Clientset:        clientset,
+			DefaultNamespace: state.Namespace,
+			DeleteTimeout:    10 * time.Second,
+			UpdateStrategy:   manifestv1alpha2.UpdateStrategyServerSideApply,
+			ServerSideApply: &managedresource.ServerSideApplyConfiguration{
+				FieldManager: "my-manager",
+			},
+			Manifests: []managedresource.Manifest{
+				{
+					Manifest: cmRaw,
+				},
+			},
+			ManagedResources: managedresource.ManagedResourceStatusList{},
+		}
+		managedResources, err := resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+		opts.ManagedResources = managedResources
+
+		// another field manager takes over the field
+		other := &corev1.ConfigMap{}
+		other.APIVersion = "v1"
+		other.Kind = "ConfigMap"
+		other.Name = cm.Name
+		other.Namespace = cm.Namespace
+		other.Data = map[string]string{
+			"key": "foreign",
+		}
+		Expect(testenv.Client.Patch(ctx, other, client.Apply, client.FieldOwner("other"), client.ForceOwnership)).To(Succeed())
+
+		_, err = resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).To(HaveOccurred())
+		Expect(lserrors.ContainsErrorCode(err, lsv1alpha1.ErrorServerSideApplyConflict)).To(BeTrue())
+
+		opts.ServerSideApply.ForceConflicts = true
+		_, err = resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+
+		res := &corev1.ConfigMap{}
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), res)).To(Succeed())
+		Expect(res.Data).To(HaveKeyWithValue("key", "val"))
+	})
 })
@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tokenrequest
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Token Cache", func() {
+
+	It("should evict expired tokens", func() {
+		now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+		resolver := New(nil).WithClock(func() time.Time { return now })
+		resolver.tokens["expired"] = cachedToken{token: "a", refreshAfter: now.Add(-time.Hour), expiration: now}
+		resolver.tokens["valid"] = cachedToken{token: "b", refreshAfter: now.Add(-time.Minute), expiration: now.Add(time.Minute)}
+
+		resolver.evictExpiredTokens()
+		Expect(resolver.tokens).To(HaveLen(1))
+		Expect(resolver.tokens).To(HaveKey("valid"))
+	})
+
+})
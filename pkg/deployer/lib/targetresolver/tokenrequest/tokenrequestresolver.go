@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tokenrequest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/utils/pointer"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	. "github.com/gardener/landscaper/pkg/deployer/lib/targetresolver"
+)
+
+var _ TargetResolver = &TokenRequestResolver{}
+
+// TokenRequestResolver resolves kubernetes cluster token request targets.
+// It requests short-lived tokens for the service account of the target with the TokenRequest API
+// and resolves the target to a kubernetes cluster target config with a kubeconfig that contains the token.
+// Tokens are only requested for service accounts that allow the target with the AllowedTokenRequestTargetsAnnotation.
+// Tokens are cached and reused until half of their duration of validity has passed.
+type TokenRequestResolver struct {
+	serviceAccounts corev1client.ServiceAccountsGetter
+	now             func() time.Time
+
+	mux    sync.Mutex
+	tokens map[string]cachedToken
+	// requests deduplicates concurrent token requests for the same cache key.
+	requests singleflight.Group
+}
+
+// cachedToken is a requested token together with the time after which a new token is requested
+// and the time after which the token is removed from the cache.
+type cachedToken struct {
+	token        string
+	refreshAfter time.Time
+	expiration   time.Time
+}
+
+// New creates a new token request resolver that requests tokens for service accounts of the given client.
+func New(serviceAccounts corev1client.ServiceAccountsGetter) *TokenRequestResolver {
+	return &TokenRequestResolver{
+		serviceAccounts: serviceAccounts,
+		now:             time.Now,
+		tokens:          map[string]cachedToken{},
+	}
+}
+
+// WithClock sets the function that returns the current time which is used to expire cached tokens.
+func (r *TokenRequestResolver) WithClock(now func() time.Time) *TokenRequestResolver {
+	r.now = now
+	return r
+}
+
+func (r *TokenRequestResolver) Resolve(ctx context.Context, target *lsv1alpha1.Target) (*lsv1alpha1.ResolvedTarget, error) {
+	if target.Spec.Configuration == nil {
+		return nil, errors.New("target does not define a configuration")
+	}
+	config := &targettypes.KubernetesClusterTokenRequestTargetConfig{}
+	if err := json.Unmarshal(target.Spec.Configuration.RawMessage, config); err != nil {
+		return nil, fmt.Errorf("unable to parse token request target configuration: %w", err)
+	}
+	if len(config.Server) == 0 {
+		return nil, errors.New("no server defined in token request target configuration")
+	}
+	if len(config.ServiceAccount.Name) == 0 {
+		return nil, errors.New("no service account defined in token request target configuration")
+	}
+
+	if err := r.checkTokenRequestAllowed(ctx, target, config.ServiceAccount.Name); err != nil {
+		return nil, err
+	}
+	token, err := r.getToken(ctx, target.Namespace, config)
+	if err != nil {
+		return nil, err
+	}
+	kubeconfig, err := BuildKubeconfig(config, token)
+	if err != nil {
+		return nil, err
+	}
+	content, err := json.Marshal(targettypes.KubernetesClusterTargetConfig{
+		Kubeconfig: targettypes.ValueRef{
+			StrVal: pointer.String(string(kubeconfig)),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal kubernetes cluster target configuration: %w", err)
+	}
+
+	return &lsv1alpha1.ResolvedTarget{
+		Target:  target,
+		Content: string(content),
+	}, nil
+}
+
+// checkTokenRequestAllowed checks that the service account allows token requests for the target.
+// The service account is checked for every resolution, so that a revoked permission also prevents the use of cached tokens.
+func (r *TokenRequestResolver) checkTokenRequestAllowed(ctx context.Context, target *lsv1alpha1.Target, serviceAccountName string) error {
+	sa, err := r.serviceAccounts.ServiceAccounts(target.Namespace).Get(ctx, serviceAccountName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("unable to get service account %s/%s: %w", target.Namespace, serviceAccountName, err)
+	}
+	for _, name := range strings.Split(sa.Annotations[targettypes.AllowedTokenRequestTargetsAnnotation], ",") {
+		if strings.TrimSpace(name) == target.Name {
+			return nil
+		}
+	}
+	return fmt.Errorf("service account %s/%s does not allow token requests for target %s: the target has to be listed in the annotation %s",
+		target.Namespace, serviceAccountName, target.Name, targettypes.AllowedTokenRequestTargetsAnnotation)
+}
+
+// getToken returns a cached token for the service account or requests a new one.
+func (r *TokenRequestResolver) getToken(ctx context.Context, namespace string, config *targettypes.KubernetesClusterTokenRequestTargetConfig) (string, error) {
+	expirationSeconds := targettypes.DefaultTokenExpirationSeconds
+	if config.ExpirationSeconds != nil {
+		expirationSeconds = *config.ExpirationSeconds
+	}
+	key := fmt.Sprintf("%s/%s/%s/%d", namespace, config.ServiceAccount.Name, strings.Join(config.Audiences, ","), expirationSeconds)
+
+	r.mux.Lock()
+	r.evictExpiredTokens()
+	cached, ok := r.tokens[key]
+	r.mux.Unlock()
+	if ok && r.now().Before(cached.refreshAfter) {
+		return cached.token, nil
+	}
+
+	// the lock of the cache is not held during the token request, concurrent requests for the same key share one token request.
+	token, err, _ := r.requests.Do(key, func() (interface{}, error) {
+		return r.requestToken(ctx, key, namespace, config.ServiceAccount.Name, config.Audiences, expirationSeconds)
+	})
+	if err != nil {
+		return "", err
+	}
+	return token.(string), nil
+}
+
+// requestToken requests a new token for the service account and stores it in the cache.
+func (r *TokenRequestResolver) requestToken(ctx context.Context, key, namespace, serviceAccountName string,
+	audiences []string, expirationSeconds int64) (string, error) {
+	requestTime := r.now()
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			Audiences:         audiences,
+			ExpirationSeconds: pointer.Int64(expirationSeconds),
+		},
+	}
+	tokenRequest, err := r.serviceAccounts.ServiceAccounts(namespace).CreateToken(ctx, serviceAccountName, tokenRequest, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("unable to request token for service account %s/%s: %w", namespace, serviceAccountName, err)
+	}
+
+	// the api server may issue tokens with a different expiration than requested.
+	expiration := tokenRequest.Status.ExpirationTimestamp.Time
+	if expiration.IsZero() {
+		expiration = requestTime.Add(time.Duration(expirationSeconds) * time.Second)
+	}
+
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.tokens[key] = cachedToken{
+		token:        tokenRequest.Status.Token,
+		refreshAfter: requestTime.Add(expiration.Sub(requestTime) / 2),
+		expiration:   expiration,
+	}
+	return tokenRequest.Status.Token, nil
+}
+
+// evictExpiredTokens removes the expired tokens from the cache, e.g. the tokens of deleted targets.
+// The caller has to hold the lock of the cache.
+func (r *TokenRequestResolver) evictExpiredTokens() {
+	now := r.now()
+	for key, cached := range r.tokens {
+		if !now.Before(cached.expiration) {
+			delete(r.tokens, key)
+		}
+	}
+}
+
+// BuildKubeconfig creates a kubeconfig that accesses the cluster of the token request target config with the given token.
+func BuildKubeconfig(config *targettypes.KubernetesClusterTokenRequestTargetConfig, token string) ([]byte, error) {
+	const name = "target"
+	kubeconfig := clientcmdapi.NewConfig()
+	kubeconfig.Clusters[name] = &clientcmdapi.Cluster{
+		Server:                   config.Server,
+		CertificateAuthorityData: config.CAData,
+	}
+	kubeconfig.AuthInfos[name] = &clientcmdapi.AuthInfo{
+		Token: token,
+	}
+	kubeconfig.Contexts[name] = &clientcmdapi.Context{
+		Cluster:  name,
+		AuthInfo: name,
+	}
+	kubeconfig.CurrentContext = name
+
+	data, err := clientcmd.Write(*kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to write kubeconfig: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,198 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tokenrequest_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	"github.com/gardener/landscaper/pkg/deployer/lib/targetresolver/tokenrequest"
+	testutils "github.com/gardener/landscaper/test/utils"
+)
+
+// fakeServiceAccounts issues consecutively numbered tokens and records the token requests.
+// All service accounts allow the targets of the allowed targets annotation.
+// Token requests wait until the block channel is closed, if it is set.
+type fakeServiceAccounts struct {
+	corev1client.ServiceAccountInterface
+	namespace      string
+	allowedTargets string
+	requests       *[]string
+	block          chan struct{}
+}
+
+func (f fakeServiceAccounts) Get(_ context.Context, name string, _ metav1.GetOptions) (*corev1.ServiceAccount, error) {
+	sa := &corev1.ServiceAccount{}
+	sa.Name = name
+	sa.Namespace = f.namespace
+	if len(f.allowedTargets) != 0 {
+		sa.Annotations = map[string]string{targettypes.AllowedTokenRequestTargetsAnnotation: f.allowedTargets}
+	}
+	return sa, nil
+}
+
+func (f fakeServiceAccounts) CreateToken(_ context.Context, name string, req *authenticationv1.TokenRequest, _ metav1.CreateOptions) (*authenticationv1.TokenRequest, error) {
+	if f.block != nil {
+		<-f.block
+	}
+	*f.requests = append(*f.requests, fmt.Sprintf("%s/%s", f.namespace, name))
+	res := req.DeepCopy()
+	res.Status.Token = fmt.Sprintf("token-%d", len(*f.requests))
+	return res, nil
+}
+
+type fakeServiceAccountsGetter struct {
+	allowedTargets string
+	requests       []string
+	block          chan struct{}
+}
+
+func (f *fakeServiceAccountsGetter) ServiceAccounts(namespace string) corev1client.ServiceAccountInterface {
+	return fakeServiceAccounts{namespace: namespace, allowedTargets: f.allowedTargets, requests: &f.requests, block: f.block}
+}
+
+var _ = Describe("TokenRequestResolver", func() {
+
+	var (
+		ctx      context.Context
+		now      time.Time
+		getter   *fakeServiceAccountsGetter
+		resolver *tokenrequest.TokenRequestResolver
+		target   *lsv1alpha1.Target
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		now = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+		getter = &fakeServiceAccountsGetter{allowedTargets: "other-target, my-target"}
+		resolver = tokenrequest.New(getter).WithClock(func() time.Time { return now })
+
+		target = &lsv1alpha1.Target{}
+		target.Name = "my-target"
+		target.Namespace = "default"
+		target.Spec.Type = targettypes.KubernetesClusterTokenRequestTargetType
+		target.Spec.Configuration = lsv1alpha1.NewAnyJSONPointer([]byte(`{
+  "server": "https://api.example.com",
+  "caData": "Y2EtZGF0YQ==",
+  "serviceAccount": {"name": "deployer"},
+  "audiences": ["example"]
+}`))
+	})
+
+	getKubeconfig := func(rt *lsv1alpha1.ResolvedTarget) []byte {
+		config := &targettypes.KubernetesClusterTargetConfig{}
+		testutils.ExpectNoError(yaml.Unmarshal([]byte(rt.Content), config))
+		Expect(config.Kubeconfig.StrVal).ToNot(BeNil())
+		return []byte(*config.Kubeconfig.StrVal)
+	}
+
+	It("should resolve the target to a kubeconfig with a requested token", func() {
+		rt, err := resolver.Resolve(ctx, target)
+		testutils.ExpectNoError(err)
+		Expect(rt.Target).To(Equal(target))
+		Expect(getter.requests).To(Equal([]string{"default/deployer"}))
+
+		kubeconfig, err := clientcmd.Load(getKubeconfig(rt))
+		testutils.ExpectNoError(err)
+		restConfig, err := clientcmd.NewDefaultClientConfig(*kubeconfig, nil).ClientConfig()
+		testutils.ExpectNoError(err)
+		Expect(restConfig.Host).To(Equal("https://api.example.com"))
+		Expect(restConfig.CAData).To(Equal([]byte("ca-data")))
+		Expect(restConfig.BearerToken).To(Equal("token-1"))
+	})
+
+	It("should reuse a token until half of its validity has passed", func() {
+		_, err := resolver.Resolve(ctx, target)
+		testutils.ExpectNoError(err)
+
+		now = now.Add(29 * time.Minute)
+		rt, err := resolver.Resolve(ctx, target)
+		testutils.ExpectNoError(err)
+		Expect(getter.requests).To(HaveLen(1))
+		Expect(string(getKubeconfig(rt))).To(ContainSubstring("token-1"))
+
+		now = now.Add(2 * time.Minute)
+		rt, err = resolver.Resolve(ctx, target)
+		testutils.ExpectNoError(err)
+		Expect(getter.requests).To(HaveLen(2))
+		Expect(string(getKubeconfig(rt))).To(ContainSubstring("token-2"))
+	})
+
+	It("should request separate tokens for service accounts of different namespaces", func() {
+		_, err := resolver.Resolve(ctx, target)
+		testutils.ExpectNoError(err)
+
+		target.Namespace = "other"
+		_, err = resolver.Resolve(ctx, target)
+		testutils.ExpectNoError(err)
+		Expect(getter.requests).To(Equal([]string{"default/deployer", "other/deployer"}))
+	})
+
+	It("should not block the resolution of cached tokens while a token is requested", func() {
+		otherTarget := target.DeepCopy()
+		otherTarget.Namespace = "other"
+		_, err := resolver.Resolve(ctx, otherTarget)
+		testutils.ExpectNoError(err)
+
+		getter.block = make(chan struct{})
+		results := make(chan string, 2)
+		for i := 0; i < 2; i++ {
+			go func() {
+				defer GinkgoRecover()
+				rt, err := resolver.Resolve(ctx, target)
+				testutils.ExpectNoError(err)
+				results <- string(getKubeconfig(rt))
+			}()
+		}
+
+		done := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			defer close(done)
+			rt, err := resolver.Resolve(ctx, otherTarget)
+			testutils.ExpectNoError(err)
+			Expect(string(getKubeconfig(rt))).To(ContainSubstring("token-1"))
+		}()
+		Eventually(done).Should(BeClosed())
+
+		// concurrent resolutions of the same target share one token request
+		close(getter.block)
+		Expect(<-results).To(ContainSubstring("token-2"))
+		Expect(<-results).To(ContainSubstring("token-2"))
+		Expect(getter.requests).To(Equal([]string{"other/deployer", "default/deployer"}))
+	})
+
+	It("should fail if no service account is defined", func() {
+		target.Spec.Configuration = lsv1alpha1.NewAnyJSONPointer([]byte(`{"server": "https://api.example.com"}`))
+		_, err := resolver.Resolve(ctx, target)
+		Expect(err).To(HaveOccurred())
+		Expect(getter.requests).To(BeEmpty())
+	})
+
+	It("should fail if the service account does not allow the target", func() {
+		getter.allowedTargets = ""
+		_, err := resolver.Resolve(ctx, target)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(targettypes.AllowedTokenRequestTargetsAnnotation))
+
+		getter.allowedTargets = "my-target-2"
+		_, err = resolver.Resolve(ctx, target)
+		Expect(err).To(HaveOccurred())
+		Expect(getter.requests).To(BeEmpty())
+	})
+
+})
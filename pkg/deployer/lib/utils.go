@@ -18,6 +18,8 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 
+	"github.com/gardener/landscaper/pkg/events"
+	"github.com/gardener/landscaper/pkg/metrics"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 
 	corev1 "k8s.io/api/core/v1"
@@ -146,15 +148,12 @@ func HandleReconcileResult(ctx context.Context, err lserrors.LsError, oldDeployI
 	lsClient client.Client, lsEventRecorder record.EventRecorder) error {
 
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
+	oldPhase := deployItem.Status.DeployItemPhase
 	lsutil.SetLastError(&deployItem.Status, lserrors.TryUpdateLsError(deployItem.Status.GetLastError(), err))
 
-	if deployItem.Status.GetLastError() != nil {
-		if lserrors.ContainsAnyErrorCode(deployItem.Status.GetLastError().Codes, lsv1alpha1.UnrecoverableErrorCodes) {
-			deployItem.Status.Phase = lsv1alpha1.ExecutionPhaseFailed
-		}
-
-		lastErr := deployItem.Status.GetLastError()
-		lsEventRecorder.Event(deployItem, corev1.EventTypeWarning, lastErr.Reason, lastErr.Message)
+	if deployItem.Status.GetLastError() != nil &&
+		lserrors.ContainsAnyErrorCode(deployItem.Status.GetLastError().Codes, lsv1alpha1.UnrecoverableErrorCodes) {
+		deployItem.Status.Phase = lsv1alpha1.ExecutionPhaseFailed
 	}
 
 	if deployItem.Status.Phase == lsv1alpha1.ExecutionPhaseFailed {
@@ -163,6 +162,11 @@ func HandleReconcileResult(ctx context.Context, err lserrors.LsError, oldDeployI
 		deployItem.Status.DeployItemPhase = lsv1alpha1.DeployItemPhaseSucceeded
 	}
 
+	// the error of a phase transition is already contained in the event of the new phase
+	if lastErr := deployItem.Status.GetLastError(); lastErr != nil && deployItem.Status.DeployItemPhase == oldPhase {
+		lsEventRecorder.Event(deployItem, corev1.EventTypeWarning, lastErr.Reason, lastErr.Message)
+	}
+
 	if deployItem.Status.DeployItemPhase == lsv1alpha1.DeployItemPhaseSucceeded ||
 		deployItem.Status.DeployItemPhase == lsv1alpha1.DeployItemPhaseFailed {
 		deployItem.Status.JobIDFinished = deployItem.Status.GetJobID()
@@ -187,7 +191,10 @@ func HandleReconcileResult(ctx context.Context, err lserrors.LsError, oldDeployI
 			if err == nil {
 				return err2
 			}
+			return err
 		}
+		metrics.RecordDeployItemPhaseTransition(deployItem, oldPhase)
+		events.RecordDeployItemPhaseTransition(lsEventRecorder, deployItem, oldPhase)
 	}
 
 	return err
@@ -45,11 +45,12 @@ type deployer struct {
 	hooks      extension.ReconcileExtensionHooks
 }
 
-func (d *deployer) Reconcile(ctx context.Context, _ *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
+func (d *deployer) Reconcile(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
 	manifest, err := New(d.lsClient, d.hostClient, &d.config, di, rt)
 	if err != nil {
 		return err
 	}
+	manifest.Context = lsCtx
 	return manifest.Reconcile(ctx)
 }
 
@@ -66,6 +67,15 @@ func (d *deployer) Abort(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv
 	return nil
 }
 
+func (d *deployer) DetectDrift(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) (time.Duration, error) {
+	manifest, err := New(d.lsClient, d.hostClient, &d.config, di, rt)
+	if err != nil {
+		return 0, err
+	}
+	manifest.Context = lsCtx
+	return manifest.DetectDrift(ctx)
+}
+
 func (d *deployer) ExtensionHooks() extension.ReconcileExtensionHooks {
 	return d.hooks
 }
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/deployer/lib/resourcemanager"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// DetectDrift compares the managed resources with the manifests of the last successful apply if drift detection is configured.
+// The result is written to the provider status of the deploy item.
+// It returns the duration after which the next drift detection is due.
+func (m *Manifest) DetectDrift(ctx context.Context) (time.Duration, error) {
+	currOp := "DetectDrift"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+
+	spec := m.ProviderConfiguration.DriftDetection
+	if spec == nil || spec.Every == nil || m.ProviderStatus == nil {
+		return 0, nil
+	}
+	if m.DeployItem.GetGeneration() != m.DeployItem.Status.ObservedGeneration {
+		// the manifests have changed and are not applied yet
+		return 0, nil
+	}
+
+	now := time.Now()
+	if m.ProviderStatus.Drift != nil {
+		if next := m.ProviderStatus.Drift.LastCheckTime.Add(spec.Every.Duration); next.After(now) {
+			return next.Sub(now), nil
+		}
+	}
+
+	_, targetClient, targetClientSet, err := m.TargetClient(ctx)
+	if err != nil {
+		return 0, lserrors.NewWrappedError(err, currOp, "TargetClusterClient", err.Error())
+	}
+
+	manifests, err := resourcemanager.LoadAppliedManifests(ctx, m.lsKubeClient, m.DeployItem)
+	if err != nil {
+		return 0, lserrors.NewWrappedError(err, currOp, "LoadAppliedManifests", err.Error())
+	}
+	if manifests == nil {
+		logger.Info("No applied manifests stored: the drift is detected after the next reconcile")
+		return spec.Every.Duration, nil
+	}
+
+	drifts, err := resourcemanager.DetectDrift(ctx, resourcemanager.DriftDetectorOptions{
+		Decoder:          serializer.NewCodecFactory(Scheme).UniversalDecoder(),
+		KubeClient:       targetClient,
+		Manifests:        manifests,
+		ManagedResources: m.ProviderStatus.ManagedResources,
+	})
+	if err != nil {
+		return 0, lserrors.NewWrappedError(err, currOp, "DetectDrift", err.Error())
+	}
+
+	// drift is not corrected while the installation of the deploy item is suspended
+	if len(drifts) != 0 && spec.Policy == managedresource.DriftDetectionPolicyAutoCorrect && !lsv1alpha1helper.IsSuspended(m.DeployItem.ObjectMeta) {
+		logger.Info("Correcting drifted resources", "count", len(drifts))
+		if err := resourcemanager.CorrectDrift(ctx, m.applierOptions(targetClient, targetClientSet), drifts); err != nil {
+			return 0, err
+		}
+	}
+
+	m.ProviderStatus.Drift = resourcemanager.NewDriftStatus(now, drifts)
+	m.DeployItem.Status.ProviderStatus, err = kutil.ConvertToRawExtension(m.ProviderStatus, Scheme)
+	if err != nil {
+		return 0, lserrors.NewWrappedError(err, currOp, "ProviderStatus", err.Error())
+	}
+	if err := m.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000170, m.DeployItem); err != nil {
+		return 0, lserrors.NewWrappedError(err, currOp, "UpdateStatus", err.Error())
+	}
+	return spec.Every.Duration, nil
+}
@@ -13,6 +13,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
@@ -29,6 +30,24 @@ import (
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
+// applierOptions returns the options to apply the manifests of the deploy item to the target cluster.
+func (m *Manifest) applierOptions(targetClient client.Client, targetClientSet kubernetes.Interface) resourcemanager.ManifestApplierOptions {
+	return resourcemanager.ManifestApplierOptions{
+		Decoder:          serializer.NewCodecFactory(Scheme).UniversalDecoder(),
+		KubeClient:       targetClient,
+		Clientset:        targetClientSet,
+		DeployItemName:   m.DeployItem.Name,
+		DeleteTimeout:    m.ProviderConfiguration.DeleteTimeout.Duration,
+		UpdateStrategy:   m.ProviderConfiguration.UpdateStrategy,
+		ServerSideApply:  m.ProviderConfiguration.ServerSideApply,
+		Manifests:        m.ProviderConfiguration.Manifests,
+		ManagedResources: m.ProviderStatus.ManagedResources,
+		Labels: map[string]string{
+			manifestv1alpha2.ManagedDeployItemLabel: m.DeployItem.Name,
+		},
+	}
+}
+
 func (m *Manifest) Reconcile(ctx context.Context) error {
 	currOp := "ReconcileManifests"
 	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
@@ -50,20 +69,14 @@ func (m *Manifest) Reconcile(ctx context.Context) error {
 			ManagedResources: make([]managedresource.ManagedResourceStatus, 0),
 		}
 	}
+	// the drift of the previously applied manifests is outdated
+	m.ProviderStatus.Drift = nil
 
-	applier := resourcemanager.NewManifestApplier(resourcemanager.ManifestApplierOptions{
-		Decoder:          serializer.NewCodecFactory(Scheme).UniversalDecoder(),
-		KubeClient:       targetClient,
-		Clientset:        targetClientSet,
-		DeployItemName:   m.DeployItem.Name,
-		DeleteTimeout:    m.ProviderConfiguration.DeleteTimeout.Duration,
-		UpdateStrategy:   m.ProviderConfiguration.UpdateStrategy,
-		Manifests:        m.ProviderConfiguration.Manifests,
-		ManagedResources: m.ProviderStatus.ManagedResources,
-		Labels: map[string]string{
-			manifestv1alpha2.ManagedDeployItemLabel: m.DeployItem.Name,
-		},
-	})
+	if err := m.buildKustomization(ctx); err != nil {
+		return err
+	}
+
+	applier := resourcemanager.NewManifestApplier(m.applierOptions(targetClient, targetClientSet))
 
 	err = applier.Apply(ctx)
 	m.ProviderStatus.ManagedResources = applier.GetManagedResourcesStatus()
@@ -76,6 +89,13 @@ func (m *Manifest) Reconcile(ctx context.Context) error {
 		return err
 	}
 
+	if m.ProviderConfiguration.DriftDetection != nil {
+		if err := resourcemanager.StoreAppliedManifests(ctx, m.lsKubeClient, m.DeployItem, m.ProviderConfiguration.Manifests); err != nil {
+			return lserrors.NewWrappedError(err,
+				currOp, "StoreAppliedManifests", err.Error())
+		}
+	}
+
 	m.DeployItem.Status.ProviderStatus, err = kutil.ConvertToRawExtension(m.ProviderStatus, Scheme)
 	if err != nil {
 		return lserrors.NewWrappedError(err,
@@ -0,0 +1,302 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/gardener/component-cli/ociclient"
+	"github.com/gardener/component-cli/ociclient/credentials"
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/kustomize/api/konfig"
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/deployer/lib"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	componentsregistry "github.com/gardener/landscaper/pkg/landscaper/registry/components"
+	"github.com/gardener/landscaper/pkg/utils"
+)
+
+// buildKustomization builds the kustomization of the provider configuration if one is defined
+// and adds the resulting resources to the manifests of the provider configuration.
+func (m *Manifest) buildKustomization(ctx context.Context) error {
+	currOp := "BuildKustomization"
+	source := m.ProviderConfiguration.Kustomize
+	if source == nil {
+		return nil
+	}
+
+	files := source.Files
+	if source.FromResource != nil {
+		var err error
+		files, err = m.fetchKustomizationFiles(ctx, source.FromResource)
+		if err != nil {
+			return lserrors.NewWrappedError(err, currOp, "FetchKustomization", err.Error())
+		}
+	}
+
+	manifests, err := BuildKustomization(files, source.Path, source.Policy)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "BuildKustomization", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+	m.ProviderConfiguration.Manifests = append(m.ProviderConfiguration.Manifests, manifests...)
+	return nil
+}
+
+// fetchKustomizationFiles fetches the tar archive of a kustomization source from a component descriptor resource.
+func (m *Manifest) fetchKustomizationFiles(ctx context.Context, ref *manifestv1alpha2.RemoteKustomizationReference) (map[string]string, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "fetchKustomizationFiles"})
+
+	ociClient, err := m.createOCIClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build oci client: %w", err)
+	}
+
+	compResolver, err := componentsregistry.NewOCIRegistryWithOCIClient(logger, ociClient, ref.Inline)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build component resolver: %w", err)
+	}
+
+	cdRef := installations.GetReferenceFromComponentDescriptorDefinition(&ref.ComponentDescriptorDefinition)
+	if cdRef == nil {
+		return nil, fmt.Errorf("no component descriptor reference found for %q", ref.ResourceName)
+	}
+
+	cd, blobResolver, err := compResolver.ResolveWithBlobResolver(ctx, cdRef.RepositoryContext, cdRef.ComponentName, cdRef.Version)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get component descriptor for %q: %w", cdRef.ComponentName, err)
+	}
+
+	resources, err := cd.GetResourcesByName(ref.ResourceName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find resource with name %q in component descriptor", ref.ResourceName)
+	}
+	if len(resources) != 1 {
+		return nil, fmt.Errorf("resource with name %q cannot be uniquly identified", ref.ResourceName)
+	}
+
+	var buf bytes.Buffer
+	if _, err := blobResolver.Resolve(ctx, resources[0], &buf); err != nil {
+		return nil, fmt.Errorf("unable to resolve kustomization from resource %q: %w", ref.ResourceName, err)
+	}
+	return ReadKustomizationArchive(&buf)
+}
+
+// createOCIClient creates an oci client with the configured oci configuration and the registry pull secrets.
+func (m *Manifest) createOCIClient(ctx context.Context) (ociclient.Client, error) {
+	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "createOCIClient"})
+
+	secrets, err := kutil.ResolveSecrets(ctx, m.lsKubeClient,
+		append(lib.GetRegistryPullSecretsFromContext(m.Context), m.DeployItem.Spec.RegistryPullSecrets...))
+	if err != nil {
+		return nil, err
+	}
+
+	ociConfigFiles := make([]string, 0)
+	if m.Configuration.OCI != nil {
+		ociConfigFiles = m.Configuration.OCI.ConfigFiles
+	}
+	ociKeyring, err := credentials.NewBuilder(logger.WithName("ociKeyring").Logr()).
+		WithFS(osfs.New()).
+		FromConfigFiles(ociConfigFiles...).
+		FromPullSecrets(secrets...).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+	return ociclient.NewClient(logger.Logr(),
+		utils.WithConfiguration(m.Configuration.OCI),
+		ociclient.WithKeyring(ociKeyring),
+	)
+}
+
+// ReadKustomizationArchive reads all files of an optionally gzip compressed tar archive.
+// The files are returned as map of their paths to their content.
+func ReadKustomizationArchive(archive io.Reader) (map[string]string, error) {
+	reader := bufio.NewReader(archive)
+	magic, err := reader.Peek(2)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	var tarStream io.Reader = reader
+	if len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		gr, err := gzip.NewReader(reader)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decompress archive: %w", err)
+		}
+		defer gr.Close()
+		tarStream = gr
+	}
+
+	files := map[string]string{}
+	tarReader := tar.NewReader(tarStream)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to read archive: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		data, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read %q from archive: %w", header.Name, err)
+		}
+		files[path.Clean(header.Name)] = string(data)
+	}
+}
+
+// BuildKustomization builds the kustomization in the given directory of the files.
+// The files are given as map of their paths relative to the root of the kustomization source to their content.
+// All resulting resources are returned as manifests with the given policy.
+func BuildKustomization(files map[string]string, dir string, policy managedresource.ManifestPolicy) ([]managedresource.Manifest, error) {
+	fs := filesys.MakeFsInMemory()
+	for filePath, content := range files {
+		if err := fs.WriteFile(path.Join("/", filePath), []byte(content)); err != nil {
+			return nil, fmt.Errorf("unable to write file %q: %w", filePath, err)
+		}
+	}
+
+	if err := validateKustomizationSources(fs); err != nil {
+		return nil, err
+	}
+
+	resMap, err := krusty.MakeKustomizer(kustomizerOptions()).Run(fs, path.Join("/", dir))
+	if err != nil {
+		return nil, fmt.Errorf("unable to build kustomization: %w", err)
+	}
+
+	if len(policy) == 0 {
+		policy = managedresource.ManagePolicy
+	}
+	manifests := make([]managedresource.Manifest, 0, resMap.Size())
+	for _, res := range resMap.Resources() {
+		data, err := res.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode resource %s: %w", res.CurId(), err)
+		}
+		manifests = append(manifests, managedresource.Manifest{
+			Policy:   policy,
+			Manifest: &runtime.RawExtension{Raw: data},
+		})
+	}
+	return manifests, nil
+}
+
+// kustomizerOptions returns the options of the kustomizer.
+// Kustomizations may only load files of their own directory and its subdirectories and must not use plugins.
+func kustomizerOptions() *krusty.Options {
+	return &krusty.Options{
+		LoadRestrictions: types.LoadRestrictionsRootOnly,
+		PluginConfig:     types.DisabledPluginConfig(),
+	}
+}
+
+// validateKustomizationSources validates that all kustomizations of the given filesystem only refer to sources
+// that are contained in the filesystem.
+// The kustomizer clones remote bases and downloads remote files regardless of its load restrictions,
+// so that all references that are not contained in the filesystem are rejected before the kustomization is built.
+func validateKustomizationSources(fs filesys.FileSystem) error {
+	isKustomization := map[string]bool{}
+	for _, name := range konfig.RecognizedKustomizationFileNames() {
+		isKustomization[name] = true
+	}
+
+	return fs.Walk("/", func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !isKustomization[path.Base(filePath)] {
+			return nil
+		}
+		data, err := fs.ReadFile(filePath)
+		if err != nil {
+			return fmt.Errorf("unable to read kustomization %q: %w", filePath, err)
+		}
+		kustomization := &types.Kustomization{}
+		if err := kustomization.Unmarshal(data); err != nil {
+			return fmt.Errorf("unable to decode kustomization %q: %w", filePath, err)
+		}
+		kustomization.FixKustomizationPostUnmarshalling()
+
+		dir := path.Dir(filePath)
+		for _, source := range kustomizationSources(kustomization) {
+			// inline patches and generator configurations are not loaded from anywhere.
+			if len(source) == 0 || strings.Contains(source, "\n") {
+				continue
+			}
+			sourcePath := source
+			if !path.IsAbs(sourcePath) {
+				sourcePath = path.Join(dir, sourcePath)
+			}
+			if !fs.Exists(sourcePath) {
+				return fmt.Errorf("kustomization %q refers to %q which is not part of the kustomization source, remote sources are not supported",
+					filePath, source)
+			}
+		}
+		return nil
+	})
+}
+
+// kustomizationSources returns all files and directories a kustomization loads.
+func kustomizationSources(kustomization *types.Kustomization) []string {
+	sources := []string{kustomization.OpenAPI["path"]}
+	sources = append(sources, kustomization.Resources...)
+	sources = append(sources, kustomization.Components...)
+	sources = append(sources, kustomization.Crds...)
+	sources = append(sources, kustomization.Configurations...)
+	sources = append(sources, kustomization.Generators...)
+	sources = append(sources, kustomization.Transformers...)
+	sources = append(sources, kustomization.Validators...)
+	for _, patch := range kustomization.PatchesStrategicMerge {
+		sources = append(sources, string(patch))
+	}
+	for _, patch := range kustomization.Patches {
+		sources = append(sources, patch.Path)
+	}
+	for _, patch := range kustomization.PatchesJson6902 {
+		sources = append(sources, patch.Path)
+	}
+	generators := make([]types.GeneratorArgs, 0, len(kustomization.ConfigMapGenerator)+len(kustomization.SecretGenerator))
+	for _, generator := range kustomization.ConfigMapGenerator {
+		generators = append(generators, generator.GeneratorArgs)
+	}
+	for _, generator := range kustomization.SecretGenerator {
+		generators = append(generators, generator.GeneratorArgs)
+	}
+	for _, generator := range generators {
+		for _, file := range generator.FileSources {
+			// file sources are either paths or key=path pairs
+			if i := strings.Index(file, "="); i >= 0 {
+				file = file[i+1:]
+			}
+			sources = append(sources, file)
+		}
+		sources = append(sources, generator.EnvSources...)
+	}
+	return sources
+}
@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/json"
+
+	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	secretresolver "github.com/gardener/landscaper/pkg/deployer/lib/targetresolver/secret"
+	"github.com/gardener/landscaper/pkg/deployer/manifest"
+	"github.com/gardener/landscaper/test/utils"
+	"github.com/gardener/landscaper/test/utils/envtest"
+)
+
+var _ = Describe("Kustomize", func() {
+
+	kustomization := func(namespace string) map[string]string {
+		return map[string]string{
+			"base/kustomization.yaml": "resources:\n- configmap.yaml\n",
+			"base/configmap.yaml":     "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: my-cm\ndata:\n  key: val\n",
+			"overlay/kustomization.yaml": fmt.Sprintf("namespace: %s\nnamePrefix: dev-\nresources:\n- ../base\n", namespace) +
+				"configMapGenerator:\n- name: generated\n  literals:\n  - foo=bar\n  options:\n    disableNameSuffixHash: true\n",
+		}
+	}
+
+	decode := func(m managedresource.Manifest) *corev1.ConfigMap {
+		cm := &corev1.ConfigMap{}
+		Expect(json.Unmarshal(m.Manifest.Raw, cm)).To(Succeed())
+		return cm
+	}
+
+	Context("Build", func() {
+		It("should build a kustomization with bases and generators", func() {
+			manifests, err := manifest.BuildKustomization(kustomization("test"), "overlay", "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(manifests).To(HaveLen(2))
+
+			cm := decode(manifests[0])
+			Expect(cm.Name).To(Equal("dev-my-cm"))
+			Expect(cm.Namespace).To(Equal("test"))
+			Expect(cm.Data).To(HaveKeyWithValue("key", "val"))
+			Expect(manifests[0].Policy).To(Equal(managedresource.ManagePolicy))
+
+			cm = decode(manifests[1])
+			Expect(cm.Name).To(Equal("dev-generated"))
+			Expect(cm.Data).To(HaveKeyWithValue("foo", "bar"))
+		})
+
+		It("should set the policy of the kustomization for all manifests", func() {
+			manifests, err := manifest.BuildKustomization(kustomization("test"), "base", managedresource.KeepPolicy)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(manifests).To(HaveLen(1))
+			Expect(manifests[0].Policy).To(Equal(managedresource.KeepPolicy))
+		})
+
+		It("should fail if the directory contains no kustomization", func() {
+			_, err := manifest.BuildKustomization(kustomization("test"), "other", "")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should not load files outside of the kustomization source", func() {
+			files := map[string]string{
+				"kustomization.yaml": "resources:\n- ../../etc/configmap.yaml\n",
+			}
+			_, err := manifest.BuildKustomization(files, "", "")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should not load remote bases and files", func() {
+			for _, source := range []string{
+				"resources:\n- github.com/kubernetes-sigs/kustomize//examples/multibases?ref=v3.3.1\n",
+				"resources:\n- https://example.com/configmap.yaml\n",
+				"patchesStrategicMerge:\n- https://example.com/patch.yaml\n",
+				"configMapGenerator:\n- name: cm\n  files:\n  - key=https://example.com/file\n",
+			} {
+				_, err := manifest.BuildKustomization(map[string]string{"kustomization.yaml": source}, "", "")
+				Expect(err).To(MatchError(ContainSubstring("remote sources are not supported")), source)
+			}
+		})
+	})
+
+	Context("Archive", func() {
+		buildArchive := func(files map[string]string, compress bool) *bytes.Buffer {
+			var (
+				buf bytes.Buffer
+				w   io.Writer = &buf
+				gw  *gzip.Writer
+			)
+			if compress {
+				gw = gzip.NewWriter(&buf)
+				w = gw
+			}
+			tw := tar.NewWriter(w)
+			for name, content := range files {
+				Expect(tw.WriteHeader(&tar.Header{
+					Typeflag: tar.TypeReg,
+					Name:     name,
+					Mode:     0644,
+					Size:     int64(len(content)),
+				})).To(Succeed())
+				_, err := tw.Write([]byte(content))
+				Expect(err).ToNot(HaveOccurred())
+			}
+			Expect(tw.Close()).To(Succeed())
+			if gw != nil {
+				Expect(gw.Close()).To(Succeed())
+			}
+			return &buf
+		}
+
+		It("should read the files of a tar archive", func() {
+			files, err := manifest.ReadKustomizationArchive(buildArchive(kustomization("test"), false))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(Equal(kustomization("test")))
+		})
+
+		It("should read the files of a gzip compressed tar archive", func() {
+			files, err := manifest.ReadKustomizationArchive(buildArchive(kustomization("test"), true))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(Equal(kustomization("test")))
+		})
+	})
+
+	Context("Reconcile", func() {
+		var (
+			ctx   context.Context
+			state *envtest.State
+		)
+
+		BeforeEach(func() {
+			ctx = logging.NewContext(context.Background(), logging.Discard())
+			var err error
+			state, err = testenv.InitState(ctx)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			defer ctx.Done()
+			Expect(state.CleanupState(ctx)).To(Succeed())
+		})
+
+		It("should apply the resources of an inline kustomization", func() {
+			target, err := utils.CreateKubernetesTarget(state.Namespace, "my-target", testenv.Env.Config)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state.Create(ctx, target)).To(Succeed())
+
+			sr := secretresolver.New(state.Client)
+			rt, err := sr.Resolve(ctx, target)
+			Expect(err).ToNot(HaveOccurred())
+
+			manifestConfig := &manifestv1alpha2.ProviderConfiguration{}
+			manifestConfig.Kustomize = &manifestv1alpha2.KustomizeSource{
+				Path:  "overlay",
+				Files: kustomization(state.Namespace),
+			}
+			item, err := manifest.NewDeployItemBuilder().
+				Key(state.Namespace, "myitem").
+				ProviderConfig(manifestConfig).
+				Target(target.Namespace, target.Name).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state.Create(ctx, item)).To(Succeed())
+
+			m, err := manifest.New(testenv.Client, testenv.Client, &manifestv1alpha2.Configuration{}, item, rt)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(m.Reconcile(ctx)).To(Succeed())
+
+			cm := &corev1.ConfigMap{}
+			Expect(testenv.Client.Get(ctx, kutil.ObjectKey("dev-my-cm", state.Namespace), cm)).To(Succeed())
+			Expect(cm.Data).To(HaveKeyWithValue("key", "val"))
+			Expect(testenv.Client.Get(ctx, kutil.ObjectKey("dev-generated", state.Namespace), cm)).To(Succeed())
+			Expect(cm.Data).To(HaveKeyWithValue("foo", "bar"))
+			Expect(m.ProviderStatus.ManagedResources).To(HaveLen(2))
+		})
+	})
+
+})
@@ -52,6 +52,7 @@ type Manifest struct {
 
 	DeployItem            *lsv1alpha1.DeployItem
 	Target                *lsv1alpha1.ResolvedTarget
+	Context               *lsv1alpha1.Context
 	ProviderConfiguration *manifestv1alpha2.ProviderConfiguration
 	ProviderStatus        *manifestv1alpha2.ProviderStatus
 
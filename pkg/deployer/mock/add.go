@@ -31,12 +31,13 @@ func AddDeployerToManager(logger logging.Logger, lsMgr, hostMgr manager.Manager,
 	}
 
 	return deployerlib.Add(log, lsMgr, hostMgr, deployerlib.DeployerArgs{
-		Name:            Name,
-		Version:         version.Get().String(),
-		Identity:        config.Identity,
-		Type:            Type,
-		Deployer:        d,
-		TargetSelectors: config.TargetSelector,
+		Name:                  Name,
+		Version:               version.Get().String(),
+		Identity:              config.Identity,
+		Type:                  Type,
+		Deployer:              d,
+		TargetSelectors:       config.TargetSelector,
+		FailOnUnhealthyTarget: config.FailOnUnhealthyTarget,
 	})
 }
 
@@ -57,8 +58,9 @@ func NewController(log logging.Logger, kubeClient client.Client, scheme *runtime
 		scheme, eventRecorder,
 		kubeClient, scheme,
 		deployerlib.DeployerArgs{
-			Type:            Type,
-			Deployer:        d,
-			TargetSelectors: config.TargetSelector,
+			Type:                  Type,
+			Deployer:              d,
+			TargetSelectors:       config.TargetSelector,
+			FailOnUnhealthyTarget: config.FailOnUnhealthyTarget,
 		}), nil
 }
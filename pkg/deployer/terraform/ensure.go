@@ -0,0 +1,510 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package terraform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	terraformv1alpha1 "github.com/gardener/landscaper/apis/deployer/terraform/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	deployerlib "github.com/gardener/landscaper/pkg/deployer/lib"
+	health "github.com/gardener/landscaper/pkg/deployer/lib/readinesscheck"
+	"github.com/gardener/landscaper/pkg/deployer/terraform/runner"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	// runnerContainerName is the name of the container of the runner pod.
+	runnerContainerName = "terraform"
+	// runnerCommand is the command of the runner in the runner image.
+	runnerCommand   = "terraform-deployer-runner"
+	inputVolumeName = "input"
+	inputMountPath  = "/terraform/input"
+	workVolumeName  = "work"
+	workMountPath   = "/terraform/work"
+)
+
+// Reconcile applies the terraform configuration of the deploy item and exports the configured terraform outputs.
+func (t *Terraform) Reconcile(ctx context.Context) error {
+	currOp := "ReconcileTerraform"
+	t.DeployItem.Status.Phase = lsv1alpha1.ExecutionPhaseProgressing
+
+	if err := t.run(ctx, terraformv1alpha1.OperationApply); err != nil {
+		return err
+	}
+
+	if len(t.ProviderConfiguration.Exports) != 0 {
+		exports, err := t.readExports(ctx)
+		if err != nil {
+			return lserrors.NewWrappedError(err, currOp, "ReadExportValues", err.Error())
+		}
+		if err := deployerlib.CreateOrUpdateExport(ctx, t.Writer(), t.lsKubeClient, t.DeployItem, exports); err != nil {
+			return err
+		}
+	}
+
+	t.DeployItem.Status.Phase = lsv1alpha1.ExecutionPhaseSucceeded
+	return nil
+}
+
+// Delete destroys all resources that are managed by the terraform configuration
+// and removes the terraform state and all other resources of the deploy item from the host cluster.
+func (t *Terraform) Delete(ctx context.Context) error {
+	currOp := "DeleteTerraform"
+	_, ctx = logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+	t.DeployItem.Status.Phase = lsv1alpha1.ExecutionPhaseDeleting
+
+	if t.ProviderStatus == nil {
+		controllerutil.RemoveFinalizer(t.DeployItem, lsv1alpha1.LandscaperFinalizer)
+		return t.Writer().UpdateDeployItem(ctx, read_write_layer.W000177, t.DeployItem)
+	}
+
+	if err := t.run(ctx, terraformv1alpha1.OperationDestroy); err != nil {
+		return err
+	}
+
+	if err := t.deleteRunner(ctx); err != nil {
+		return lserrors.NewWrappedError(err,
+			currOp, "DeleteRunner", err.Error())
+	}
+	if err := t.cleanupResources(ctx); err != nil {
+		return lserrors.NewWrappedError(err,
+			currOp, "CleanupResources", err.Error())
+	}
+
+	controllerutil.RemoveFinalizer(t.DeployItem, lsv1alpha1.LandscaperFinalizer)
+	return t.Writer().UpdateDeployItem(ctx, read_write_layer.W000178, t.DeployItem)
+}
+
+// run runs the given terraform operation in a runner pod in the host cluster and waits for its completion.
+// The pod of a previous run has to complete before a new run is started as it may still change the terraform state.
+func (t *Terraform) run(ctx context.Context, op terraformv1alpha1.Operation) error {
+	currOp := "RunTerraform"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+
+	image, err := t.Image()
+	if err != nil {
+		return lserrors.NewWrappedError(err,
+			currOp, "RunnerImage", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+	input, err := t.RunnerInput(ctx, op)
+	if err != nil {
+		return lserrors.NewWrappedError(err,
+			currOp, "RunnerInput", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+
+	key := kutil.ObjectKey(ResourceName(t.DeployItem), t.Configuration.Namespace)
+	if err := t.waitForPreviousRunner(ctx, key); err != nil {
+		return lserrors.NewWrappedError(err,
+			currOp, "WaitForPreviousRun", err.Error(), lsv1alpha1.ErrorTimeout)
+	}
+	if err := t.deleteRunner(ctx); err != nil {
+		return lserrors.NewWrappedError(err,
+			currOp, "DeletePreviousRun", err.Error())
+	}
+
+	if err := t.ensureServiceAccount(ctx); err != nil {
+		return lserrors.NewWrappedError(err,
+			currOp, "EnsureServiceAccount", err.Error())
+	}
+	if err := t.ensureInputSecret(ctx, input); err != nil {
+		return lserrors.NewWrappedError(err,
+			currOp, "EnsureInput", err.Error())
+	}
+	if err := t.ensureRunnerSecrets(ctx); err != nil {
+		return lserrors.NewWrappedError(err,
+			currOp, "EnsureRunnerSecrets", err.Error())
+	}
+
+	pod := t.runnerPod(image)
+	logger.Info("Create runner pod", lc.KeyResource, key.String(), "operation", op)
+	if err := t.hostKubeClient.Create(ctx, pod); err != nil {
+		return lserrors.NewWrappedError(err,
+			currOp, "CreateRunner", err.Error())
+	}
+
+	now := metav1.Now()
+	t.ProviderStatus = &terraformv1alpha1.ProviderStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: terraformv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "ProviderStatus",
+		},
+		LastOperation: op,
+		Pod:           pod.Name,
+		Phase:         terraformv1alpha1.RunPhaseRunning,
+		StartTime:     &now,
+	}
+	if err := t.encodeProviderStatus(); err != nil {
+		return lserrors.NewWrappedError(err,
+			currOp, "ProviderStatus", err.Error())
+	}
+	if err := t.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000176, t.DeployItem); err != nil {
+		return lserrors.NewWrappedError(err,
+			currOp, "UpdateStatus", err.Error())
+	}
+
+	waitErr := t.waitForRunner(ctx, key)
+	if err := t.hostKubeClient.Get(ctx, key, pod); err != nil {
+		return lserrors.NewWrappedError(err,
+			currOp, "GetRunner", err.Error())
+	}
+	t.setRunStatus(pod)
+	if err := t.encodeProviderStatus(); err != nil {
+		return lserrors.NewWrappedError(err,
+			currOp, "ProviderStatus", err.Error())
+	}
+	if t.ProviderStatus.Phase == terraformv1alpha1.RunPhaseFailed {
+		return lserrors.NewError(currOp, "CheckRunner", t.ProviderStatus.Message, lsv1alpha1.ErrorTerraformFailed)
+	}
+	if waitErr != nil {
+		return lserrors.NewWrappedError(waitErr,
+			currOp, "CheckRunner", waitErr.Error(), lsv1alpha1.ErrorTimeout)
+	}
+	return nil
+}
+
+// runnerPod returns the pod that runs terraform with the runner input of the deploy item.
+func (t *Terraform) runnerPod(image string) *corev1.Pod {
+	pod := &corev1.Pod{}
+	pod.Name = ResourceName(t.DeployItem)
+	pod.Namespace = t.Configuration.Namespace
+	t.injectLabels(pod)
+	pod.Spec = corev1.PodSpec{
+		ServiceAccountName: ResourceName(t.DeployItem),
+		RestartPolicy:      corev1.RestartPolicyNever,
+		Containers: []corev1.Container{
+			{
+				Name:    runnerContainerName,
+				Image:   image,
+				Command: []string{runnerCommand},
+				Args: []string{
+					"--input=" + path.Join(inputMountPath, runner.InputSecretKey),
+					"--work-dir=" + workMountPath,
+				},
+				VolumeMounts: []corev1.VolumeMount{
+					{
+						Name:      inputVolumeName,
+						MountPath: inputMountPath,
+						ReadOnly:  true,
+					},
+					{
+						Name:      workVolumeName,
+						MountPath: workMountPath,
+					},
+				},
+				TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+			},
+		},
+		Volumes: []corev1.Volume{
+			{
+				Name: inputVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: InputSecretName(t.DeployItem),
+					},
+				},
+			},
+			{
+				Name: workVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{},
+				},
+			},
+		},
+	}
+	return pod
+}
+
+// ensureInputSecret creates or updates the secret that contains the runner input.
+func (t *Terraform) ensureInputSecret(ctx context.Context, input *runner.Input) error {
+	data, err := json.Marshal(input)
+	if err != nil {
+		return fmt.Errorf("unable to encode runner input: %w", err)
+	}
+	secret := &corev1.Secret{}
+	secret.Name = InputSecretName(t.DeployItem)
+	secret.Namespace = t.Configuration.Namespace
+	_, err = controllerutil.CreateOrUpdate(ctx, t.hostKubeClient, secret, func() error {
+		t.injectLabels(secret)
+		secret.Data = map[string][]byte{
+			runner.InputSecretKey: data,
+		}
+		return nil
+	})
+	return err
+}
+
+// ensureRunnerSecrets ensures the state and outputs secrets the runner writes to.
+// The content of existing secrets is kept.
+func (t *Terraform) ensureRunnerSecrets(ctx context.Context) error {
+	for _, name := range t.runnerSecretNames() {
+		secret := &corev1.Secret{}
+		secret.Name = name
+		secret.Namespace = t.Configuration.Namespace
+		if _, err := controllerutil.CreateOrUpdate(ctx, t.hostKubeClient, secret, func() error {
+			t.injectLabels(secret)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runnerSecretNames returns the names of the secrets the runner reads and writes.
+func (t *Terraform) runnerSecretNames() []string {
+	return append(StateSecretNames(t.DeployItem), OutputsSecretName(t.DeployItem))
+}
+
+// ensureServiceAccount ensures the service account of the runner pod.
+// The runner is only allowed to read and update the state and outputs secrets of the deploy item.
+// The secrets are created by the deployer as the creation of secrets cannot be restricted to names.
+func (t *Terraform) ensureServiceAccount(ctx context.Context) error {
+	sa := &corev1.ServiceAccount{}
+	sa.Name = ResourceName(t.DeployItem)
+	sa.Namespace = t.Configuration.Namespace
+	if _, err := controllerutil.CreateOrUpdate(ctx, t.hostKubeClient, sa, func() error {
+		t.injectLabels(sa)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	role := &rbacv1.Role{}
+	role.Name = sa.Name
+	role.Namespace = sa.Namespace
+	if _, err := controllerutil.CreateOrUpdate(ctx, t.hostKubeClient, role, func() error {
+		t.injectLabels(role)
+		role.Rules = []rbacv1.PolicyRule{
+			{
+				APIGroups:     []string{corev1.SchemeGroupVersion.Group},
+				Resources:     []string{"secrets"},
+				ResourceNames: t.runnerSecretNames(),
+				Verbs:         []string{"get", "update"},
+			},
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	rolebinding := &rbacv1.RoleBinding{}
+	rolebinding.Name = sa.Name
+	rolebinding.Namespace = sa.Namespace
+	_, err := controllerutil.CreateOrUpdate(ctx, t.hostKubeClient, rolebinding, func() error {
+		t.injectLabels(rolebinding)
+		rolebinding.RoleRef = rbacv1.RoleRef{
+			APIGroup: rbacv1.SchemeGroupVersion.Group,
+			Kind:     "Role",
+			Name:     role.Name,
+		}
+		rolebinding.Subjects = []rbacv1.Subject{
+			{
+				Kind:      "ServiceAccount",
+				Name:      sa.Name,
+				Namespace: sa.Namespace,
+			},
+		}
+		return nil
+	})
+	return err
+}
+
+// cleanupResources deletes the input, state and outputs secrets and the service account of the deploy item.
+func (t *Terraform) cleanupResources(ctx context.Context) error {
+	objects := []client.Object{
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: InputSecretName(t.DeployItem), Namespace: t.Configuration.Namespace}},
+	}
+	for _, name := range t.runnerSecretNames() {
+		objects = append(objects, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: t.Configuration.Namespace}})
+	}
+	objects = append(objects,
+		&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: ResourceName(t.DeployItem), Namespace: t.Configuration.Namespace}},
+		&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: ResourceName(t.DeployItem), Namespace: t.Configuration.Namespace}},
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: ResourceName(t.DeployItem), Namespace: t.Configuration.Namespace}},
+	)
+	for _, obj := range objects {
+		if err := t.hostKubeClient.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteRunner deletes the runner pod of the deploy item and waits until it is gone.
+func (t *Terraform) deleteRunner(ctx context.Context) error {
+	key := kutil.ObjectKey(ResourceName(t.DeployItem), t.Configuration.Namespace)
+	pod := &corev1.Pod{}
+	pod.Name = key.Name
+	pod.Namespace = key.Namespace
+	if err := t.hostKubeClient.Delete(ctx, pod); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	return wait.PollImmediate(time.Second, 3*time.Minute, func() (bool, error) {
+		if err := t.hostKubeClient.Get(ctx, key, pod); err != nil {
+			if apierrors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		return false, nil
+	})
+}
+
+// waitForPreviousRunner waits until the runner pod of a previous run has terminated.
+func (t *Terraform) waitForPreviousRunner(ctx context.Context, key types.NamespacedName) error {
+	pod := &corev1.Pod{}
+	if err := t.hostKubeClient.Get(ctx, key, pod); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if isPodTerminated(pod) {
+		return nil
+	}
+
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+	logger.Info("Wait for the runner pod of a previous run", lc.KeyResource, key.String())
+	waitErr := t.waitForRunner(ctx, key)
+	if err := t.hostKubeClient.Get(ctx, key, pod); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if !isPodTerminated(pod) {
+		if waitErr != nil {
+			return waitErr
+		}
+		return fmt.Errorf("runner pod %s of a previous run has not terminated", key.String())
+	}
+	return nil
+}
+
+// waitForRunner waits until the runner pod with the given key has completed.
+// Waiting is aborted if the pod fails.
+func (t *Terraform) waitForRunner(ctx context.Context, key types.NamespacedName) error {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Pod"))
+	obj.SetName(key.Name)
+	obj.SetNamespace(key.Namespace)
+
+	return health.WaitForObjectsReady(ctx, t.ProviderConfiguration.Timeout.Duration, t.hostKubeClient,
+		[]*unstructured.Unstructured{obj}, checkRunner, deployerlib.NewInterruptionChecker(t.DeployItem, t.lsKubeClient))
+}
+
+func isPodTerminated(pod *corev1.Pod) bool {
+	return pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+}
+
+// checkRunner checks whether the runner pod has completed.
+// In contrast to a running pod, a failed pod is not reported as not ready so that waiting for it is aborted.
+func checkRunner(u *unstructured.Unstructured) error {
+	pod := &corev1.Pod{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, pod); err != nil {
+		return err
+	}
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		return nil
+	case corev1.PodFailed:
+		return fmt.Errorf("runner pod failed: %s", runnerMessage(pod))
+	default:
+		return health.NewObjectNotReadyError(u, fmt.Errorf("runner pod is in phase %q", pod.Status.Phase))
+	}
+}
+
+// runnerMessage returns the termination message of the runner container of a failed pod.
+func runnerMessage(pod *corev1.Pod) string {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == runnerContainerName && status.State.Terminated != nil && len(status.State.Terminated.Message) != 0 {
+			return strings.TrimSpace(status.State.Terminated.Message)
+		}
+	}
+	if len(pod.Status.Message) != 0 {
+		return pod.Status.Message
+	}
+	return pod.Status.Reason
+}
+
+// setRunStatus sets the provider status from the status of the given runner pod.
+func (t *Terraform) setRunStatus(pod *corev1.Pod) {
+	t.ProviderStatus.Pod = pod.Name
+	t.ProviderStatus.Message = ""
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		now := metav1.Now()
+		t.ProviderStatus.Phase = terraformv1alpha1.RunPhaseSucceeded
+		t.ProviderStatus.CompletionTime = &now
+	case corev1.PodFailed:
+		t.ProviderStatus.Phase = terraformv1alpha1.RunPhaseFailed
+		t.ProviderStatus.Message = runnerMessage(pod)
+	default:
+		t.ProviderStatus.Phase = terraformv1alpha1.RunPhaseRunning
+	}
+}
+
+// readExports reads the configured exports from the terraform outputs.
+func (t *Terraform) readExports(ctx context.Context) (map[string]interface{}, error) {
+	secret := &corev1.Secret{}
+	if err := t.hostKubeClient.Get(ctx, kutil.ObjectKey(OutputsSecretName(t.DeployItem), t.Configuration.Namespace), secret); err != nil {
+		return nil, fmt.Errorf("unable to get terraform outputs: %w", err)
+	}
+	outputs := map[string]interface{}{}
+	if err := json.Unmarshal(secret.Data[runner.OutputsSecretKey], &outputs); err != nil {
+		return nil, fmt.Errorf("unable to decode terraform outputs: %w", err)
+	}
+
+	exports := make(map[string]interface{}, len(t.ProviderConfiguration.Exports))
+	for _, export := range t.ProviderConfiguration.Exports {
+		value, ok := outputs[export.FromOutput]
+		if !ok {
+			return nil, fmt.Errorf("terraform output %q of export %q does not exist", export.FromOutput, export.Key)
+		}
+		exports[export.Key] = value
+	}
+	return exports, nil
+}
+
+// injectLabels adds the labels of the deploy item to the given object.
+func (t *Terraform) injectLabels(obj client.Object) {
+	kutil.SetMetaDataLabel(obj, terraformv1alpha1.DeployItemNameLabel, t.DeployItem.Name)
+	kutil.SetMetaDataLabel(obj, terraformv1alpha1.DeployItemNamespaceLabel, t.DeployItem.Namespace)
+}
+
+func (t *Terraform) encodeProviderStatus() error {
+	var err error
+	t.DeployItem.Status.ProviderStatus, err = kutil.ConvertToRawExtension(t.ProviderStatus, Scheme)
+	return err
+}
+
+func (t *Terraform) Writer() *read_write_layer.Writer {
+	return read_write_layer.NewWriter(t.lsKubeClient)
+}
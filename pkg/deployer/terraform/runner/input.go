@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package runner
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	terraformv1alpha1 "github.com/gardener/landscaper/apis/deployer/terraform/v1alpha1"
+)
+
+const (
+	// InputSecretKey is the key of the input in the input secret that is mounted into the runner pod.
+	InputSecretKey = "input.json"
+	// OutputsSecretKey is the key of the terraform outputs in the outputs secret that is written by the runner.
+	OutputsSecretKey = lsv1alpha1.DataObjectSecretDataKey
+)
+
+// Input is the input of a terraform run that is passed from the deployer to the runner.
+type Input struct {
+	// DeployItem is the deploy item the terraform run belongs to.
+	DeployItem lsv1alpha1.ObjectReference `json:"deployItem"`
+	// Namespace is the namespace where the terraform state and the outputs are stored.
+	Namespace string `json:"namespace"`
+	// Operation is the terraform operation that is run.
+	Operation terraformv1alpha1.Operation `json:"operation"`
+	// Binary is the terraform binary that is executed.
+	Binary string `json:"binary"`
+	// Files contains the terraform configuration as a map of relative file paths to their content.
+	Files map[string]string `json:"files"`
+	// Variables contains the values of the input variables as json object.
+	Variables json.RawMessage `json:"variables,omitempty"`
+	// Env defines additional environment variables of the terraform process.
+	Env map[string]string `json:"env,omitempty"`
+	// Kubeconfig is the kubeconfig of a kubernetes cluster target.
+	Kubeconfig string `json:"kubeconfig,omitempty"`
+	// TargetVariable is the name of the input variable that is set to the target configuration.
+	TargetVariable string `json:"targetVariable,omitempty"`
+	// TargetConfig is the configuration of the target of the deploy item.
+	TargetConfig json.RawMessage `json:"targetConfig,omitempty"`
+	// StateSecretNames are the names of the existing secrets the terraform state is stored in.
+	StateSecretNames []string `json:"stateSecretNames"`
+	// OutputsSecretName is the name of the secret the terraform outputs are written to.
+	OutputsSecretName string `json:"outputsSecretName,omitempty"`
+}
+
+// ReadInput reads the runner input from the given file.
+func ReadInput(path string) (*Input, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read input from %q: %w", path, err)
+	}
+	input := &Input{}
+	if err := json.Unmarshal(data, input); err != nil {
+		return nil, fmt.Errorf("unable to decode input: %w", err)
+	}
+	return input, nil
+}
@@ -0,0 +1,213 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package runner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	terraform "github.com/gardener/landscaper/apis/deployer/terraform/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+const (
+	// BackendOverrideFileName is the name of the generated file that configures the local backend for the terraform state.
+	BackendOverrideFileName = terraform.GeneratedFilePrefix + "backend_override.tf"
+	// VariablesFileName is the name of the generated file that contains the values of the input variables.
+	VariablesFileName = terraform.GeneratedFilePrefix + "variables.auto.tfvars.json"
+	// StateFileName is the name of the terraform state file in the state directory.
+	StateFileName = "terraform.tfstate"
+	// PlanFileName is the name of the plan file that is applied.
+	PlanFileName = "tfplan"
+)
+
+// Runner runs terraform for a deploy item.
+// The terraform state is restored from and backed up to the state secrets of the input.
+type Runner struct {
+	kubeClient client.Client
+	input      *Input
+	workDir    string
+	stdout     io.Writer
+	stderr     io.Writer
+}
+
+// New creates a new runner that uses the given directory as working directory.
+func New(kubeClient client.Client, input *Input, workDir string) *Runner {
+	return &Runner{
+		kubeClient: kubeClient,
+		input:      input,
+		workDir:    workDir,
+		stdout:     os.Stdout,
+		stderr:     os.Stderr,
+	}
+}
+
+// WithOutput sets the writers the output of terraform is written to.
+func (r *Runner) WithOutput(stdout, stderr io.Writer) *Runner {
+	r.stdout = stdout
+	r.stderr = stderr
+	return r
+}
+
+// Run restores the terraform state, runs the operation of the input and backs up the resulting state.
+// The state is also backed up if terraform fails as terraform may already have changed resources.
+func (r *Runner) Run(ctx context.Context) (err error) {
+	log, ctx := logging.FromContextOrNew(ctx, nil)
+	var (
+		configDir = filepath.Join(r.workDir, "config")
+		stateDir  = filepath.Join(r.workDir, "state")
+		stateFile = filepath.Join(stateDir, StateFileName)
+	)
+
+	if err := os.MkdirAll(stateDir, 0700); err != nil {
+		return fmt.Errorf("unable to create state directory: %w", err)
+	}
+	if err := r.restoreState(ctx, stateFile); err != nil {
+		return fmt.Errorf("unable to restore terraform state: %w", err)
+	}
+	defer func() {
+		if backupErr := r.backupState(ctx, stateFile); backupErr != nil {
+			if err == nil {
+				err = fmt.Errorf("unable to backup terraform state: %w", backupErr)
+				return
+			}
+			log.Error(backupErr, "Unable to backup terraform state")
+		}
+	}()
+
+	if err := r.writeConfiguration(configDir, stateFile); err != nil {
+		return err
+	}
+	env, err := r.environment()
+	if err != nil {
+		return err
+	}
+
+	log.Info("Run terraform", "operation", r.input.Operation)
+	if err := r.terraform(ctx, configDir, env, r.stdout, "init", "-input=false", "-no-color"); err != nil {
+		return err
+	}
+	planArgs := []string{"plan", "-input=false", "-no-color", "-out=" + PlanFileName}
+	if r.input.Operation == terraform.OperationDestroy {
+		planArgs = append(planArgs, "-destroy")
+	}
+	if err := r.terraform(ctx, configDir, env, r.stdout, planArgs...); err != nil {
+		return err
+	}
+	if err := r.terraform(ctx, configDir, env, r.stdout, "apply", "-input=false", "-no-color", "-auto-approve", PlanFileName); err != nil {
+		return err
+	}
+
+	if r.input.Operation != terraform.OperationApply || len(r.input.OutputsSecretName) == 0 {
+		return nil
+	}
+	var outputs bytes.Buffer
+	if err := r.terraform(ctx, configDir, env, &outputs, "output", "-json", "-no-color"); err != nil {
+		return err
+	}
+	return r.writeOutputs(ctx, outputs.Bytes())
+}
+
+// writeConfiguration writes the terraform configuration files, the values of the variables
+// and the backend configuration that stores the state in the given state file.
+// The files are only accessible by the runner as the variables may contain credentials.
+func (r *Runner) writeConfiguration(configDir, stateFile string) error {
+	for filePath, content := range r.input.Files {
+		path := filepath.Join(configDir, filepath.FromSlash(filePath))
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			return fmt.Errorf("unable to create directory for file %q: %w", filePath, err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			return fmt.Errorf("unable to write file %q: %w", filePath, err)
+		}
+	}
+
+	backend := fmt.Sprintf("terraform {\n  backend \"local\" {\n    path = %q\n  }\n}\n", stateFile)
+	if err := os.WriteFile(filepath.Join(configDir, BackendOverrideFileName), []byte(backend), 0600); err != nil {
+		return fmt.Errorf("unable to write backend configuration: %w", err)
+	}
+
+	if len(r.input.Variables) != 0 {
+		if err := os.WriteFile(filepath.Join(configDir, VariablesFileName), r.input.Variables, 0600); err != nil {
+			return fmt.Errorf("unable to write variables: %w", err)
+		}
+	}
+	return nil
+}
+
+// environment returns the environment of the terraform process.
+// The kubeconfig of a kubernetes cluster target is written to a file that is used by the kubernetes and helm providers.
+func (r *Runner) environment() ([]string, error) {
+	env := append(os.Environ(), "TF_IN_AUTOMATION=true", "TF_INPUT=0")
+	if len(r.input.Kubeconfig) != 0 {
+		kubeconfigPath := filepath.Join(r.workDir, "kubeconfig")
+		if err := os.WriteFile(kubeconfigPath, []byte(r.input.Kubeconfig), 0600); err != nil {
+			return nil, fmt.Errorf("unable to write kubeconfig: %w", err)
+		}
+		env = append(env, "KUBECONFIG="+kubeconfigPath, "KUBE_CONFIG_PATH="+kubeconfigPath)
+	}
+	if len(r.input.TargetVariable) != 0 {
+		env = append(env, fmt.Sprintf("TF_VAR_%s=%s", r.input.TargetVariable, string(r.input.TargetConfig)))
+	}
+	for key, value := range r.input.Env {
+		env = append(env, fmt.Sprintf("%s=%s", key, value))
+	}
+	return env, nil
+}
+
+// terraform executes the terraform binary with the given arguments in the configuration directory.
+func (r *Runner) terraform(ctx context.Context, dir string, env []string, stdout io.Writer, args ...string) error {
+	cmd := exec.CommandContext(ctx, r.input.Binary, args...)
+	cmd.Dir = dir
+	cmd.Env = env
+	cmd.Stdout = stdout
+	cmd.Stderr = r.stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s %s failed: %w", r.input.Binary, args[0], err)
+	}
+	return nil
+}
+
+// outputValue is a single output of "terraform output -json".
+type outputValue struct {
+	Value json.RawMessage `json:"value"`
+}
+
+// writeOutputs writes the values of the terraform outputs to the existing outputs secret.
+func (r *Runner) writeOutputs(ctx context.Context, data []byte) error {
+	outputs := map[string]outputValue{}
+	if err := json.Unmarshal(data, &outputs); err != nil {
+		return fmt.Errorf("unable to decode terraform outputs: %w", err)
+	}
+	values := make(map[string]json.RawMessage, len(outputs))
+	for name, output := range outputs {
+		values[name] = output.Value
+	}
+	valuesBytes, err := json.Marshal(values)
+	if err != nil {
+		return fmt.Errorf("unable to encode terraform outputs: %w", err)
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.kubeClient.Get(ctx, client.ObjectKey{Name: r.input.OutputsSecretName, Namespace: r.input.Namespace}, secret); err != nil {
+		return fmt.Errorf("unable to get outputs secret: %w", err)
+	}
+	secret.Data = map[string][]byte{
+		OutputsSecretKey: valuesBytes,
+	}
+	if err := r.kubeClient.Update(ctx, secret); err != nil {
+		return fmt.Errorf("unable to write terraform outputs: %w", err)
+	}
+	return nil
+}
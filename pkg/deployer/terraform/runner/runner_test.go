@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package runner_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	terraformv1alpha1 "github.com/gardener/landscaper/apis/deployer/terraform/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/deployer/terraform/runner"
+)
+
+// fakeTerraform is a shell script that simulates the terraform binary.
+// It records its invocations, counts the applies in the state file of the local backend
+// and returns the number of applies as output.
+const fakeTerraform = `#!/bin/sh
+echo "$@" >> "$INVOCATIONS"
+state=$(sed -n 's/.*path *= *"\(.*\)".*/\1/p' zz_landscaper_backend_override.tf)
+case "$1" in
+apply)
+  count=$(cat "$state" 2>/dev/null || echo 0)
+  echo $((count + 1)) > "$state"
+  ;;
+output)
+  echo "{\"applies\":{\"sensitive\":false,\"type\":\"number\",\"value\":$(cat "$state")},\"region\":{\"sensitive\":false,\"type\":\"string\",\"value\":\"$REGION\"}}"
+  ;;
+fail)
+  exit 1
+  ;;
+esac
+`
+
+// timestampClient sets increasing creation timestamps as the fake client does not set them.
+type timestampClient struct {
+	client.Client
+	now time.Time
+}
+
+func (c *timestampClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	c.now = c.now.Add(time.Second)
+	obj.SetCreationTimestamp(metav1.NewTime(c.now))
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+var _ = Describe("Runner", func() {
+
+	var (
+		ctx         context.Context
+		kubeClient  client.Client
+		binDir      string
+		invocations string
+		deployItem  = lsv1alpha1.ObjectReference{Name: "myitem", Namespace: "default"}
+
+		stateSecretNames = []string{"myitem-state-0", "myitem-state-1"}
+	)
+
+	BeforeEach(func() {
+		ctx = logging.NewContextWithDiscard(context.Background())
+		kubeClient = &timestampClient{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(),
+			now:    time.Now(),
+		}
+		binDir = GinkgoT().TempDir()
+		invocations = filepath.Join(binDir, "invocations")
+		Expect(os.WriteFile(filepath.Join(binDir, "terraform"), []byte(fakeTerraform), 0755)).To(Succeed())
+		GinkgoT().Setenv("INVOCATIONS", invocations)
+
+		// the secrets are created by the deployer
+		for _, name := range append(stateSecretNames, "myitem-outputs") {
+			secret := &corev1.Secret{}
+			secret.Name = name
+			secret.Namespace = "ls-system"
+			Expect(kubeClient.Create(ctx, secret)).To(Succeed())
+		}
+	})
+
+	newInput := func(op terraformv1alpha1.Operation) *runner.Input {
+		return &runner.Input{
+			DeployItem: deployItem,
+			Namespace:  "ls-system",
+			Operation:  op,
+			Binary:     filepath.Join(binDir, "terraform"),
+			Files: map[string]string{
+				"main.tf":           `resource "null_resource" "test" {}`,
+				"modules/a/main.tf": `output "a" { value = "a" }`,
+			},
+			Variables:         json.RawMessage(`{"name":"test"}`),
+			Env:               map[string]string{"REGION": "eu"},
+			StateSecretNames:  stateSecretNames,
+			OutputsSecretName: "myitem-outputs",
+		}
+	}
+
+	run := func(input *runner.Input) error {
+		return runner.New(kubeClient, input, GinkgoT().TempDir()).WithOutput(io.Discard, io.Discard).Run(ctx)
+	}
+
+	readInvocations := func() []string {
+		data, err := os.ReadFile(invocations)
+		Expect(err).ToNot(HaveOccurred())
+		return strings.Split(strings.TrimSpace(string(data)), "\n")
+	}
+
+	readOutputs := func() map[string]interface{} {
+		secret := &corev1.Secret{}
+		Expect(kubeClient.Get(ctx, client.ObjectKey{Name: "myitem-outputs", Namespace: "ls-system"}, secret)).To(Succeed())
+		outputs := map[string]interface{}{}
+		Expect(json.Unmarshal(secret.Data[runner.OutputsSecretKey], &outputs)).To(Succeed())
+		return outputs
+	}
+
+	It("should init, plan and apply the configuration and write the outputs", func() {
+		Expect(run(newInput(terraformv1alpha1.OperationApply))).To(Succeed())
+
+		Expect(readInvocations()).To(Equal([]string{
+			"init -input=false -no-color",
+			"plan -input=false -no-color -out=tfplan",
+			"apply -input=false -no-color -auto-approve tfplan",
+			"output -json -no-color",
+		}))
+		Expect(readOutputs()).To(Equal(map[string]interface{}{
+			"applies": float64(1),
+			"region":  "eu",
+		}))
+	})
+
+	It("should restore the terraform state of previous runs", func() {
+		Expect(run(newInput(terraformv1alpha1.OperationApply))).To(Succeed())
+		Expect(run(newInput(terraformv1alpha1.OperationApply))).To(Succeed())
+		Expect(run(newInput(terraformv1alpha1.OperationApply))).To(Succeed())
+		Expect(readOutputs()).To(HaveKeyWithValue("applies", float64(3)))
+
+		// the small state fits into the first state secret
+		secret := &corev1.Secret{}
+		Expect(kubeClient.Get(ctx, client.ObjectKey{Name: "myitem-state-0", Namespace: "ls-system"}, secret)).To(Succeed())
+		Expect(secret.Data[lsv1alpha1.DataObjectSecretDataKey]).ToNot(BeEmpty())
+		Expect(kubeClient.Get(ctx, client.ObjectKey{Name: "myitem-state-1", Namespace: "ls-system"}, secret)).To(Succeed())
+		Expect(secret.Data[lsv1alpha1.DataObjectSecretDataKey]).To(BeEmpty())
+	})
+
+	It("should fail if a state secret does not exist", func() {
+		input := newInput(terraformv1alpha1.OperationApply)
+		input.StateSecretNames = append(input.StateSecretNames, "myitem-state-2")
+		err := run(input)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("myitem-state-2"))
+	})
+
+	It("should write the configuration only accessible by the runner", func() {
+		script := "#!/bin/sh\n" +
+			"[ \"$1\" = init ] && stat -c %a . modules main.tf zz_landscaper_variables.auto.tfvars.json > \"$INVOCATIONS\"\n" +
+			"exit 0\n"
+		Expect(os.WriteFile(filepath.Join(binDir, "terraform"), []byte(script), 0755)).To(Succeed())
+
+		Expect(run(newInput(terraformv1alpha1.OperationDestroy))).To(Succeed())
+		Expect(readInvocations()).To(Equal([]string{"700", "700", "600", "600"}))
+	})
+
+	It("should plan and apply a destroy", func() {
+		Expect(run(newInput(terraformv1alpha1.OperationDestroy))).To(Succeed())
+
+		Expect(readInvocations()).To(Equal([]string{
+			"init -input=false -no-color",
+			"plan -input=false -no-color -out=tfplan -destroy",
+			"apply -input=false -no-color -auto-approve tfplan",
+		}))
+	})
+
+	It("should pass the target as kubeconfig and as variable", func() {
+		script := "#!/bin/sh\n" +
+			"[ \"$1\" = init ] && { cat \"$KUBE_CONFIG_PATH\"; echo; echo \"$TF_VAR_cluster\"; } > \"$INVOCATIONS\"\n" +
+			"exit 0\n"
+		Expect(os.WriteFile(filepath.Join(binDir, "terraform"), []byte(script), 0755)).To(Succeed())
+
+		input := newInput(terraformv1alpha1.OperationDestroy)
+		input.Kubeconfig = "apiVersion: v1\nkind: Config"
+		input.TargetVariable = "cluster"
+		input.TargetConfig = json.RawMessage(`{"kubeconfig":"abc"}`)
+		Expect(run(input)).To(Succeed())
+
+		Expect(readInvocations()).To(Equal([]string{
+			"apiVersion: v1",
+			"kind: Config",
+			`{"kubeconfig":"abc"}`,
+		}))
+	})
+
+	It("should return an error and keep the state if terraform fails", func() {
+		Expect(run(newInput(terraformv1alpha1.OperationApply))).To(Succeed())
+
+		input := newInput(terraformv1alpha1.OperationApply)
+		input.Files["zz.tf"] = "invalid"
+		script := "#!/bin/sh\n[ \"$1\" = plan ] && exit 1\nexit 0\n"
+		Expect(os.WriteFile(filepath.Join(binDir, "terraform"), []byte(script), 0755)).To(Succeed())
+		err := run(input)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("plan failed"))
+
+		Expect(os.WriteFile(filepath.Join(binDir, "terraform"), []byte(fakeTerraform), 0755)).To(Succeed())
+		Expect(run(newInput(terraformv1alpha1.OperationApply))).To(Succeed())
+		Expect(readOutputs()).To(HaveKeyWithValue("applies", float64(2)))
+	})
+
+})
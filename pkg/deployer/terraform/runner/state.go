@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package runner
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+const (
+	// StateSecretCount is the number of secrets the terraform state of a deploy item is stored in.
+	StateSecretCount = 8
+	// stateChunkSize is the maximal size of the compressed state that is stored in one secret.
+	stateChunkSize = corev1.MaxSecretSize
+)
+
+// restoreState restores the terraform state from the state secrets to the given file.
+// The compressed state is split into chunks in the order of the secrets, the first empty secret ends the state.
+// Nothing is written if no state has been stored yet.
+func (r *Runner) restoreState(ctx context.Context, stateFile string) error {
+	var data bytes.Buffer
+	for _, name := range r.input.StateSecretNames {
+		secret := &corev1.Secret{}
+		if err := r.kubeClient.Get(ctx, client.ObjectKey{Name: name, Namespace: r.input.Namespace}, secret); err != nil {
+			return fmt.Errorf("unable to get state secret %s: %w", name, err)
+		}
+		chunk := secret.Data[lsv1alpha1.DataObjectSecretDataKey]
+		if len(chunk) == 0 {
+			break
+		}
+		data.Write(chunk)
+	}
+	if data.Len() == 0 {
+		return nil
+	}
+
+	reader, err := gzip.NewReader(&data)
+	if err != nil {
+		return fmt.Errorf("unable to decompress state: %w", err)
+	}
+	state, err := io.ReadAll(reader)
+	if err != nil {
+		return fmt.Errorf("unable to decompress state: %w", err)
+	}
+	return os.WriteFile(stateFile, state, 0600)
+}
+
+// backupState compresses the given state file and stores it in the state secrets.
+// Secrets that are not needed for the current state are emptied.
+func (r *Runner) backupState(ctx context.Context, stateFile string) error {
+	state, err := os.ReadFile(stateFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("unable to read state: %w", err)
+	}
+
+	var data bytes.Buffer
+	writer := gzip.NewWriter(&data)
+	if _, err := writer.Write(state); err != nil {
+		return fmt.Errorf("unable to compress state: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("unable to compress state: %w", err)
+	}
+	if data.Len() > len(r.input.StateSecretNames)*stateChunkSize {
+		return fmt.Errorf("the compressed state of %d bytes exceeds the maximal size of %d bytes",
+			data.Len(), len(r.input.StateSecretNames)*stateChunkSize)
+	}
+
+	for _, name := range r.input.StateSecretNames {
+		secret := &corev1.Secret{}
+		if err := r.kubeClient.Get(ctx, client.ObjectKey{Name: name, Namespace: r.input.Namespace}, secret); err != nil {
+			return fmt.Errorf("unable to get state secret %s: %w", name, err)
+		}
+		secret.Data = map[string][]byte{
+			lsv1alpha1.DataObjectSecretDataKey: data.Next(stateChunkSize),
+		}
+		if err := r.kubeClient.Update(ctx, secret); err != nil {
+			return fmt.Errorf("unable to update state secret %s: %w", name, err)
+		}
+	}
+	return nil
+}
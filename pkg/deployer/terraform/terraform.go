@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package terraform
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	sigsyaml "sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	terraforminstall "github.com/gardener/landscaper/apis/deployer/terraform/install"
+	terraformv1alpha1 "github.com/gardener/landscaper/apis/deployer/terraform/v1alpha1"
+	terraformvalidation "github.com/gardener/landscaper/apis/deployer/terraform/validation"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/deployer/lib"
+	"github.com/gardener/landscaper/pkg/deployer/terraform/runner"
+	"github.com/gardener/landscaper/pkg/utils"
+)
+
+const (
+	Type lsv1alpha1.DeployItemType = "landscaper.gardener.cloud/terraform"
+	Name string                    = "terraform.deployer.landscaper.gardener.cloud"
+)
+
+var Scheme = runtime.NewScheme()
+
+func init() {
+	terraforminstall.Install(Scheme)
+}
+
+// Terraform is the internal representation of a DeployItem of Type Terraform
+type Terraform struct {
+	lsKubeClient   client.Client
+	hostKubeClient client.Client
+	Configuration  *terraformv1alpha1.Configuration
+
+	DeployItem            *lsv1alpha1.DeployItem
+	Target                *lsv1alpha1.ResolvedTarget
+	Context               *lsv1alpha1.Context
+	ProviderConfiguration *terraformv1alpha1.ProviderConfiguration
+	ProviderStatus        *terraformv1alpha1.ProviderStatus
+}
+
+// NewDeployItemBuilder creates a new deployitem builder for terraform deployitems
+func NewDeployItemBuilder() *utils.DeployItemBuilder {
+	return utils.NewDeployItemBuilder(string(Type)).Scheme(Scheme)
+}
+
+// New creates a new internal terraform item
+func New(lsKubeClient client.Client,
+	hostKubeClient client.Client,
+	configuration *terraformv1alpha1.Configuration,
+	item *lsv1alpha1.DeployItem,
+	rt *lsv1alpha1.ResolvedTarget) (*Terraform, error) {
+
+	config := &terraformv1alpha1.ProviderConfiguration{}
+	currOp := "InitTerraformOperation"
+	terraformDecoder := api.NewDecoder(Scheme)
+	if _, _, err := terraformDecoder.Decode(item.Spec.Configuration.Raw, nil, config); err != nil {
+		return nil, lserrors.NewWrappedError(err,
+			currOp, "ParseProviderConfiguration", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+
+	if err := terraformvalidation.ValidateProviderConfiguration(config); err != nil {
+		return nil, lserrors.NewWrappedError(err,
+			currOp, "ValidateProviderConfiguration", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+
+	var status *terraformv1alpha1.ProviderStatus
+	if item.Status.ProviderStatus != nil {
+		status = &terraformv1alpha1.ProviderStatus{}
+		if _, _, err := terraformDecoder.Decode(item.Status.ProviderStatus.Raw, nil, status); err != nil {
+			return nil, lserrors.NewWrappedError(err,
+				currOp, "ParseProviderStatus", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+		}
+	}
+
+	return &Terraform{
+		lsKubeClient:          lsKubeClient,
+		hostKubeClient:        hostKubeClient,
+		Configuration:         configuration,
+		DeployItem:            item,
+		Target:                rt,
+		ProviderConfiguration: config,
+		ProviderStatus:        status,
+	}, nil
+}
+
+// ResourceName returns the name of the resources in the host cluster that belong to the deploy item.
+func ResourceName(item *lsv1alpha1.DeployItem) string {
+	return fmt.Sprintf("terraform-%s-%s", item.Namespace, item.Name)
+}
+
+// InputSecretName returns the name of the secret that contains the runner input of the deploy item.
+func InputSecretName(item *lsv1alpha1.DeployItem) string {
+	return ResourceName(item) + "-input"
+}
+
+// OutputsSecretName returns the name of the secret that contains the terraform outputs of the deploy item.
+func OutputsSecretName(item *lsv1alpha1.DeployItem) string {
+	return ResourceName(item) + "-outputs"
+}
+
+// StateSecretNames returns the names of the secrets that store the terraform state of the deploy item.
+// The secrets are created in advance so that the runner only needs access to these secrets by name.
+func StateSecretNames(item *lsv1alpha1.DeployItem) []string {
+	names := make([]string, runner.StateSecretCount)
+	for i := range names {
+		names[i] = fmt.Sprintf("%s-state-%d", ResourceName(item), i)
+	}
+	return names
+}
+
+// Image returns the runner image of the deploy item.
+func (t *Terraform) Image() (string, error) {
+	if len(t.ProviderConfiguration.Image) != 0 {
+		return t.ProviderConfiguration.Image, nil
+	}
+	if len(t.Configuration.DefaultImage) != 0 {
+		return t.Configuration.DefaultImage, nil
+	}
+	return "", errors.New("neither the deploy item nor the deployer define a runner image")
+}
+
+// RunnerInput returns the input of the runner for the given operation.
+// The target of the deploy item is passed as kubeconfig for kubernetes cluster targets
+// and as input variable if a target variable is configured.
+func (t *Terraform) RunnerInput(ctx context.Context, op terraformv1alpha1.Operation) (*runner.Input, error) {
+	input := &runner.Input{
+		DeployItem: lsv1alpha1.ObjectReference{
+			Name:      t.DeployItem.Name,
+			Namespace: t.DeployItem.Namespace,
+		},
+		Namespace:        t.Configuration.Namespace,
+		Operation:        op,
+		Binary:           t.ProviderConfiguration.Binary,
+		Files:            t.ProviderConfiguration.Files,
+		Env:              t.ProviderConfiguration.Env,
+		TargetVariable:   t.ProviderConfiguration.TargetVariable,
+		StateSecretNames: StateSecretNames(t.DeployItem),
+	}
+	if t.ProviderConfiguration.Variables != nil {
+		input.Variables = t.ProviderConfiguration.Variables.Raw
+	}
+	if op == terraformv1alpha1.OperationApply {
+		input.OutputsSecretName = OutputsSecretName(t.DeployItem)
+	}
+
+	if t.Target == nil || t.Target.Target == nil {
+		if len(t.ProviderConfiguration.TargetVariable) != 0 {
+			return nil, errors.New("a target variable is defined but the deploy item has no target")
+		}
+		return input, nil
+	}
+
+	if t.Target.Spec.Type == targettypes.KubernetesClusterTargetType || t.Target.Spec.Type == targettypes.KubernetesClusterTokenRequestTargetType {
+		targetConfig := &targettypes.KubernetesClusterTargetConfig{}
+		if err := yaml.Unmarshal([]byte(t.Target.Content), targetConfig); err != nil {
+			return nil, fmt.Errorf("unable to parse target configuration: %w", err)
+		}
+		kubeconfig, err := lib.GetKubeconfigFromTargetConfig(ctx, targetConfig, t.Target.Namespace, t.lsKubeClient)
+		if err != nil {
+			return nil, err
+		}
+		input.Kubeconfig = string(kubeconfig)
+	}
+
+	if len(t.ProviderConfiguration.TargetVariable) != 0 {
+		targetConfig, err := sigsyaml.YAMLToJSON([]byte(t.Target.Content))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse target configuration: %w", err)
+		}
+		input.TargetConfig = json.RawMessage(targetConfig)
+	}
+	return input, nil
+}
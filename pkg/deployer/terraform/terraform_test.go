@@ -0,0 +1,311 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package terraform_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	terraformv1alpha1 "github.com/gardener/landscaper/apis/deployer/terraform/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/deployer/terraform"
+	"github.com/gardener/landscaper/pkg/deployer/terraform/runner"
+)
+
+var _ = Describe("Terraform", func() {
+
+	var (
+		ctx        context.Context
+		lsClient   client.Client
+		hostClient *runnerClient
+		config     *terraformv1alpha1.Configuration
+	)
+
+	BeforeEach(func() {
+		ctx = logging.NewContextWithDiscard(context.Background())
+		lsClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+		hostClient = &runnerClient{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(),
+			phase:  corev1.PodSucceeded,
+		}
+		config = &terraformv1alpha1.Configuration{
+			Namespace:    "ls-system",
+			DefaultImage: "terraform-runner:v1",
+		}
+	})
+
+	newDeployItem := func(config *terraformv1alpha1.ProviderConfiguration) *lsv1alpha1.DeployItem {
+		if config.Timeout == nil {
+			config.Timeout = &lsv1alpha1.Duration{Duration: 10 * time.Millisecond}
+		}
+		if config.Files == nil {
+			config.Files = map[string]string{"main.tf": `resource "null_resource" "test" {}`}
+		}
+		item, err := terraform.NewDeployItemBuilder().
+			Key("default", "myitem").
+			ProviderConfig(config).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		item.Finalizers = []string{lsv1alpha1.LandscaperFinalizer}
+		Expect(lsClient.Create(ctx, item)).To(Succeed())
+		return item
+	}
+
+	newTerraform := func(item *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) *terraform.Terraform {
+		tf, err := terraform.New(lsClient, hostClient, config, item, rt)
+		Expect(err).ToNot(HaveOccurred())
+		return tf
+	}
+
+	providerStatus := func(item *lsv1alpha1.DeployItem) *terraformv1alpha1.ProviderStatus {
+		status := &terraformv1alpha1.ProviderStatus{}
+		Expect(json.Unmarshal(item.Status.ProviderStatus.Raw, status)).To(Succeed())
+		return status
+	}
+
+	errorCodes := func(err error) []lsv1alpha1.ErrorCode {
+		lsErr, ok := err.(lserrors.LsError)
+		Expect(ok).To(BeTrue())
+		return lsErr.LandscaperError().Codes
+	}
+
+	podKey := kutil.ObjectKey("terraform-default-myitem", "ls-system")
+
+	It("should apply the terraform configuration in a runner pod", func() {
+		item := newDeployItem(&terraformv1alpha1.ProviderConfiguration{
+			Variables: &runtime.RawExtension{Raw: []byte(`{"name":"test"}`)},
+		})
+		Expect(newTerraform(item, nil).Reconcile(ctx)).To(Succeed())
+		Expect(item.Status.Phase).To(Equal(lsv1alpha1.ExecutionPhaseSucceeded))
+
+		pod := &corev1.Pod{}
+		Expect(hostClient.Get(ctx, podKey, pod)).To(Succeed())
+		Expect(pod.Spec.ServiceAccountName).To(Equal("terraform-default-myitem"))
+		Expect(pod.Spec.Containers[0].Image).To(Equal("terraform-runner:v1"))
+		Expect(pod.Labels).To(HaveKeyWithValue(terraformv1alpha1.DeployItemNameLabel, "myitem"))
+		Expect(hostClient.Get(ctx, podKey, &rbacv1.RoleBinding{})).To(Succeed())
+
+		// the runner may only access the state and outputs secrets of the deploy item
+		role := &rbacv1.Role{}
+		Expect(hostClient.Get(ctx, podKey, role)).To(Succeed())
+		Expect(role.Rules).To(HaveLen(1))
+		Expect(role.Rules[0].Verbs).To(ConsistOf("get", "update"))
+		Expect(role.Rules[0].ResourceNames).To(ConsistOf(append(terraform.StateSecretNames(item), terraform.OutputsSecretName(item))))
+		for _, name := range role.Rules[0].ResourceNames {
+			Expect(hostClient.Get(ctx, kutil.ObjectKey(name, "ls-system"), &corev1.Secret{})).To(Succeed())
+		}
+
+		Expect(hostClient.input.Operation).To(Equal(terraformv1alpha1.OperationApply))
+		Expect(hostClient.input.Binary).To(Equal("terraform"))
+		Expect(hostClient.input.Namespace).To(Equal("ls-system"))
+		Expect(hostClient.input.StateSecretNames).To(Equal(terraform.StateSecretNames(item)))
+		Expect(hostClient.input.Files).To(HaveKey("main.tf"))
+		Expect(hostClient.input.Variables).To(MatchJSON(`{"name":"test"}`))
+
+		status := providerStatus(item)
+		Expect(status.Phase).To(Equal(terraformv1alpha1.RunPhaseSucceeded))
+		Expect(status.LastOperation).To(Equal(terraformv1alpha1.OperationApply))
+		Expect(status.Pod).To(Equal("terraform-default-myitem"))
+		Expect(status.CompletionTime).ToNot(BeNil())
+	})
+
+	It("should replace the runner pod of a previous run", func() {
+		item := newDeployItem(&terraformv1alpha1.ProviderConfiguration{})
+		Expect(newTerraform(item, nil).Reconcile(ctx)).To(Succeed())
+		Expect(newTerraform(item, nil).Reconcile(ctx)).To(Succeed())
+		Expect(hostClient.runs).To(Equal(2))
+		Expect(hostClient.Get(ctx, podKey, &corev1.Pod{})).To(Succeed())
+	})
+
+	It("should not start a new run while the runner pod of a previous run is running", func() {
+		item := newDeployItem(&terraformv1alpha1.ProviderConfiguration{})
+		hostClient.phase = corev1.PodRunning
+		err := newTerraform(item, nil).Reconcile(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(errorCodes(err)).To(ContainElement(lsv1alpha1.ErrorTimeout))
+		Expect(providerStatus(item).Phase).To(Equal(terraformv1alpha1.RunPhaseRunning))
+
+		hostClient.phase = corev1.PodSucceeded
+		err = newTerraform(item, nil).Reconcile(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(errorCodes(err)).To(ContainElement(lsv1alpha1.ErrorTimeout))
+		Expect(hostClient.runs).To(Equal(1))
+	})
+
+	It("should fail if terraform fails", func() {
+		item := newDeployItem(&terraformv1alpha1.ProviderConfiguration{})
+		hostClient.phase = corev1.PodFailed
+		hostClient.message = "Error: Invalid resource type"
+
+		err := newTerraform(item, nil).Reconcile(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(errorCodes(err)).To(ContainElement(lsv1alpha1.ErrorTerraformFailed))
+		Expect(providerStatus(item).Phase).To(Equal(terraformv1alpha1.RunPhaseFailed))
+		Expect(providerStatus(item).Message).To(Equal("Error: Invalid resource type"))
+	})
+
+	It("should export terraform outputs", func() {
+		item := newDeployItem(&terraformv1alpha1.ProviderConfiguration{
+			Exports: []terraformv1alpha1.Export{
+				{Key: "endpoint", FromOutput: "url"},
+			},
+		})
+		hostClient.outputs = map[string]interface{}{"url": "https://example.com", "other": 1}
+		Expect(newTerraform(item, nil).Reconcile(ctx)).To(Succeed())
+		Expect(item.Status.ExportReference).ToNot(BeNil())
+
+		export := &corev1.Secret{}
+		Expect(lsClient.Get(ctx, item.Status.ExportReference.NamespacedName(), export)).To(Succeed())
+		var exportData map[string]interface{}
+		Expect(json.Unmarshal(export.Data[lsv1alpha1.DataObjectSecretDataKey], &exportData)).To(Succeed())
+		Expect(exportData).To(Equal(map[string]interface{}{"endpoint": "https://example.com"}))
+	})
+
+	It("should fail if an exported output does not exist", func() {
+		item := newDeployItem(&terraformv1alpha1.ProviderConfiguration{
+			Exports: []terraformv1alpha1.Export{
+				{Key: "endpoint", FromOutput: "url"},
+			},
+		})
+		hostClient.outputs = map[string]interface{}{}
+		Expect(newTerraform(item, nil).Reconcile(ctx)).ToNot(Succeed())
+	})
+
+	It("should pass a kubernetes cluster target to the runner", func() {
+		item := newDeployItem(&terraformv1alpha1.ProviderConfiguration{TargetVariable: "cluster"})
+		kubeconfig := "apiVersion: v1\nkind: Config\n"
+		targetConfig, err := json.Marshal(targettypes.KubernetesClusterTargetConfig{
+			Kubeconfig: targettypes.ValueRef{StrVal: &kubeconfig},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		rt := &lsv1alpha1.ResolvedTarget{
+			Target: &lsv1alpha1.Target{
+				Spec: lsv1alpha1.TargetSpec{Type: targettypes.KubernetesClusterTargetType},
+			},
+			Content: string(targetConfig),
+		}
+
+		Expect(newTerraform(item, rt).Reconcile(ctx)).To(Succeed())
+		Expect(hostClient.input.Kubeconfig).To(Equal(kubeconfig))
+		Expect(hostClient.input.TargetVariable).To(Equal("cluster"))
+		Expect(hostClient.input.TargetConfig).To(MatchJSON(targetConfig))
+	})
+
+	It("should fail if no runner image is defined", func() {
+		config.DefaultImage = ""
+		item := newDeployItem(&terraformv1alpha1.ProviderConfiguration{})
+		err := newTerraform(item, nil).Reconcile(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(errorCodes(err)).To(ContainElement(lsv1alpha1.ErrorConfigurationProblem))
+	})
+
+	It("should destroy the resources and cleanup the host cluster on delete", func() {
+		item := newDeployItem(&terraformv1alpha1.ProviderConfiguration{})
+		Expect(newTerraform(item, nil).Reconcile(ctx)).To(Succeed())
+
+		Expect(newTerraform(item, nil).Delete(ctx)).To(Succeed())
+		Expect(hostClient.input.Operation).To(Equal(terraformv1alpha1.OperationDestroy))
+		Expect(hostClient.input.OutputsSecretName).To(BeEmpty())
+		Expect(item.Finalizers).ToNot(ContainElement(lsv1alpha1.LandscaperFinalizer))
+
+		for _, obj := range []client.Object{&corev1.Pod{}, &corev1.ServiceAccount{}, &rbacv1.Role{}, &rbacv1.RoleBinding{}} {
+			err := hostClient.Get(ctx, podKey, obj)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		}
+		for _, name := range append(terraform.StateSecretNames(item), terraform.InputSecretName(item), terraform.OutputsSecretName(item)) {
+			err := hostClient.Get(ctx, kutil.ObjectKey(name, "ls-system"), &corev1.Secret{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		}
+	})
+
+	It("should not run terraform on delete if it has never run", func() {
+		item := newDeployItem(&terraformv1alpha1.ProviderConfiguration{})
+		Expect(newTerraform(item, nil).Delete(ctx)).To(Succeed())
+		Expect(hostClient.input).To(BeNil())
+		Expect(item.Finalizers).ToNot(ContainElement(lsv1alpha1.LandscaperFinalizer))
+	})
+
+	It("should reject a provider configuration without files", func() {
+		item := &lsv1alpha1.DeployItem{}
+		item.Spec.Configuration = &runtime.RawExtension{
+			Raw: []byte(`{"apiVersion": "terraform.deployer.landscaper.gardener.cloud/v1alpha1", "kind": "ProviderConfiguration"}`),
+		}
+		_, err := terraform.New(lsClient, hostClient, config, item, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(errorCodes(err)).To(ContainElement(lsv1alpha1.ErrorConfigurationProblem))
+	})
+})
+
+// runnerClient is a client that simulates the runner.
+// Created runner pods immediately get the configured phase and a successful runner writes the configured outputs.
+type runnerClient struct {
+	client.Client
+	phase   corev1.PodPhase
+	message string
+	outputs map[string]interface{}
+
+	input *runner.Input
+	runs  int
+}
+
+func (c *runnerClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return c.Client.Create(ctx, obj, opts...)
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.Client.Get(ctx, kutil.ObjectKey(pod.Spec.Volumes[0].Secret.SecretName, pod.Namespace), secret); err != nil {
+		return err
+	}
+	c.runs++
+	c.input = &runner.Input{}
+	if err := json.Unmarshal(secret.Data[runner.InputSecretKey], c.input); err != nil {
+		return err
+	}
+
+	pod.Status.Phase = c.phase
+	if c.phase == corev1.PodFailed {
+		pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+			{
+				Name: "terraform",
+				State: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Message: c.message + "\n"},
+				},
+			},
+		}
+	}
+	if c.phase == corev1.PodSucceeded && c.outputs != nil {
+		data, err := json.Marshal(c.outputs)
+		if err != nil {
+			return err
+		}
+		outputs := &corev1.Secret{}
+		if err := c.Client.Get(ctx, kutil.ObjectKey(c.input.OutputsSecretName, c.input.Namespace), outputs); err != nil {
+			return err
+		}
+		outputs.Data = map[string][]byte{runner.OutputsSecretKey: data}
+		if err := c.Client.Update(ctx, outputs); err != nil {
+			return err
+		}
+	}
+	return c.Client.Create(ctx, obj, opts...)
+}
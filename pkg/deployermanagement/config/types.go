@@ -24,6 +24,8 @@ const (
 	ContainerDeployerType = "landscaper.gardener.cloud/container"
 	HelmDeployerType      = "landscaper.gardener.cloud/helm"
 	ManifestDeployerType  = "landscaper.gardener.cloud/kubernetes-manifest"
+	JobDeployerType       = "landscaper.gardener.cloud/job"
+	TerraformDeployerType = "landscaper.gardener.cloud/terraform"
 	MockDeployerType      = "landscaper.gardener.cloud/mock"
 )
 
@@ -53,6 +55,16 @@ var DefaultDeployerConfiguration = map[string]DeployerApplyArgs{
 		ComponentName: "github.com/gardener/landscaper/manifest-deployer",
 		ResourceName:  "manifest-deployer-blueprint",
 	},
+	"job": {
+		Type:          JobDeployerType,
+		ComponentName: "github.com/gardener/landscaper/job-deployer",
+		ResourceName:  "job-deployer-blueprint",
+	},
+	"terraform": {
+		Type:          TerraformDeployerType,
+		ComponentName: "github.com/gardener/landscaper/terraform-deployer",
+		ResourceName:  "terraform-deployer-blueprint",
+	},
 	"mock": {
 		Type:          MockDeployerType,
 		ComponentName: "github.com/gardener/landscaper/mock-deployer",
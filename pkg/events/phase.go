@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+)
+
+// Reasons of the events that are emitted on phase transitions.
+const (
+	ReconcileStartedReason   = "ReconcileStarted"
+	ReconcileSucceededReason = "ReconcileSucceeded"
+	ReconcileFailedReason    = "ReconcileFailed"
+	DeletionStartedReason    = "DeletionStarted"
+	DeletionTriggeredReason  = "DeletionTriggered"
+	DeletionFailedReason     = "DeletionFailed"
+
+	SubobjectsUpdatedReason               = "SubobjectsUpdated"
+	OrphanedSubinstallationsDeletedReason = "OrphanedSubinstallationsDeleted"
+	SubobjectsTriggeredReason             = "SubobjectsTriggered"
+	SubobjectsSucceededReason             = "SubobjectsSucceeded"
+	DeletionPreparedReason                = "DeletionPrepared"
+
+	DeployItemsCreatedReason   = "DeployItemsCreated"
+	DeployItemsSucceededReason = "DeployItemsSucceeded"
+
+	DeployItemProgressingReason = "DeployItemProgressing"
+	DeployItemSucceededReason   = "DeployItemSucceeded"
+	DeployItemFailedReason      = "DeployItemFailed"
+	DeployItemTimeoutReason     = "DeployItemTimeout"
+	ReadinessCheckTimeoutReason = "ReadinessCheckTimeout"
+)
+
+type phaseEvent struct {
+	eventType string
+	reason    string
+	message   string
+}
+
+var installationPhaseEvents = map[lsv1alpha1.InstallationPhase]phaseEvent{
+	lsv1alpha1.InstallationPhaseInit: {corev1.EventTypeNormal, ReconcileStartedReason,
+		"started job %s"},
+	lsv1alpha1.InstallationPhaseCleanupOrphaned: {corev1.EventTypeNormal, SubobjectsUpdatedReason,
+		"imports of job %s are satisfied and the subinstallations and the execution have been updated"},
+	lsv1alpha1.InstallationPhaseObjectsCreated: {corev1.EventTypeNormal, OrphanedSubinstallationsDeletedReason,
+		"orphaned subinstallations of job %s have been deleted"},
+	lsv1alpha1.InstallationPhaseProgressing: {corev1.EventTypeNormal, SubobjectsTriggeredReason,
+		"the subinstallations and the execution have been triggered for job %s"},
+	lsv1alpha1.InstallationPhaseCompleting: {corev1.EventTypeNormal, SubobjectsSucceededReason,
+		"the subinstallations and the execution have succeeded for job %s"},
+	lsv1alpha1.InstallationPhaseSucceeded: {corev1.EventTypeNormal, ReconcileSucceededReason,
+		"job %s succeeded"},
+	lsv1alpha1.InstallationPhaseFailed: {corev1.EventTypeWarning, ReconcileFailedReason,
+		"job %s failed"},
+	lsv1alpha1.InstallationPhaseInitDelete: {corev1.EventTypeNormal, DeletionStartedReason,
+		"started deletion with job %s"},
+	lsv1alpha1.InstallationPhaseTriggerDelete: {corev1.EventTypeNormal, DeletionPreparedReason,
+		"the deletion of the subinstallations and the execution has been prepared for job %s"},
+	lsv1alpha1.InstallationPhaseDeleting: {corev1.EventTypeNormal, DeletionTriggeredReason,
+		"the deletion of the subinstallations and the execution has been triggered for job %s"},
+	lsv1alpha1.InstallationPhaseDeleteFailed: {corev1.EventTypeWarning, DeletionFailedReason,
+		"deletion with job %s failed"},
+}
+
+var executionPhaseEvents = map[lsv1alpha1.ExecPhase]phaseEvent{
+	lsv1alpha1.ExecPhaseInit: {corev1.EventTypeNormal, ReconcileStartedReason,
+		"started job %s"},
+	lsv1alpha1.ExecPhaseProgressing: {corev1.EventTypeNormal, DeployItemsCreatedReason,
+		"the deploy items have been created or updated for job %s"},
+	lsv1alpha1.ExecPhaseCompleting: {corev1.EventTypeNormal, DeployItemsSucceededReason,
+		"all deploy items have succeeded for job %s"},
+	lsv1alpha1.ExecPhaseSucceeded: {corev1.EventTypeNormal, ReconcileSucceededReason,
+		"job %s succeeded"},
+	lsv1alpha1.ExecPhaseFailed: {corev1.EventTypeWarning, ReconcileFailedReason,
+		"job %s failed"},
+	lsv1alpha1.ExecPhaseInitDelete: {corev1.EventTypeNormal, DeletionStartedReason,
+		"started deletion with job %s"},
+	lsv1alpha1.ExecPhaseDeleting: {corev1.EventTypeNormal, DeletionTriggeredReason,
+		"the deletion of the deploy items has been triggered for job %s"},
+	lsv1alpha1.ExecPhaseDeleteFailed: {corev1.EventTypeWarning, DeletionFailedReason,
+		"deletion with job %s failed"},
+}
+
+var deployItemPhaseEvents = map[lsv1alpha1.DeployItemPhase]phaseEvent{
+	lsv1alpha1.DeployItemPhaseInit: {corev1.EventTypeNormal, ReconcileStartedReason,
+		"started job %s"},
+	lsv1alpha1.DeployItemPhaseProgressing: {corev1.EventTypeNormal, DeployItemProgressingReason,
+		"the deployer is processing job %s"},
+	lsv1alpha1.DeployItemPhaseSucceeded: {corev1.EventTypeNormal, DeployItemSucceededReason,
+		"job %s succeeded"},
+	lsv1alpha1.DeployItemPhaseFailed: {corev1.EventTypeWarning, DeployItemFailedReason,
+		"job %s failed"},
+	lsv1alpha1.DeployItemPhaseDeleting: {corev1.EventTypeNormal, DeletionStartedReason,
+		"the deployer is deleting the deployed resources with job %s"},
+}
+
+// RecordInstallationPhaseTransition emits an event for the transition of an installation from oldPhase to its current phase.
+func RecordInstallationPhaseTransition(recorder record.EventRecorder, inst *lsv1alpha1.Installation, oldPhase lsv1alpha1.InstallationPhase) {
+	newPhase := inst.Status.InstallationPhase
+	if oldPhase == newPhase {
+		return
+	}
+	if event, ok := installationPhaseEvents[newPhase]; ok {
+		event.record(recorder, inst, inst.Status.JobID, inst.Status.LastError)
+	}
+}
+
+// RecordExecutionPhaseTransition emits an event for the transition of an execution from oldPhase to its current phase.
+func RecordExecutionPhaseTransition(recorder record.EventRecorder, exec *lsv1alpha1.Execution, oldPhase lsv1alpha1.ExecPhase) {
+	newPhase := exec.Status.ExecutionPhase
+	if oldPhase == newPhase {
+		return
+	}
+	if event, ok := executionPhaseEvents[newPhase]; ok {
+		event.record(recorder, exec, exec.Status.JobID, exec.Status.LastError)
+	}
+}
+
+// RecordDeployItemPhaseTransition emits an event for the transition of a deploy item from oldPhase to its current phase.
+// Failures caused by a timeout are reported with a dedicated reason.
+func RecordDeployItemPhaseTransition(recorder record.EventRecorder, di *lsv1alpha1.DeployItem, oldPhase lsv1alpha1.DeployItemPhase) {
+	newPhase := di.Status.DeployItemPhase
+	if oldPhase == newPhase {
+		return
+	}
+	event, ok := deployItemPhaseEvents[newPhase]
+	if !ok {
+		return
+	}
+
+	lastErr := di.Status.GetLastError()
+	if newPhase == lsv1alpha1.DeployItemPhaseFailed && lastErr != nil {
+		if lserrors.HasErrorCode(lastErr.Codes, lsv1alpha1.ErrorReadinessCheckTimeout) {
+			event.reason = ReadinessCheckTimeoutReason
+		} else if lserrors.HasErrorCode(lastErr.Codes, lsv1alpha1.ErrorTimeout) {
+			event.reason = DeployItemTimeoutReason
+		}
+	}
+	event.record(recorder, di, di.Status.GetJobID(), lastErr)
+}
+
+// record emits the event for the given object.
+// The last error is appended to the message of warnings.
+func (e phaseEvent) record(recorder record.EventRecorder, obj runtime.Object, jobID string, lastErr *lsv1alpha1.Error) {
+	if recorder == nil {
+		return
+	}
+	msg := fmt.Sprintf(e.message, jobID)
+	if e.eventType == corev1.EventTypeWarning && lastErr != nil {
+		msg = fmt.Sprintf("%s: %s", msg, lastErr.Message)
+	}
+	recorder.Event(obj, e.eventType, e.reason, msg)
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package events_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/tools/record"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/events"
+)
+
+var _ = Describe("Phase Events", func() {
+
+	var recorder *record.FakeRecorder
+
+	BeforeEach(func() {
+		recorder = record.NewFakeRecorder(10)
+	})
+
+	It("should not emit an event if the phase has not changed", func() {
+		inst := &lsv1alpha1.Installation{}
+		inst.Status.InstallationPhase = lsv1alpha1.InstallationPhaseProgressing
+
+		events.RecordInstallationPhaseTransition(recorder, inst, lsv1alpha1.InstallationPhaseProgressing)
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should emit a normal event for a phase transition of an installation", func() {
+		inst := &lsv1alpha1.Installation{}
+		inst.Status.JobID = "job1"
+		inst.Status.InstallationPhase = lsv1alpha1.InstallationPhaseCleanupOrphaned
+
+		events.RecordInstallationPhaseTransition(recorder, inst, lsv1alpha1.InstallationPhaseInit)
+		Expect(recorder.Events).To(Receive(And(
+			HavePrefix("Normal "+events.SubobjectsUpdatedReason),
+			ContainSubstring("job1"),
+		)))
+
+		inst.Status.InstallationPhase = lsv1alpha1.InstallationPhaseObjectsCreated
+		events.RecordInstallationPhaseTransition(recorder, inst, lsv1alpha1.InstallationPhaseCleanupOrphaned)
+		Expect(recorder.Events).To(Receive(And(
+			HavePrefix("Normal "+events.OrphanedSubinstallationsDeletedReason),
+			ContainSubstring("orphaned subinstallations of job job1"),
+		)))
+	})
+
+	It("should emit a warning with the last error for a failed execution", func() {
+		exec := &lsv1alpha1.Execution{}
+		exec.Status.JobID = "job1"
+		exec.Status.ExecutionPhase = lsv1alpha1.ExecPhaseFailed
+		exec.Status.LastError = &lsv1alpha1.Error{Message: "has failed or missing deploy items"}
+
+		events.RecordExecutionPhaseTransition(recorder, exec, lsv1alpha1.ExecPhaseProgressing)
+		Expect(recorder.Events).To(Receive(And(
+			HavePrefix("Warning "+events.ReconcileFailedReason),
+			ContainSubstring("has failed or missing deploy items"),
+		)))
+	})
+
+	It("should emit a dedicated reason for deploy items that failed because of a timeout", func() {
+		di := &lsv1alpha1.DeployItem{}
+		di.Status.SetJobID("job1")
+		di.Status.DeployItemPhase = lsv1alpha1.DeployItemPhaseFailed
+		di.Status.LastError = &lsv1alpha1.Error{Codes: []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorReadinessCheckTimeout}}
+
+		events.RecordDeployItemPhaseTransition(recorder, di, lsv1alpha1.DeployItemPhaseProgressing)
+		Expect(recorder.Events).To(Receive(HavePrefix("Warning " + events.ReadinessCheckTimeoutReason)))
+
+		di.Status.LastError = &lsv1alpha1.Error{Codes: []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorTimeout}}
+		events.RecordDeployItemPhaseTransition(recorder, di, lsv1alpha1.DeployItemPhaseProgressing)
+		Expect(recorder.Events).To(Receive(HavePrefix("Warning " + events.DeployItemTimeoutReason)))
+
+		di.Status.LastError = nil
+		events.RecordDeployItemPhaseTransition(recorder, di, lsv1alpha1.DeployItemPhaseProgressing)
+		Expect(recorder.Events).To(Receive(HavePrefix("Warning " + events.DeployItemFailedReason)))
+	})
+
+})
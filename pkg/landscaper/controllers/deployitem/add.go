@@ -30,6 +30,7 @@ func AddControllerToManager(logger logging.Logger,
 		log,
 		mgr.GetClient(),
 		mgr.GetScheme(),
+		mgr.GetEventRecorderFor("Landscaper"),
 		deployItemPickupTimeout,
 		deployItemAbortingTimeout,
 		deployItemDefaultTimeout,
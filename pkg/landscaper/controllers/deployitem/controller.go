@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -17,13 +18,16 @@ import (
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
+const controllerName = "deployitem"
+
 // NewController creates a new deploy item controller that handles timeouts
 // To detect pickup timeouts (when a DeployItem resource is not reconciled by any deployer within a specified timeframe), the controller checks for a timestamp annotation.
 // It is expected that deployers remove the timestamp annotation from deploy items during reconciliation. If the timestamp annotation exists and is older than a specified duration,
 // the controller marks the deploy item as failed.
 // pickupTimeout is a string containing the pickup timeout duration, either as 'none' or as a duration that can be parsed by time.ParseDuration.
-func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, pickupTimeout, abortingTimeout, defaultTimeout *lscore.Duration) (reconcile.Reconciler, error) {
-	con := controller{log: logger, c: c, scheme: scheme}
+func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, eventRecorder record.EventRecorder,
+	pickupTimeout, abortingTimeout, defaultTimeout *lscore.Duration) (reconcile.Reconciler, error) {
+	con := controller{log: logger, c: c, scheme: scheme, eventRecorder: eventRecorder}
 	if pickupTimeout != nil {
 		con.pickupTimeout = pickupTimeout.Duration
 	} else {
@@ -52,6 +56,7 @@ type controller struct {
 	log             logging.Logger
 	c               client.Client
 	scheme          *runtime.Scheme
+	eventRecorder   record.EventRecorder
 	pickupTimeout   time.Duration
 	abortingTimeout time.Duration
 	defaultTimeout  time.Duration
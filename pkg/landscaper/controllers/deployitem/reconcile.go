@@ -20,12 +20,15 @@ import (
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/events"
+	"github.com/gardener/landscaper/pkg/metrics"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
 func (con *controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
 	logger := con.log.StartReconcile(req)
 	ctx = logging.NewContext(ctx, logger)
+	defer metrics.ObserveReconcileDuration(controllerName, time.Now())
 
 	di := &lsv1alpha1.DeployItem{}
 	if err := read_write_layer.GetDeployItem(ctx, con.c, req.NamespacedName, di); err != nil {
@@ -127,6 +130,7 @@ func (con *controller) writePickupTimeoutExceeded(ctx context.Context, di *lsv1a
 	logger = logger.WithValues(lc.KeyMethod, "writePickupTimeoutExceeded")
 	logger.Info("pickup timeout occurred")
 
+	oldPhase := di.Status.DeployItemPhase
 	di.Status.DeployItemPhase = lsv1alpha1.DeployItemPhaseFailed
 	di.Status.JobIDFinished = di.Status.GetJobID()
 	di.Status.Phase = lsv1alpha1.ExecutionPhaseFailed
@@ -142,6 +146,8 @@ func (con *controller) writePickupTimeoutExceeded(ctx context.Context, di *lsv1a
 		logger.Error(err, "unable to set deployitem status")
 		return err
 	}
+	metrics.RecordDeployItemPhaseTransition(di, oldPhase)
+	events.RecordDeployItemPhaseTransition(con.eventRecorder, di, oldPhase)
 
 	return nil
 }
@@ -170,6 +176,7 @@ func (con *controller) writeAbortingTimeoutExceeded(ctx context.Context, di *lsv
 	logger = logger.WithValues(lc.KeyMethod, "writeAbortingTimeoutExceeded")
 	logger.Info("aborting timeout occurred")
 
+	oldPhase := di.Status.DeployItemPhase
 	di.Status.DeployItemPhase = lsv1alpha1.DeployItemPhaseFailed
 	di.Status.JobIDFinished = di.Status.GetJobID()
 	di.Status.Phase = lsv1alpha1.ExecutionPhaseFailed
@@ -186,6 +193,8 @@ func (con *controller) writeAbortingTimeoutExceeded(ctx context.Context, di *lsv
 		logger.Error(err, "unable to set deployitem status")
 		return err
 	}
+	metrics.RecordDeployItemPhaseTransition(di, oldPhase)
+	events.RecordDeployItemPhaseTransition(con.eventRecorder, di, oldPhase)
 
 	return nil
 }
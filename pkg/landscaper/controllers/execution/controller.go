@@ -7,6 +7,7 @@ package execution
 import (
 	"context"
 	"fmt"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -22,12 +23,18 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/events"
 	"github.com/gardener/landscaper/pkg/landscaper/execution"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/operation"
+	"github.com/gardener/landscaper/pkg/metrics"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
+const controllerName = "execution"
+
 // NewController creates a new execution controller that reconcile Execution resources.
 func NewController(logger logging.Logger, kubeClient client.Client, scheme *runtime.Scheme, eventRecorder record.EventRecorder) (reconcile.Reconciler, error) {
 	return &controller{
@@ -48,6 +55,7 @@ type controller struct {
 func (c *controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
 	logger := c.log.StartReconcile(req)
 	ctx = logging.NewContext(ctx, logger)
+	defer metrics.ObserveReconcileDuration(controllerName, time.Now())
 
 	exec := &lsv1alpha1.Execution{}
 	if err := read_write_layer.GetExecution(ctx, c.client, req.NamespacedName, exec); err != nil {
@@ -65,6 +73,12 @@ func (c *controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		}
 	}
 
+	if exec.DeletionTimestamp.IsZero() {
+		if err := c.propagateSuspension(ctx, exec); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
 	if lsv1alpha1helper.HasOperation(exec.ObjectMeta, lsv1alpha1.InterruptOperation) {
 		if err := c.handleInterruptOperation(ctx, exec); err != nil {
 			return reconcile.Result{}, err
@@ -72,13 +86,30 @@ func (c *controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, nil
 	}
 
+	if lsv1alpha1helper.HasOperation(exec.ObjectMeta, lsv1alpha1.ContinueOperation) {
+		if err := c.handleContinueOperation(ctx, exec); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
 	if exec.Status.JobID != exec.Status.JobIDFinished {
 		// Execution is unfinished
 
+		// a suspended execution does not start new jobs, but running jobs and deletions are continued
+		if lsv1alpha1helper.IsSuspended(exec.ObjectMeta) && exec.DeletionTimestamp.IsZero() && !isJobStarted(exec) {
+			logger.Info("execution is suspended: postponing new job", lc.KeyJobID, exec.Status.JobID)
+			return reconcile.Result{}, nil
+		}
+
 		err := c.handleReconcilePhase(ctx, exec)
 		return reconcile.Result{}, err
 	} else {
-		// Execution is finished; nothing to do
+		// Execution is finished; a rollback of a deploy item is executed by a new job
+		if exec.DeletionTimestamp.IsZero() {
+			if err := c.triggerDeployItemRollback(ctx, exec); err != nil {
+				return reconcile.Result{}, err
+			}
+		}
 		return reconcile.Result{}, nil
 	}
 }
@@ -93,6 +124,9 @@ func (c *controller) handleReconcilePhase(ctx context.Context, exec *lsv1alpha1.
 		exec.Status.ExecutionPhase == lsv1alpha1.ExecPhaseDeleteFailed ||
 		exec.Status.ExecutionPhase == "" {
 
+		oldPhase := exec.Status.ExecutionPhase
+		// the rollout state only belongs to one job
+		exec.Status.Rollout = nil
 		if exec.DeletionTimestamp.IsZero() {
 			exec.Status.ExecutionPhase = lsv1alpha1.ExecPhaseInit
 		} else {
@@ -103,6 +137,8 @@ func (c *controller) handleReconcilePhase(ctx context.Context, exec *lsv1alpha1.
 		if err := c.Writer().UpdateExecutionStatus(ctx, read_write_layer.W000105, exec); err != nil {
 			return lserrors.NewWrappedError(err, op, "UpdateExecutionStatus", err.Error())
 		}
+		metrics.RecordExecutionPhaseTransition(exec, oldPhase)
+		events.RecordExecutionPhaseTransition(c.eventRecorder, exec, oldPhase)
 	}
 
 	if exec.Status.ExecutionPhase == lsv1alpha1.ExecPhaseInit {
@@ -125,11 +161,18 @@ func (c *controller) handleReconcilePhase(ctx context.Context, exec *lsv1alpha1.
 		}
 
 		if !deployItemClassification.HasRunningItems() && deployItemClassification.HasFailedItems() {
+			if execution.ShouldPauseOnFailure(exec) {
+				// remain in progressing until the rollout is continued
+				return c.pauseRollout(ctx, exec, deployItemClassification)
+			}
 			err = lserrors.NewError(op, "handlePhaseProgressing", "has failed or missing deploy items")
 			return c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecPhaseFailed, err, read_write_layer.W000134)
 		} else if !deployItemClassification.HasRunningItems() && !deployItemClassification.HasRunnableItems() && deployItemClassification.HasPendingItems() {
 			err = lserrors.NewError(op, "handlePhaseProgressing", "items could not be started")
 			return c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecPhaseFailed, err, read_write_layer.W000135)
+		} else if !deployItemClassification.HasRunningItems() && !deployItemClassification.HasRunnableItems() && deployItemClassification.HasContinuedFailedItems() {
+			err = lserrors.NewError(op, "handlePhaseProgressing", "has failed or missing deploy items")
+			return c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecPhaseFailed, err, read_write_layer.W000155)
 		} else if !deployItemClassification.AllSucceeded() {
 			// remain in progressing in all other cases
 			err = lserrors.NewError(op, "handlePhaseProgressing", "some running items", lsv1alpha1.ErrorUnfinished)
@@ -209,6 +252,14 @@ func (c *controller) handlePhaseProgressing(ctx context.Context, exec *lsv1alpha
 	return o.TriggerDeployItems(ctx)
 }
 
+func (c *controller) pauseRollout(ctx context.Context, exec *lsv1alpha1.Execution,
+	deployItemClassification *execution.DeployItemClassification) lserrors.LsError {
+	forceReconcile := false
+	o := execution.NewOperation(operation.NewOperation(c.client, c.scheme, c.eventRecorder), exec, forceReconcile)
+
+	return o.PauseRollout(ctx, deployItemClassification)
+}
+
 func (c *controller) handlePhaseCompleting(ctx context.Context, exec *lsv1alpha1.Execution) lserrors.LsError {
 	forceReconcile := false
 	o := execution.NewOperation(operation.NewOperation(c.client, c.scheme, c.eventRecorder), exec, forceReconcile)
@@ -282,6 +333,7 @@ func (c *controller) handleInterruptOperation(ctx context.Context, exec *lsv1alp
 		item := &managedItems[i]
 
 		if item.Status.JobIDFinished != exec.Status.JobID {
+			oldPhase := item.Status.DeployItemPhase
 			item.Status.SetJobID(exec.Status.JobID)
 			item.Status.JobIDFinished = exec.Status.JobID
 			item.Status.DeployItemPhase = lsv1alpha1.DeployItemPhaseFailed
@@ -295,6 +347,118 @@ func (c *controller) handleInterruptOperation(ctx context.Context, exec *lsv1alp
 				return lserrors.NewWrappedError(err, "UpdateDeployItemStatus",
 					fmt.Sprintf("unable to update deploy item %s / %s for interrupt", item.Namespace, item.Name), err.Error())
 			}
+			metrics.RecordDeployItemPhaseTransition(item, oldPhase)
+			events.RecordDeployItemPhaseTransition(c.eventRecorder, item, oldPhase)
+		}
+	}
+
+	return nil
+}
+
+func (c *controller) handleContinueOperation(ctx context.Context, exec *lsv1alpha1.Execution) error {
+	delete(exec.Annotations, lsv1alpha1.OperationAnnotation)
+	if err := c.Writer().UpdateExecution(ctx, read_write_layer.W000152, exec); err != nil {
+		return err
+	}
+
+	forceReconcile := false
+	o := execution.NewOperation(operation.NewOperation(c.client, c.scheme, c.eventRecorder), exec, forceReconcile)
+
+	if err := o.ContinueRollout(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// triggerDeployItemRollback starts a new job of the root installation of the execution, if a deploy item of the execution
+// has a rollback annotation. The deploy item is rolled back in this job, so that the execution aggregates the result.
+func (c *controller) triggerDeployItemRollback(ctx context.Context, exec *lsv1alpha1.Execution) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	forceReconcile := false
+	o := execution.NewOperation(operation.NewOperation(c.client, c.scheme, c.eventRecorder), exec, forceReconcile)
+
+	managedItems, err := o.ListManagedDeployItems(ctx)
+	if err != nil {
+		return err
+	}
+
+	hasRollback := false
+	for i := range managedItems {
+		item := &managedItems[i]
+		if lsv1alpha1helper.HasOperation(item.ObjectMeta, lsv1alpha1.RollbackOperation) && item.DeletionTimestamp.IsZero() {
+			hasRollback = true
+			break
+		}
+	}
+	if !hasRollback {
+		return nil
+	}
+
+	owner := metav1.GetControllerOf(exec)
+	if !installations.OwnerReferenceIsInstallation(owner) {
+		return nil
+	}
+
+	inst := &lsv1alpha1.Installation{}
+	if err := read_write_layer.GetInstallation(ctx, c.client, client.ObjectKey{Name: owner.Name, Namespace: exec.Namespace}, inst); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	for {
+		parent, err := installations.GetParent(ctx, c.client, inst)
+		if err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		if parent == nil {
+			break
+		}
+		inst = parent
+	}
+
+	if lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation) || !inst.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	if inst.Status.JobID != inst.Status.JobIDFinished && inst.Status.JobID != exec.Status.JobID {
+		// the running job of the root installation has not yet reached the execution and executes the rollback
+		return nil
+	}
+
+	logger.Info("starting a new job of the root installation, because of a rollback annotation at a deploy item",
+		lc.KeyResource, kutil.ObjectKeyFromObject(inst).String())
+	lsv1alpha1helper.SetOperation(&inst.ObjectMeta, lsv1alpha1.ReconcileOperation)
+	return c.Writer().UpdateInstallation(ctx, read_write_layer.W000188, inst)
+}
+
+// isJobStarted returns true if the current job of the execution has already left its start phase.
+func isJobStarted(exec *lsv1alpha1.Execution) bool {
+	return exec.Status.ExecutionPhase != lsv1alpha1.ExecPhaseSucceeded &&
+		exec.Status.ExecutionPhase != lsv1alpha1.ExecPhaseFailed &&
+		exec.Status.ExecutionPhase != lsv1alpha1.ExecPhaseDeleteFailed &&
+		exec.Status.ExecutionPhase != ""
+}
+
+// propagateSuspension sets the suspended annotation at the deploy items of the execution
+// if the execution is suspended, and removes it otherwise.
+func (c *controller) propagateSuspension(ctx context.Context, exec *lsv1alpha1.Execution) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+	suspended := lsv1alpha1helper.IsSuspended(exec.ObjectMeta)
+
+	forceReconcile := false
+	o := execution.NewOperation(operation.NewOperation(c.client, c.scheme, c.eventRecorder), exec, forceReconcile)
+
+	managedItems, err := o.ListManagedDeployItems(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range managedItems {
+		item := &managedItems[i]
+		if lsv1alpha1helper.SetSuspended(&item.ObjectMeta, suspended) {
+			logger.Debug("propagate suspension to deploy item", "deployItem", item.Name, "suspended", suspended)
+			if err := c.Writer().UpdateDeployItem(ctx, read_write_layer.W000169, item); client.IgnoreNotFound(err) != nil {
+				return err
+			}
 		}
 	}
 
@@ -311,6 +475,7 @@ func (c *controller) setExecutionPhaseAndUpdate(ctx context.Context, exec *lsv1a
 		logger.Error(lsErr, "setExecutionPhaseAndUpdate")
 	}
 
+	oldPhase := exec.Status.ExecutionPhase
 	exec.Status.ExecutionPhase = phase
 
 	if exec.Status.ExecutionPhase == lsv1alpha1.ExecPhaseSucceeded ||
@@ -336,7 +501,10 @@ func (c *controller) setExecutionPhaseAndUpdate(ctx context.Context, exec *lsv1a
 		if lsErr == nil {
 			return lserrors.NewWrappedError(err, "setExecutionPhaseAndUpdate", "UpdateExecutionStatus", err.Error())
 		}
+		return lsErr
 	}
 
+	metrics.RecordExecutionPhaseTransition(exec, oldPhase)
+	events.RecordExecutionPhaseTransition(c.eventRecorder, exec, oldPhase)
 	return lsErr
 }
@@ -12,12 +12,14 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/api"
@@ -161,6 +163,78 @@ var _ = Describe("Reconcile", func() {
 		Expect(di.ObjectMeta.Annotations).NotTo(HaveKey(lsv1alpha1.AbortTimestampAnnotation))
 	})
 
+	It("should not start a new job of a suspended execution", func() {
+		ctx := context.Background()
+		exec := &lsv1alpha1.Execution{}
+		exec.GenerateName = "test-"
+		exec.Namespace = state.Namespace
+		lsv1alpha1helper.SetSuspended(&exec.ObjectMeta, true)
+		exec.Spec.DeployItems = []lsv1alpha1.DeployItemTemplate{
+			{
+				Name:          "def",
+				Type:          "test-type",
+				Configuration: &runtime.RawExtension{Raw: []byte(`{"apiVersion": "sometest", "kind": "somekind"}`)},
+			},
+		}
+		Expect(state.Create(ctx, exec)).To(Succeed())
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec)).To(Succeed())
+		Expect(testutils.UpdateJobIdForExecution(ctx, testenv, exec)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(exec))
+
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec)).To(Succeed())
+		Expect(exec.Status.ExecutionPhase).To(BeEmpty())
+		items := &lsv1alpha1.DeployItemList{}
+		testutils.ExpectNoError(testenv.Client.List(ctx, items, client.InNamespace(state.Namespace)))
+		Expect(items.Items).To(BeEmpty())
+
+		// the job is started when the execution is resumed
+		lsv1alpha1helper.SetSuspended(&exec.ObjectMeta, false)
+		Expect(state.Client.Update(ctx, exec)).To(Succeed())
+		_ = testutils.ShouldNotReconcile(ctx, ctrl, testutils.RequestFromObject(exec))
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec)).To(Succeed())
+		Expect(exec.Status.ExecutionPhase).To(Equal(lsv1alpha1.ExecPhaseProgressing))
+	})
+
+	It("should start a new job of the root installation for a rollback of a deploy item", func() {
+		ctx := context.Background()
+		inst := &lsv1alpha1.Installation{}
+		inst.GenerateName = "root-"
+		inst.Namespace = state.Namespace
+		Expect(state.Create(ctx, inst)).To(Succeed())
+		inst.Status.JobID = "job-1"
+		inst.Status.JobIDFinished = "job-1"
+		Expect(state.Client.Status().Update(ctx, inst)).To(Succeed())
+
+		exec := &lsv1alpha1.Execution{}
+		exec.GenerateName = "test-"
+		exec.Namespace = state.Namespace
+		Expect(controllerutil.SetControllerReference(inst, exec, api.LandscaperScheme)).To(Succeed())
+		Expect(state.Create(ctx, exec)).To(Succeed())
+		exec.Status.JobID = "job-1"
+		exec.Status.JobIDFinished = "job-1"
+		exec.Status.ExecutionPhase = lsv1alpha1.ExecPhaseSucceeded
+		Expect(state.Client.Status().Update(ctx, exec)).To(Succeed())
+
+		di := &lsv1alpha1.DeployItem{}
+		di.GenerateName = "di-"
+		di.Namespace = state.Namespace
+		di.Spec.Type = "test-type"
+		metav1.SetMetaDataLabel(&di.ObjectMeta, lsv1alpha1.ExecutionManagedByLabel, exec.Name)
+		Expect(state.Create(ctx, di)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(exec))
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst)).To(Succeed())
+		Expect(lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation)).To(BeFalse())
+
+		metav1.SetMetaDataAnnotation(&di.ObjectMeta, lsv1alpha1.OperationAnnotation, string(lsv1alpha1.RollbackOperation))
+		metav1.SetMetaDataAnnotation(&di.ObjectMeta, lsv1alpha1.RollbackRevisionAnnotation, "2")
+		Expect(state.Client.Update(ctx, di)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(exec))
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst)).To(Succeed())
+		Expect(lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation)).To(BeTrue())
+	})
+
 	Context("Context", func() {
 		It("should pass the context to the deploy item", func() {
 			ctx := context.Background()
@@ -5,6 +5,7 @@ import (
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -89,6 +90,30 @@ func (c *DataObjectAndTargetCleaner) CleanupContext(ctx context.Context) error {
 	return nil
 }
 
+// CleanupResourceExports deletes all Secrets and ConfigMaps the given Installation has written its secret and configmap
+// exports to. In contrast to the exported DataObjects and Targets they are not removed on every reconciliation
+// but only when the Installation is deleted, because they are consumed by applications outside of the Landscaper.
+func (c *DataObjectAndTargetCleaner) CleanupResourceExports(ctx context.Context) error {
+	secrets, configMaps, err := installations.ListResourceExports(ctx, c.client, c.installation)
+	if err != nil {
+		return err
+	}
+
+	for i := range secrets {
+		if err := c.client.Delete(ctx, &secrets[i]); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	for i := range configMaps {
+		if err := c.client.Delete(ctx, &configMaps[i]); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (c *DataObjectAndTargetCleaner) deleteDataObjects(ctx context.Context, dataObjects []lsv1alpha1.DataObject) error {
 	for i := range dataObjects {
 		do := &dataObjects[i]
@@ -8,11 +8,13 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/gardener/component-cli/ociclient/cache"
 	"github.com/google/uuid"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
@@ -28,16 +30,19 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/events"
 	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
 	"github.com/gardener/landscaper/pkg/landscaper/operation"
+	"github.com/gardener/landscaper/pkg/metrics"
 	"github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
 const (
 	cacheIdentifier = "landscaper-installation-Controller"
+	controllerName  = "installation"
 )
 
 // NewController creates a new Controller that reconciles Installation resources.
@@ -90,6 +95,7 @@ type Controller struct {
 
 func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
 	logger, ctx := c.log.StartReconcileAndAddToContext(ctx, req)
+	defer metrics.ObserveReconcileDuration(controllerName, time.Now())
 
 	inst := &lsv1alpha1.Installation{}
 	if err := read_write_layer.GetInstallation(ctx, c.Client(), req.NamespacedName, inst); err != nil {
@@ -150,6 +156,12 @@ func (c *Controller) reconcileInstallation(ctx context.Context, inst *lsv1alpha1
 		return reconcile.Result{}, nil
 	}
 
+	if inst.DeletionTimestamp.IsZero() {
+		if err := c.propagateSuspension(ctx, inst); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
 	if lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.InterruptOperation) {
 		if err := c.handleInterruptOperation(ctx, inst); err != nil {
 			return reconcile.Result{}, err
@@ -157,6 +169,22 @@ func (c *Controller) reconcileInstallation(ctx context.Context, inst *lsv1alpha1
 		return reconcile.Result{}, nil
 	}
 
+	if lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.PlanOperation) {
+		if err := c.handlePlanOperation(ctx, inst); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+
+	// a rollback is postponed until the current job has finished and the installation is resumed
+	if lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.RollbackOperation) && !installations.IsSuspended(inst) &&
+		inst.DeletionTimestamp.IsZero() && inst.Status.JobID == inst.Status.JobIDFinished {
+		if err := c.handleRollbackOperation(ctx, inst); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+
 	if !installations.IsRootInstallation(inst) && lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation) {
 		// only root installations could be triggered with operation annotation to prevent that end users interfere with overall
 		// algorithm
@@ -174,7 +202,26 @@ func (c *Controller) reconcileInstallation(ctx context.Context, inst *lsv1alpha1
 		(lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation) || isFirstDelete) &&
 		inst.Status.JobID == inst.Status.JobIDFinished {
 
+		// deletions are never suspended or deferred
+		if !isFirstDelete && installations.IsSuspended(inst) {
+			logger.Info("installation is suspended: postponing new job")
+			return reconcile.Result{}, nil
+		}
+
+		if !isFirstDelete {
+			requeueAfter, deferred, err := newRetryHelper(c.Client(), c.clock).deferToMaintenanceWindow(ctx, inst)
+			if err != nil {
+				return reconcile.Result{}, err
+			}
+			if deferred {
+				return reconcile.Result{RequeueAfter: requeueAfter}, nil
+			}
+		}
+
 		inst.Status.JobID = uuid.New().String()
+		now := metav1.Now()
+		inst.Status.JobIDGenerationTime = &now
+		inst.Status.DeferredUntil = nil
 		if err := c.Writer().UpdateInstallationStatus(ctx, read_write_layer.W000082, inst); err != nil {
 			return reconcile.Result{}, err
 		}
@@ -189,8 +236,17 @@ func (c *Controller) reconcileInstallation(ctx context.Context, inst *lsv1alpha1
 	// handle reconcile
 	if inst.Status.JobID != inst.Status.JobIDFinished {
 
-		err := c.handleReconcilePhase(ctx, inst)
-		return reconcile.Result{}, err
+		// a suspended installation does not start new jobs, but running jobs and deletions are continued
+		if installations.IsSuspended(inst) && inst.DeletionTimestamp.IsZero() && !isJobStarted(inst) {
+			logger.Info("installation is suspended: postponing new job", lc.KeyJobID, inst.Status.JobID)
+			return reconcile.Result{}, nil
+		}
+
+		if err := c.handleReconcilePhase(ctx, inst); err != nil {
+			return reconcile.Result{}, err
+		}
+		// check the timeout of the rollback policy even if no subobject changes until then
+		return reconcile.Result{RequeueAfter: c.timeUntilJobTimeout(inst)}, nil
 
 	} else {
 		// job finished; nothing to do
@@ -299,7 +355,9 @@ func (c *Controller) setInstallationPhaseAndUpdate(ctx context.Context, inst *ls
 
 	inst.Status.LastError = lserrors.TryUpdateLsError(inst.Status.LastError, lsError)
 
-	if inst.Status.LastError != nil {
+	oldPhase := inst.Status.InstallationPhase
+	// the error of a phase transition is already contained in the event of the new phase
+	if inst.Status.LastError != nil && oldPhase == phase {
 		lastErr := inst.Status.LastError
 		c.EventRecorder().Event(inst, corev1.EventTypeWarning, lastErr.Reason, lastErr.Message)
 	}
@@ -325,7 +383,10 @@ func (c *Controller) setInstallationPhaseAndUpdate(ctx context.Context, inst *ls
 		if lsError == nil {
 			return lserrors.NewWrappedError(err, "setInstallationPhaseAndUpdate", "UpdateInstallationStatus", err.Error())
 		}
+		return lsError
 	}
 
+	metrics.RecordInstallationPhaseTransition(inst, oldPhase)
+	events.RecordInstallationPhaseTransition(c.EventRecorder(), inst, oldPhase)
 	return lsError
 }
@@ -6,21 +6,26 @@ package installations_test
 
 import (
 	"context"
+	"time"
 
 	"k8s.io/utils/clock"
 
 	"github.com/gardener/component-spec/bindings-go/ctf"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/gardener/landscaper/apis/config"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/api"
 	installationsctl "github.com/gardener/landscaper/pkg/landscaper/controllers/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/revisions"
 	lsoperation "github.com/gardener/landscaper/pkg/landscaper/operation"
 	componentsregistry "github.com/gardener/landscaper/pkg/landscaper/registry/components"
 	testutils "github.com/gardener/landscaper/test/utils"
@@ -130,6 +135,214 @@ var _ = Describe("Installation Controller", func() {
 			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(subinst), subinst))
 			Expect(subinst.ObjectMeta.Annotations).To(HaveKeyWithValue(lsv1alpha1.OperationAnnotation, string(lsv1alpha1.InterruptOperation)))
 		})
+
+		It("should roll back a failed installation to its last succeeded revision", func() {
+			// We consider a progressing Installation with a rollback policy whose Execution has failed.
+			// The Installation has a succeeded revision with a different generation. The reconciliation should start
+			// a rollback job that re-applies the deploy items of the revision. After the rollback job has succeeded,
+			// the Installation should be failed and the rollback should be recorded in its conditions.
+			ctx := context.Background()
+
+			var err error
+			state, err = testenv.InitResources(ctx, "./testdata/state/test10")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testutils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "root"
+			inst.Namespace = state.Namespace
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.Status.LastSucceededRevision).NotTo(BeNil())
+
+			// the failed job is rolled back by a new job
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.Status.InstallationPhase).To(Equal(lsv1alpha1.InstallationPhaseInit))
+			Expect(inst.Status.Rollback).NotTo(BeNil())
+			Expect(inst.Status.Rollback.FailedJobID).To(Equal("job2"))
+			Expect(inst.Status.Rollback.RevisionJobID).To(Equal("job1"))
+			Expect(inst.Status.Rollback.Reason).To(Equal(lsv1alpha1.RollbackReasonFailed))
+			Expect(inst.Status.JobID).To(Equal(inst.Status.Rollback.JobID))
+			Expect(inst.Status.JobIDFinished).To(Equal("job1"))
+
+			// the execution of the revision is re-applied
+			_ = testutils.ShouldNotReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.Status.InstallationPhase).To(Equal(lsv1alpha1.InstallationPhaseProgressing))
+
+			exec := &lsv1alpha1.Execution{}
+			exec.Name = inst.Status.ExecutionReference.Name
+			exec.Namespace = state.Namespace
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec))
+			Expect(exec.Spec.DeployItems).To(Equal(inst.Status.LastSucceededRevision.DeployItems))
+			Expect(exec.Status.JobID).To(Equal(inst.Status.JobID))
+
+			exec.Status.JobIDFinished = exec.Status.JobID
+			exec.Status.ExecutionPhase = lsv1alpha1.ExecPhaseSucceeded
+			testutils.ExpectNoError(testenv.Client.Status().Update(ctx, exec))
+
+			// the rollback job finishes
+			_ = testutils.ShouldNotReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.Status.InstallationPhase).To(Equal(lsv1alpha1.InstallationPhaseFailed))
+			Expect(inst.Status.JobIDFinished).To(Equal(inst.Status.JobID))
+			Expect(inst.Status.LastSucceededRevision.JobID).To(Equal("job1"))
+			cond := lsv1alpha1helper.GetCondition(inst.Status.Conditions, lsv1alpha1.RollbackCondition)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Status).To(Equal(lsv1alpha1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(installationsctl.RolledBackReason))
+		})
+
+		It("should roll back a timed out installation that is not progressing", func() {
+			// We consider an Installation with a rollback policy whose job is completing for longer than the timeout.
+			// The reconciliation should start a rollback job without waiting for further events.
+			ctx := context.Background()
+
+			var err error
+			state, err = testenv.InitResources(ctx, "./testdata/state/test10")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testutils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "root"
+			inst.Namespace = state.Namespace
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			inst.Spec.RollbackPolicy.Timeout = &lsv1alpha1.Duration{Duration: time.Minute}
+			testutils.ExpectNoError(testenv.Client.Update(ctx, inst))
+			jobIDGenerationTime := metav1.NewTime(time.Now().Add(-time.Hour))
+			inst.Status.InstallationPhase = lsv1alpha1.InstallationPhaseCompleting
+			inst.Status.JobIDGenerationTime = &jobIDGenerationTime
+			testutils.ExpectNoError(testenv.Client.Status().Update(ctx, inst))
+
+			_ = testutils.ShouldNotReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.Status.InstallationPhase).To(Equal(lsv1alpha1.InstallationPhaseInit))
+			Expect(inst.Status.Rollback).NotTo(BeNil())
+			Expect(inst.Status.Rollback.FailedJobID).To(Equal("job2"))
+			Expect(inst.Status.Rollback.Reason).To(Equal(lsv1alpha1.RollbackReasonTimeout))
+			Expect(inst.Status.JobID).To(Equal(inst.Status.Rollback.JobID))
+		})
+
+		It("should roll back an installation to a revision of its revision history", func() {
+			// We consider a finished Installation with a revision history and a rollback annotation.
+			// The reconciliation should start a rollback job for the revision and remove the annotations.
+			ctx := context.Background()
+
+			var err error
+			state, err = testenv.InitResources(ctx, "./testdata/state/test11")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testutils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "root"
+			inst.Namespace = state.Namespace
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+
+			history := revisions.NewHistory(testenv.Client, inst)
+			for _, jobID := range []string{"job1", "job2"} {
+				_, err := history.Record(ctx, &lsv1alpha1.InstallationRevision{
+					JobID:     jobID,
+					Blueprint: inst.Spec.Blueprint,
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.Annotations).NotTo(HaveKey(lsv1alpha1.OperationAnnotation))
+			Expect(inst.Annotations).NotTo(HaveKey(lsv1alpha1.RollbackRevisionAnnotation))
+			Expect(inst.Status.InstallationPhase).To(Equal(lsv1alpha1.InstallationPhaseInit))
+			Expect(inst.Status.Rollback).NotTo(BeNil())
+			Expect(inst.Status.Rollback.Reason).To(Equal(lsv1alpha1.RollbackReasonOperation))
+			Expect(inst.Status.Rollback.Revision).To(Equal(int64(1)))
+			Expect(inst.Status.Rollback.RevisionJobID).To(Equal("job1"))
+			Expect(inst.Status.JobID).To(Equal(inst.Status.Rollback.JobID))
+			Expect(inst.Status.JobIDFinished).To(Equal("job2"))
+		})
+
+		It("should restore the subinstallations of a revision", func() {
+			// We consider a finished Installation with a rollback annotation for a revision with a subinstallation.
+			// The rollback job should create the subinstallation of the revision and trigger it.
+			ctx := context.Background()
+
+			var err error
+			state, err = testenv.InitResources(ctx, "./testdata/state/test11")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testutils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "root"
+			inst.Namespace = state.Namespace
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+
+			subInstSpec := lsv1alpha1.InstallationSpec{Blueprint: inst.Spec.Blueprint}
+			history := revisions.NewHistory(testenv.Client, inst)
+			for _, jobID := range []string{"job1", "job2"} {
+				_, err := history.Record(ctx, &lsv1alpha1.InstallationRevision{
+					JobID:     jobID,
+					Blueprint: inst.Spec.Blueprint,
+					Subinstallations: []lsv1alpha1.SubinstallationRevision{
+						{Name: "subinst", Spec: subInstSpec},
+					},
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.Status.Rollback).NotTo(BeNil())
+
+			// the rollback job restores and triggers the subinstallation and waits for it
+			_, _ = ctrl.Reconcile(ctx, testutils.RequestFromObject(inst))
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.Status.InstallationPhase).To(Equal(lsv1alpha1.InstallationPhaseProgressing))
+
+			subInsts, err := installations.ListSubinstallations(ctx, testenv.Client, inst)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(subInsts).To(HaveLen(1))
+			Expect(subInsts[0].Annotations).To(HaveKeyWithValue(lsv1alpha1.SubinstallationNameAnnotation, "subinst"))
+			Expect(subInsts[0].Spec.Blueprint).To(Equal(subInstSpec.Blueprint))
+			Expect(subInsts[0].Status.JobID).To(Equal(inst.Status.Rollback.JobID))
+			Expect(inst.Status.InstallationReferences).To(ConsistOf(lsv1alpha1helper.NewInstallationReferenceState("subinst", subInsts[0])))
+		})
+
+		It("should not start new jobs of a suspended installation", func() {
+			// We consider a finished and suspended Installation with a reconcile annotation.
+			// The reconciliation should suspend the execution and postpone the new job until the installation is resumed.
+			ctx := context.Background()
+
+			var err error
+			state, err = testenv.InitResources(ctx, "./testdata/state/test13")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testutils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "root"
+			inst.Namespace = state.Namespace
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+
+			exec := &lsv1alpha1.Execution{}
+			exec.Name = "root"
+			exec.Namespace = state.Namespace
+
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.Annotations).To(HaveKeyWithValue(lsv1alpha1.OperationAnnotation, string(lsv1alpha1.ReconcileOperation)))
+			Expect(inst.Status.JobID).To(Equal("job1"))
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec))
+			Expect(lsv1alpha1helper.IsSuspended(exec.ObjectMeta)).To(BeTrue())
+
+			// resume the installation
+			inst.Spec.Suspend = false
+			testutils.ExpectNoError(testenv.Client.Update(ctx, inst))
+
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.Annotations).NotTo(HaveKey(lsv1alpha1.OperationAnnotation))
+			Expect(inst.Status.JobID).NotTo(Equal("job1"))
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec))
+			Expect(lsv1alpha1helper.IsSuspended(exec.ObjectMeta)).To(BeFalse())
+		})
 	})
 
 })
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/imports"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/subinstallations"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// handlePlanOperation computes the changes that a reconciliation of the installation would apply,
+// writes them to the status of the installation and removes the plan annotation.
+func (c *Controller) handlePlanOperation(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(inst).String()})
+
+	if inst.DeletionTimestamp.IsZero() {
+		logger.Info("compute plan")
+		inst.Status.Plan = c.computePlan(ctx, inst)
+		if err := c.Writer().UpdateInstallationStatus(ctx, read_write_layer.W000150, inst); err != nil {
+			return err
+		}
+	}
+
+	delete(inst.Annotations, lsv1alpha1.OperationAnnotation)
+	return c.Writer().UpdateInstallation(ctx, read_write_layer.W000151, inst)
+}
+
+// computePlan renders the imports, subinstallations and deploy items of the installation
+// and compares them with the objects in the cluster. Nothing but the returned plan is modified.
+// Errors are reported in the plan.
+func (c *Controller) computePlan(ctx context.Context, inst *lsv1alpha1.Installation) *lsv1alpha1.InstallationPlan {
+	currOp := "ComputePlan"
+	plan := &lsv1alpha1.InstallationPlan{
+		ObservedGeneration: inst.Generation,
+		PlanTime:           metav1.Now(),
+	}
+
+	// all further operations work on a copy as they modify the status of the installation in memory.
+	planInst := inst.DeepCopy()
+
+	instOp, imps, importsHash, _, fatalError, normalError := c.init(ctx, planInst)
+	if fatalError != nil {
+		plan.Error = lserrors.TryUpdateLsError(nil, fatalError)
+		return plan
+	}
+	if normalError != nil {
+		plan.Error = lserrors.TryUpdateLsError(nil, normalError)
+		return plan
+	}
+	plan.ImportsHash = importsHash
+
+	if err := imports.NewConstructor(instOp).Construct(ctx, imps); err != nil {
+		plan.Error = lserrors.TryUpdateLsError(nil, lserrors.NewWrappedError(err, currOp, "ConstructImports", err.Error()))
+		return plan
+	}
+
+	var err error
+	plan.Imports, err = instOp.PlanImports(ctx)
+	if err != nil {
+		plan.Error = lserrors.TryUpdateLsError(nil, lserrors.NewWrappedError(err, currOp, "PlanImports", err.Error()))
+		return plan
+	}
+
+	plan.Subinstallations, err = subinstallations.New(instOp).Plan(ctx)
+	if err != nil {
+		plan.Error = lserrors.TryUpdateLsError(nil, lserrors.NewWrappedError(err, currOp, "PlanSubinstallations", err.Error()))
+		return plan
+	}
+
+	plan.DeployItems, err = executions.New(instOp).Plan(ctx, instOp.Inst)
+	if err != nil {
+		plan.Error = lserrors.TryUpdateLsError(nil, lserrors.NewWrappedError(err, currOp, "PlanDeployItems", err.Error()))
+		return plan
+	}
+
+	return plan
+}
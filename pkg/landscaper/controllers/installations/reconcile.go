@@ -19,12 +19,14 @@ import (
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/events"
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/exports"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/imports"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/reconcilehelper"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/subinstallations"
+	"github.com/gardener/landscaper/pkg/metrics"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
@@ -42,6 +44,7 @@ func (c *Controller) handleReconcilePhase(ctx context.Context, inst *lsv1alpha1.
 			nextPhase = lsv1alpha1.InstallationPhaseInitDelete
 		}
 
+		oldPhase := inst.Status.InstallationPhase
 		inst.Status.InstallationPhase = nextPhase
 		inst.Status.ObservedGeneration = inst.GetGeneration()
 
@@ -49,10 +52,23 @@ func (c *Controller) handleReconcilePhase(ctx context.Context, inst *lsv1alpha1.
 		if err := c.Writer().UpdateInstallationStatus(ctx, read_write_layer.W000115, inst); err != nil {
 			return lserrors.NewWrappedError(err, op, "InitialPhaseSetting", err.Error())
 		}
+		metrics.RecordInstallationPhaseTransition(inst, oldPhase)
+		events.RecordInstallationPhaseTransition(c.EventRecorder(), inst, oldPhase)
+	}
+
+	if c.isJobTimedOut(inst) && inst.DeletionTimestamp.IsZero() {
+		if finished, err := c.handleJobTimeout(ctx, inst); finished || err != nil {
+			return err
+		}
 	}
 
 	if inst.Status.InstallationPhase == lsv1alpha1.InstallationPhaseInit {
-		fatalError, normalError := c.handlePhaseInit(ctx, inst)
+		var fatalError, normalError lserrors.LsError
+		if isRollbackJob(inst) {
+			fatalError = c.handlePhaseInitRollback(ctx, inst)
+		} else {
+			fatalError, normalError = c.handlePhaseInit(ctx, inst)
+		}
 
 		inst.Status.ObservedGeneration = inst.GetGeneration()
 
@@ -98,18 +114,25 @@ func (c *Controller) handleReconcilePhase(ctx context.Context, inst *lsv1alpha1.
 	if inst.Status.InstallationPhase == lsv1alpha1.InstallationPhaseProgressing {
 		allSucceeded, err := c.handlePhaseProgressing(ctx, inst)
 		if err != nil {
+			if c.isJobTimedOut(inst) {
+				// the unfinished subobjects have been interrupted; their failure triggers the rollback
+				err = lserrors.NewError(op, "JobTimeout", "the job exceeded the timeout of the rollback policy: interrupting sub objects",
+					lsv1alpha1.ErrorTimeout, lsv1alpha1.ErrorUnfinished)
+			}
 			// error or unfinished subobjects => phase remains progressing
 			return c.setInstallationPhaseAndUpdate(ctx, inst, inst.Status.InstallationPhase, err, read_write_layer.W000118)
 		}
 
-		var nextPhase lsv1alpha1.InstallationPhase
-		if allSucceeded {
-			nextPhase = lsv1alpha1.InstallationPhaseCompleting
-		} else {
-			nextPhase = lsv1alpha1.InstallationPhaseFailed
+		// a succeeded rollback operation recomputes the exports in the completing phase
+		if isRollbackJob(inst) && !(allSucceeded && isRollbackOperationJob(inst)) {
+			return c.finishRollback(ctx, inst, allSucceeded)
 		}
 
-		if err := c.setInstallationPhaseAndUpdate(ctx, inst, nextPhase, nil, read_write_layer.W000119); err != nil {
+		if !allSucceeded {
+			return c.failOrRollback(ctx, inst, nil, read_write_layer.W000119)
+		}
+
+		if err := c.setInstallationPhaseAndUpdate(ctx, inst, lsv1alpha1.InstallationPhaseCompleting, nil, read_write_layer.W000119); err != nil {
 			return err
 		}
 	}
@@ -118,13 +141,25 @@ func (c *Controller) handleReconcilePhase(ctx context.Context, inst *lsv1alpha1.
 		fatalError, normalError := c.handlePhaseCompleting(ctx, inst)
 
 		if fatalError != nil && !lsutil.IsRecoverableError(fatalError) {
-			return c.setInstallationPhaseAndUpdate(ctx, inst, lsv1alpha1.InstallationPhaseFailed, fatalError, read_write_layer.W000120)
+			return c.failOrRollback(ctx, inst, fatalError, read_write_layer.W000120)
 		} else if fatalError != nil && lsutil.IsRecoverableError(fatalError) {
 			return c.setInstallationPhaseAndUpdate(ctx, inst, inst.Status.InstallationPhase, fatalError, read_write_layer.W000005)
 		} else if normalError != nil {
 			return c.setInstallationPhaseAndUpdate(ctx, inst, inst.Status.InstallationPhase, normalError, read_write_layer.W000121)
 		}
 
+		if isRollbackOperationJob(inst) {
+			return c.finishRollback(ctx, inst, true)
+		}
+
+		if err := c.recordRevision(ctx, inst); err != nil {
+			return c.setInstallationPhaseAndUpdate(ctx, inst, inst.Status.InstallationPhase, err, read_write_layer.W000162)
+		}
+
+		if err := c.recordSucceededRevision(ctx, inst); err != nil {
+			return c.setInstallationPhaseAndUpdate(ctx, inst, inst.Status.InstallationPhase, err, read_write_layer.W000161)
+		}
+
 		if err := c.setInstallationPhaseAndUpdate(ctx, inst, lsv1alpha1.InstallationPhaseSucceeded, nil, read_write_layer.W000122); err != nil {
 			return err
 		}
@@ -329,6 +364,7 @@ func (c *Controller) handlePhaseCleanupOrphaned(ctx context.Context, inst *lsv1a
 	for _, next := range subInstsToDelete {
 		if next.Status.JobID != inst.Status.JobID {
 			next.Status.JobID = inst.Status.JobID
+			next.Status.JobIDGenerationTime = inst.Status.JobIDGenerationTime
 			if err = c.Writer().UpdateInstallationStatus(ctx, read_write_layer.W000076, next); err != nil {
 				return nil, lserrors.NewWrappedError(err, currentOperation, "UpdateInstallationStatus", err.Error())
 			}
@@ -368,6 +404,7 @@ func (c *Controller) handlePhaseObjectsCreated(ctx context.Context, inst *lsv1al
 	for _, next := range subInsts {
 		if next.Status.JobID != inst.Status.JobID {
 			next.Status.JobID = inst.Status.JobID
+			next.Status.JobIDGenerationTime = inst.Status.JobIDGenerationTime
 			if err = c.Writer().UpdateInstallationStatus(ctx, read_write_layer.W000083, next); err != nil {
 				return lserrors.NewWrappedError(err, currentOperation, "UpdateInstallationStatus", err.Error())
 			}
@@ -383,6 +420,7 @@ func (c *Controller) handlePhaseObjectsCreated(ctx context.Context, inst *lsv1al
 
 		if exec.Status.JobID != inst.Status.JobID {
 			exec.Status.JobID = inst.Status.JobID
+			exec.Status.JobIDGenerationTime = inst.Status.JobIDGenerationTime
 			if err := c.Writer().UpdateExecutionStatus(ctx, read_write_layer.W000084, exec); err != nil {
 				return lserrors.NewWrappedError(err, currentOperation, "UpdateExecutionStatus", err.Error())
 			}
@@ -458,12 +496,12 @@ func (c *Controller) handlePhaseCompleting(ctx context.Context, inst *lsv1alpha1
 		return lserrors.NewWrappedError(err, currentOperation, "ConstructImportsForExports", err.Error()), nil
 	}
 
-	dataExports, targetExports, err := exports.NewConstructor(instOp).Construct(ctx)
+	dataExports, targetExports, resourceExports, err := exports.NewConstructor(instOp).Construct(ctx)
 	if err != nil {
 		return lserrors.NewWrappedError(err, currentOperation, "ConstructExports", err.Error()), nil
 	}
 
-	if err := instOp.CreateOrUpdateExports(ctx, dataExports, targetExports); err != nil {
+	if err := instOp.CreateOrUpdateExports(ctx, dataExports, targetExports, resourceExports); err != nil {
 		if apierrors.IsConflict(err) {
 			return nil, lserrors.NewWrappedError(err, currentOperation, "CreateOrUpdateExports", err.Error())
 		}
@@ -514,6 +552,7 @@ func (c *Controller) removeReconcileAnnotation(ctx context.Context, inst *lsv1al
 		logger.Debug("remove reconcile annotation")
 		delete(inst.Annotations, lsv1alpha1.OperationAnnotation)
 		delete(inst.Annotations, lsv1alpha1.ReconcileReasonAnnotation)
+		delete(inst.Annotations, lsv1alpha1.IgnoreMaintenanceWindowAnnotation)
 		if err := c.Writer().UpdateInstallation(ctx, read_write_layer.W000009, inst); client.IgnoreNotFound(err) != nil {
 			return lserrors.NewWrappedError(err, "RemoveReconcileAnnotation", "UpdateInstallation", err.Error())
 		}
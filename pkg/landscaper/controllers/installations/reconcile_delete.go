@@ -102,6 +102,7 @@ func (c *Controller) handleDeletionPhaseTriggerDeleting(ctx context.Context, ins
 
 	if exec != nil && exec.Status.JobID != inst.Status.JobID {
 		exec.Status.JobID = inst.Status.JobID
+		exec.Status.JobIDGenerationTime = inst.Status.JobIDGenerationTime
 		if err = c.Writer().UpdateExecutionStatus(ctx, read_write_layer.W000093, exec); err != nil {
 			return lserrors.NewWrappedError(err, op, "UpdateExecutionStatus", err.Error())
 		}
@@ -115,6 +116,7 @@ func (c *Controller) handleDeletionPhaseTriggerDeleting(ctx context.Context, ins
 	for _, subInst := range subInsts {
 		if subInst.Status.JobID != inst.Status.JobID {
 			subInst.Status.JobID = inst.Status.JobID
+			subInst.Status.JobIDGenerationTime = inst.Status.JobIDGenerationTime
 			if err = c.Writer().UpdateInstallationStatus(ctx, read_write_layer.W000094, subInst); err != nil {
 				return lserrors.NewWrappedError(err, op, "UpdateInstallationStatus", err.Error())
 			}
@@ -138,6 +140,10 @@ func (c *Controller) handleDeletionPhaseDeleting(ctx context.Context, inst *lsv1
 	}
 
 	if exec == nil && len(subInsts) == 0 {
+		if err := NewDataObjectAndTargetCleaner(inst, c.Client()).CleanupResourceExports(ctx); err != nil {
+			return false, false, lserrors.NewWrappedError(err, op, "CleanupResourceExports", err.Error())
+		}
+
 		controllerutil.RemoveFinalizer(inst, lsv1alpha1.LandscaperFinalizer)
 		if err = c.Writer().UpdateInstallation(ctx, read_write_layer.W000095, inst); err != nil {
 			return false, false, lserrors.NewWrappedError(err, op, "UpdateInstallation", err.Error())
@@ -16,7 +16,10 @@ import (
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/maintenancewindow"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
@@ -71,6 +74,11 @@ func (r *retryHelper) recomputeRetry(ctx context.Context, inst *lsv1alpha1.Insta
 		return oldResult, oldError
 	}
 
+	// automatic reconciles are paused while the installation is suspended
+	if installations.IsSuspended(inst) {
+		return oldResult, oldError
+	}
+
 	isUpToDate := inst.Status.ObservedGeneration == inst.GetGeneration()
 	if !isUpToDate {
 		return oldResult, oldError
@@ -99,6 +107,60 @@ func (r *retryHelper) recomputeRetry(ctx context.Context, inst *lsv1alpha1.Insta
 	}
 }
 
+// deferToMaintenanceWindow checks whether a requested reconcile of the installation has to be deferred because it is
+// outside the maintenance window of the installation. This applies to all reconciles, e.g. reconciles requested by a
+// user, by the retry mechanism or by changed imports, unless the annotation "landscaper.gardener.cloud/ignore-maintenance-window"
+// is set to "true". If the reconcile is deferred, the duration until the begin of the next maintenance window is returned.
+func (r *retryHelper) deferToMaintenanceWindow(ctx context.Context, inst *lsv1alpha1.Installation) (time.Duration, bool, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+	currentOperation := "deferToMaintenanceWindow"
+
+	if r.hasIgnoreMaintenanceWindow(inst.ObjectMeta) {
+		return 0, false, nil
+	}
+
+	spec, err := maintenancewindow.GetForInstallation(ctx, r.cl, inst)
+	if err == nil && spec == nil {
+		return 0, false, nil
+	}
+
+	var window *maintenancewindow.Window
+	if err == nil {
+		window, err = maintenancewindow.New(spec)
+	}
+	if err != nil {
+		lsErr := lserrors.NewWrappedError(err, currentOperation, "GetMaintenanceWindow", err.Error())
+		inst.Status.LastError = lserrors.TryUpdateLsError(inst.Status.LastError, lsErr)
+		if err := r.writer.UpdateInstallationStatus(ctx, read_write_layer.W000166, inst); err != nil {
+			logger.Error(err, "failed to update last error of installation")
+		}
+		return 0, false, lsErr
+	}
+
+	now := r.now()
+	if window.IsOpen(now) {
+		return 0, false, nil
+	}
+
+	nextBegin := window.NextBegin(now)
+	if inst.Status.DeferredUntil == nil || !inst.Status.DeferredUntil.Time.Equal(nextBegin) {
+		logger.Info("defer reconcile until next maintenance window", "deferredUntil", nextBegin)
+		inst.Status.DeferredUntil = &metav1.Time{Time: nextBegin}
+		if err := r.writer.UpdateInstallationStatus(ctx, read_write_layer.W000165, inst); err != nil {
+			logger.Error(err, "failed to update deferral of reconcile")
+			return 0, false, err
+		}
+	}
+
+	return nextBegin.Sub(now), true, nil
+}
+
+// hasIgnoreMaintenanceWindow returns true if the annotation "landscaper.gardener.cloud/ignore-maintenance-window"
+// is set to "true".
+func (r *retryHelper) hasIgnoreMaintenanceWindow(obj metav1.ObjectMeta) bool {
+	return obj.GetAnnotations()[lsv1alpha1.IgnoreMaintenanceWindowAnnotation] == "true"
+}
+
 func (r *retryHelper) isFailed(inst *lsv1alpha1.Installation) bool {
 	return inst.Status.InstallationPhase == lsv1alpha1.InstallationPhaseFailed ||
 		inst.Status.InstallationPhase == lsv1alpha1.InstallationPhaseDeleteFailed
@@ -11,6 +11,7 @@ import (
 	"github.com/gardener/component-spec/bindings-go/ctf"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock/testing"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -249,5 +250,57 @@ var _ = Describe("Retry handler", func() {
 			Expect(inst.Status.AutomaticReconcileStatus.NumberOfReconciles).To(Equal(1))
 			Expect(inst.Status.AutomaticReconcileStatus.LastReconcileTime.Time.UnixMilli()).To(Equal(t6.UnixMilli()))
 		})
+
+		It("should defer a reconcile until the maintenance window", func() {
+			ctx := context.Background()
+
+			var err error
+			state, err = testenv.InitResources(ctx, "./testdata/state/test12")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testutils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+
+			inst := state.Installations[state.Namespace+"/root"]
+
+			// outside the maintenance window no new job is started
+			t1 := time.Date(2020, time.May, 1, 12, 0, 0, 0, time.UTC)
+			clok.SetTime(t1)
+			res, err := ctrl.Reconcile(ctx, testutils.RequestFromObject(inst))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.RequeueAfter).To(Equal(10 * time.Hour))
+			Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst)).To(Succeed())
+			Expect(inst.ObjectMeta.Annotations).To(HaveKeyWithValue(v1alpha1.OperationAnnotation, string(v1alpha1.ReconcileOperation))) // kept
+			Expect(inst.Status.JobID).To(Equal("job1"))
+			Expect(inst.Status.DeferredUntil).NotTo(BeNil())
+			Expect(inst.Status.DeferredUntil.Time.UTC()).To(Equal(time.Date(2020, time.May, 1, 22, 0, 0, 0, time.UTC)))
+
+			// inside the maintenance window the new job is started
+			t2 := time.Date(2020, time.May, 1, 23, 0, 0, 0, time.UTC)
+			clok.SetTime(t2)
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+			Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst)).To(Succeed())
+			Expect(inst.ObjectMeta.Annotations).NotTo(HaveKey(v1alpha1.OperationAnnotation)) // removed
+			Expect(inst.Status.JobID).NotTo(Equal("job1"))                                   // new job id
+			Expect(inst.Status.DeferredUntil).To(BeNil())
+		})
+
+		It("should not defer a reconcile with the ignore maintenance window annotation", func() {
+			ctx := context.Background()
+
+			var err error
+			state, err = testenv.InitResources(ctx, "./testdata/state/test12")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testutils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+
+			inst := state.Installations[state.Namespace+"/root"]
+			metav1.SetMetaDataAnnotation(&inst.ObjectMeta, v1alpha1.IgnoreMaintenanceWindowAnnotation, "true")
+			Expect(state.Client.Update(ctx, inst)).To(Succeed())
+
+			clok.SetTime(time.Date(2020, time.May, 1, 12, 0, 0, 0, time.UTC))
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+			Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst)).To(Succeed())
+			Expect(inst.ObjectMeta.Annotations).NotTo(HaveKey(v1alpha1.OperationAnnotation))               // removed
+			Expect(inst.ObjectMeta.Annotations).NotTo(HaveKey(v1alpha1.IgnoreMaintenanceWindowAnnotation)) // removed
+			Expect(inst.Status.JobID).NotTo(Equal("job1"))                                                 // new job id
+		})
 	})
 })
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/revisions"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// recordRevision adds the configuration that has been applied by the current job to the revision history
+// of the installation and prunes the history. It is called when the job has succeeded, so that only succeeded
+// configurations can be rolled back to. The status is not written.
+func (c *Controller) recordRevision(ctx context.Context, inst *lsv1alpha1.Installation) lserrors.LsError {
+	currentOperation := "recordRevision"
+
+	if !revisions.IsEnabled(inst) || isRollbackJob(inst) {
+		return nil
+	}
+
+	rev, err := c.newRevision(ctx, inst)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currentOperation, "NewRevision", err.Error())
+	}
+
+	history := revisions.NewHistory(c.Client(), inst)
+	rev, err = history.Record(ctx, rev)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currentOperation, "RecordRevision", err.Error())
+	}
+	inst.Status.LatestRevision = rev.Revision
+
+	if err := history.Prune(ctx, int(*inst.Spec.RevisionHistoryLimit)); err != nil {
+		return lserrors.NewWrappedError(err, currentOperation, "PruneRevisions", err.Error())
+	}
+	return nil
+}
+
+// handleRollbackOperation starts a rollback job that re-applies the revision of the revision history
+// which is specified by the rollback revision annotation. The rollback annotations are removed.
+// Invalid rollback operations are reported in the last error of the installation.
+func (c *Controller) handleRollbackOperation(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(inst).String()})
+	currentOperation := "handleRollbackOperation"
+
+	rev, lsErr := c.getRevisionFromAnnotation(ctx, inst)
+	if lsErr == nil && !installations.IsRootInstallation(inst) {
+		lsErr = lserrors.NewError(currentOperation, "CheckRootInstallation", "only root installations can be rolled back")
+	}
+	if lsErr == nil {
+		lsErr = c.checkRollback(inst, rev, lsv1alpha1.RollbackReasonOperation)
+	}
+
+	if lsErr != nil {
+		logger.Info("invalid rollback operation", "reason", lsErr.Error())
+		c.EventRecorder().Event(inst, corev1.EventTypeWarning, RollbackFailedReason, lsErr.Error())
+		inst.Status.LastError = lserrors.TryUpdateLsError(inst.Status.LastError, lsErr)
+		if err := c.Writer().UpdateInstallationStatus(ctx, read_write_layer.W000163, inst); err != nil {
+			return err
+		}
+	} else if err := c.startRollback(ctx, inst, rev, lsv1alpha1.RollbackReasonOperation, nil); err != nil {
+		return err
+	}
+
+	delete(inst.Annotations, lsv1alpha1.OperationAnnotation)
+	delete(inst.Annotations, lsv1alpha1.RollbackRevisionAnnotation)
+	return c.Writer().UpdateInstallation(ctx, read_write_layer.W000164, inst)
+}
+
+func (c *Controller) getRevisionFromAnnotation(ctx context.Context, inst *lsv1alpha1.Installation) (*lsv1alpha1.InstallationRevision, lserrors.LsError) {
+	currentOperation := "getRevisionFromAnnotation"
+
+	value, ok := inst.Annotations[lsv1alpha1.RollbackRevisionAnnotation]
+	if !ok {
+		return nil, lserrors.NewError(currentOperation, "GetRollbackRevision",
+			fmt.Sprintf("the annotation %q is required for a rollback", lsv1alpha1.RollbackRevisionAnnotation))
+	}
+
+	number, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err, currentOperation, "ParseRollbackRevision",
+			fmt.Sprintf("invalid revision %q: %s", value, err.Error()))
+	}
+
+	rev, err := revisions.NewHistory(c.Client(), inst).Get(ctx, number)
+	if err != nil {
+		if revisions.IsNotFound(err) {
+			return nil, lserrors.NewWrappedError(err, currentOperation, "GetRevision",
+				fmt.Sprintf("revision %d is not part of the revision history", number))
+		}
+		return nil, lserrors.NewWrappedError(err, currentOperation, "GetRevision", err.Error())
+	}
+	return rev, nil
+}
@@ -0,0 +1,490 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/revisions"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	// RollbackStartedReason is the reason of the rollback condition and event if a rollback has been started.
+	RollbackStartedReason = "RollbackStarted"
+	// RolledBackReason is the reason of the rollback condition and event if a rollback has succeeded.
+	RolledBackReason = "RolledBack"
+	// RollbackFailedReason is the reason of the rollback condition and event if a rollback has failed.
+	RollbackFailedReason = "RollbackFailed"
+	// RevisionSucceededReason is the reason of the rollback condition if a new revision has succeeded after a rollback.
+	RevisionSucceededReason = "RevisionSucceeded"
+)
+
+// isRollbackEnabled returns true if the installation has an enabled rollback policy.
+// Only root installations are rolled back as the configuration of subinstallations is managed by their parent.
+func isRollbackEnabled(inst *lsv1alpha1.Installation) bool {
+	return inst.Spec.RollbackPolicy != nil && inst.Spec.RollbackPolicy.Enabled && installations.IsRootInstallation(inst)
+}
+
+// isRollbackJob returns true if the current job of the installation re-applies the last succeeded revision.
+func isRollbackJob(inst *lsv1alpha1.Installation) bool {
+	return inst.Status.Rollback != nil && inst.Status.Rollback.JobID == inst.Status.JobID
+}
+
+// shouldRollback returns true if the current job of the installation has to be rolled back
+// to the last succeeded revision instead of failing.
+func shouldRollback(inst *lsv1alpha1.Installation) bool {
+	if !isRollbackEnabled(inst) || !inst.DeletionTimestamp.IsZero() || isRollbackJob(inst) {
+		return false
+	}
+
+	rev := inst.Status.LastSucceededRevision
+	if rev == nil || rev.JobID == inst.Status.JobID {
+		return false
+	}
+
+	// nothing to roll back if the failed job applied the same configuration as the revision
+	return rev.ObservedGeneration != inst.Status.ObservedGeneration || rev.ImportsHash != inst.Status.ImportsHash
+}
+
+// isJobTimedOut returns true if the current job of the installation takes longer than the timeout of the rollback policy.
+func (c *Controller) isJobTimedOut(inst *lsv1alpha1.Installation) bool {
+	if !isRollbackEnabled(inst) || isRollbackJob(inst) || inst.Spec.RollbackPolicy.Timeout == nil || inst.Status.JobIDGenerationTime == nil {
+		return false
+	}
+	return c.clock.Since(inst.Status.JobIDGenerationTime.Time) > inst.Spec.RollbackPolicy.Timeout.Duration
+}
+
+// timeUntilJobTimeout returns the duration until the current job of the installation exceeds the timeout of the
+// rollback policy. Zero is returned if the job has no timeout, has finished or has already timed out.
+func (c *Controller) timeUntilJobTimeout(inst *lsv1alpha1.Installation) time.Duration {
+	if !isRollbackEnabled(inst) || isRollbackJob(inst) || inst.Spec.RollbackPolicy.Timeout == nil || inst.Status.JobIDGenerationTime == nil ||
+		inst.Status.JobID == inst.Status.JobIDFinished {
+		return 0
+	}
+	d := inst.Status.JobIDGenerationTime.Add(inst.Spec.RollbackPolicy.Timeout.Duration).Sub(c.clock.Now())
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// handleJobTimeout handles a job of the installation that exceeded the timeout of the rollback policy.
+// A job that has not triggered its subobjects yet or that is computing its exports is failed or rolled back immediately.
+// The unfinished subobjects of a progressing job are interrupted; their failure then fails or rolls back the job.
+// It returns true if the job has been finished or rolled back.
+func (c *Controller) handleJobTimeout(ctx context.Context, inst *lsv1alpha1.Installation) (bool, lserrors.LsError) {
+	currentOperation := "handleJobTimeout"
+
+	switch inst.Status.InstallationPhase {
+	case lsv1alpha1.InstallationPhaseInit, lsv1alpha1.InstallationPhaseCleanupOrphaned, lsv1alpha1.InstallationPhaseCompleting:
+		lsErr := lserrors.NewError(currentOperation, "JobTimeout", "the job exceeded the timeout of the rollback policy",
+			lsv1alpha1.ErrorTimeout)
+		return true, c.failOrRollback(ctx, inst, lsErr, read_write_layer.W000187)
+	case lsv1alpha1.InstallationPhaseProgressing:
+		if err := c.interruptTimedOutJob(ctx, inst); err != nil {
+			return false, lserrors.NewWrappedError(err, currentOperation, "InterruptTimedOutJob", err.Error())
+		}
+	}
+	return false, nil
+}
+
+// failOrRollback sets the installation to failed.
+// If the installation has to be rolled back, a new job is started instead that re-applies the last succeeded revision.
+func (c *Controller) failOrRollback(ctx context.Context, inst *lsv1alpha1.Installation, lsError lserrors.LsError,
+	writeID read_write_layer.WriteID) lserrors.LsError {
+
+	if !shouldRollback(inst) {
+		return c.setInstallationPhaseAndUpdate(ctx, inst, lsv1alpha1.InstallationPhaseFailed, lsError, writeID)
+	}
+
+	reason := lsv1alpha1.RollbackReasonFailed
+	if c.isJobTimedOut(inst) {
+		reason = lsv1alpha1.RollbackReasonTimeout
+	}
+	if rejection := c.checkRollback(inst, inst.Status.LastSucceededRevision, reason); rejection != nil {
+		c.setRollbackFailed(inst, rejection.Error())
+		if lsError == nil {
+			lsError = rejection
+		}
+		return c.setInstallationPhaseAndUpdate(ctx, inst, lsv1alpha1.InstallationPhaseFailed, lsError, writeID)
+	}
+	return c.startRollback(ctx, inst, inst.Status.LastSucceededRevision, reason, lsError)
+}
+
+// checkRollback returns an error if the installation cannot be rolled back to the given revision.
+// A rollback that has been requested by the rollback operation recomputes the exports of the installation with its
+// current blueprint, so the revision must have been applied with the same blueprint and component descriptor.
+func (c *Controller) checkRollback(inst *lsv1alpha1.Installation, rev *lsv1alpha1.InstallationRevision,
+	reason lsv1alpha1.RollbackReason) lserrors.LsError {
+	currentOperation := "checkRollback"
+
+	if reason == lsv1alpha1.RollbackReasonOperation &&
+		(!reflect.DeepEqual(rev.Blueprint, inst.Spec.Blueprint) || !reflect.DeepEqual(rev.ComponentDescriptor, inst.Spec.ComponentDescriptor)) {
+		return lserrors.NewError(currentOperation, "CheckBlueprint",
+			fmt.Sprintf("revision %d cannot be rolled back, because it has been applied with another blueprint or component descriptor", rev.Revision))
+	}
+	return nil
+}
+
+// startRollback starts a new job that re-applies the given revision of the installation.
+func (c *Controller) startRollback(ctx context.Context, inst *lsv1alpha1.Installation, rev *lsv1alpha1.InstallationRevision,
+	reason lsv1alpha1.RollbackReason, lsError lserrors.LsError) lserrors.LsError {
+
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(inst).String()})
+
+	now := metav1.NewTime(c.clock.Now())
+	inst.Status.Rollback = &lsv1alpha1.RollbackStatus{
+		JobID:         uuid.New().String(),
+		RevisionJobID: rev.JobID,
+		Revision:      rev.Revision,
+		Reason:        reason,
+		StartTime:     now,
+	}
+
+	eventType := corev1.EventTypeNormal
+	msg := fmt.Sprintf("rolling back to revision %d applied by job %s", rev.Revision, rev.JobID)
+	if reason != lsv1alpha1.RollbackReasonOperation {
+		inst.Status.Rollback.FailedJobID = inst.Status.JobID
+		eventType = corev1.EventTypeWarning
+		msg = fmt.Sprintf("job %s did not succeed (%s): rolling back to the revision applied by job %s",
+			inst.Status.JobID, reason, rev.JobID)
+	}
+	logger.Info(msg)
+	c.EventRecorder().Event(inst, eventType, RollbackStartedReason, msg)
+	inst.Status.Conditions = lsv1alpha1helper.CreateOrUpdateConditions(inst.Status.Conditions, lsv1alpha1.RollbackCondition,
+		lsv1alpha1.ConditionProgressing, RollbackStartedReason, msg)
+
+	// the rollback is done by a new job so that the execution and its deploy items are reconciled again
+	inst.Status.JobID = inst.Status.Rollback.JobID
+	inst.Status.JobIDGenerationTime = &now
+
+	return c.setInstallationPhaseAndUpdate(ctx, inst, lsv1alpha1.InstallationPhaseInit, lsError, read_write_layer.W000156)
+}
+
+// handlePhaseInitRollback re-applies the execution and the subinstallations of the revision of the rollback.
+// The execution of the failed job is emptied if the revision has no deploy items.
+// The imports of the installation are not modified by a rollback.
+func (c *Controller) handlePhaseInitRollback(ctx context.Context, inst *lsv1alpha1.Installation) lserrors.LsError {
+	currentOperation := "handlePhaseInitRollback"
+
+	rev, lsErr := c.getRollbackRevision(ctx, inst)
+	if lsErr != nil {
+		c.setRollbackFailed(inst, lsErr.Error())
+		return lsErr
+	}
+
+	currentExec, err := executions.GetExecutionForInstallation(ctx, c.Client(), inst)
+	if err != nil {
+		lsErr = lserrors.NewWrappedError(err, currentOperation, "GetExecutionForInstallation", err.Error())
+		c.setRollbackFailed(inst, lsErr.Error())
+		return lsErr
+	}
+
+	if lsErr := c.restoreSubinstallations(ctx, inst, rev); lsErr != nil {
+		c.setRollbackFailed(inst, lsErr.Error())
+		return lsErr
+	}
+
+	if len(rev.DeployItems) != 0 || currentExec != nil {
+		exec := &lsv1alpha1.Execution{}
+		exec.Name = inst.Name
+		exec.Namespace = inst.Namespace
+		if _, err := c.Writer().CreateOrUpdateExecution(ctx, read_write_layer.W000157, exec, func() error {
+			exec.Spec.Context = inst.Spec.Context
+			exec.Spec.RegistryPullSecrets = inst.Spec.RegistryPullSecrets
+			exec.Spec.DeployItems = rev.DeployItems
+			exec.Spec.RolloutStrategy = rev.RolloutStrategy.DeepCopy()
+			metav1.SetMetaDataAnnotation(&exec.ObjectMeta, lsv1alpha1.OperationAnnotation, string(lsv1alpha1.ReconcileOperation))
+
+			if err := controllerutil.SetControllerReference(inst, exec, api.LandscaperScheme); err != nil {
+				return err
+			}
+			c.Scheme().Default(exec)
+			return nil
+		}); err != nil {
+			lsErr = lserrors.NewWrappedError(err, currentOperation, "CreateOrUpdateExecution", err.Error())
+			c.setRollbackFailed(inst, lsErr.Error())
+			return lsErr
+		}
+
+		inst.Status.ExecutionReference = &lsv1alpha1.ObjectReference{
+			Name:      exec.Name,
+			Namespace: exec.Namespace,
+		}
+	}
+
+	// a rollback operation recomputes the exports with the current imports in the completing phase
+	if inst.Status.Rollback.Reason != lsv1alpha1.RollbackReasonOperation {
+		inst.Status.ImportsHash = rev.ImportsHash
+	}
+	return nil
+}
+
+// restoreSubinstallations re-applies the specs of the subinstallations of the given revision.
+// Subinstallations that are not part of the revision are deleted; the cleanup phase waits for their deletion.
+// The restored subinstallations are reconciled with the import data that is currently available in their context.
+func (c *Controller) restoreSubinstallations(ctx context.Context, inst *lsv1alpha1.Installation, rev *lsv1alpha1.InstallationRevision) lserrors.LsError {
+	currentOperation := "restoreSubinstallations"
+
+	subInsts, err := installations.ListSubinstallations(ctx, c.Client(), inst)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currentOperation, "ListSubinstallations", err.Error())
+	}
+	current := map[string]*lsv1alpha1.Installation{}
+	for _, subInst := range subInsts {
+		current[subinstallationName(subInst)] = subInst
+	}
+
+	restored := map[string]bool{}
+	references := []lsv1alpha1.NamedObjectReference{}
+	for _, subRev := range rev.Subinstallations {
+		restored[subRev.Name] = true
+
+		subInst := current[subRev.Name]
+		if subInst == nil {
+			subInst = &lsv1alpha1.Installation{}
+			subInst.GenerateName = subRev.Name + "-"
+			subInst.Namespace = inst.Namespace
+		} else if !subInst.DeletionTimestamp.IsZero() {
+			return lserrors.NewError(currentOperation, "RestoreSubinstallation",
+				fmt.Sprintf("subinstallation %s cannot be restored, because it is being deleted", subInst.Name))
+		}
+
+		if _, err := c.Writer().CreateOrUpdateInstallation(ctx, read_write_layer.W000184, subInst, func() error {
+			metav1.SetMetaDataLabel(&subInst.ObjectMeta, lsv1alpha1.EncompassedByLabel, inst.Name)
+			metav1.SetMetaDataAnnotation(&subInst.ObjectMeta, lsv1alpha1.SubinstallationNameAnnotation, subRev.Name)
+			subInst.Spec = *subRev.Spec.DeepCopy()
+			return controllerutil.SetControllerReference(inst, subInst, api.LandscaperScheme)
+		}); err != nil {
+			return lserrors.NewWrappedError(err, currentOperation, "CreateOrUpdateInstallation", err.Error())
+		}
+		references = append(references, lsv1alpha1helper.NewInstallationReferenceState(subRev.Name, subInst))
+	}
+
+	for name, subInst := range current {
+		if restored[name] || !subInst.DeletionTimestamp.IsZero() {
+			continue
+		}
+		metav1.SetMetaDataAnnotation(&subInst.ObjectMeta, lsv1alpha1.DeleteIgnoreSuccessors, "true")
+		if err := c.Writer().UpdateInstallation(ctx, read_write_layer.W000185, subInst); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return lserrors.NewWrappedError(err, currentOperation, "UpdateInstallationDeleteIgnoreSuccessors", err.Error())
+		}
+		if err := c.Writer().DeleteInstallation(ctx, read_write_layer.W000186, subInst); err != nil && !apierrors.IsNotFound(err) {
+			return lserrors.NewWrappedError(err, currentOperation, "DeleteInstallation", err.Error())
+		}
+	}
+
+	inst.Status.InstallationReferences = references
+	return nil
+}
+
+// subinstallationName returns the name of the installation template of a subinstallation.
+func subinstallationName(subInst *lsv1alpha1.Installation) string {
+	if name, ok := subInst.Annotations[lsv1alpha1.SubinstallationNameAnnotation]; ok {
+		return name
+	}
+	return subInst.Name
+}
+
+// getRollbackRevision returns the revision that is re-applied by the current rollback job.
+// A rollback that has been triggered by the rollback operation re-applies a revision of the revision history,
+// an automatic rollback re-applies the last succeeded revision.
+func (c *Controller) getRollbackRevision(ctx context.Context, inst *lsv1alpha1.Installation) (*lsv1alpha1.InstallationRevision, lserrors.LsError) {
+	currentOperation := "getRollbackRevision"
+
+	if inst.Status.Rollback.Reason == lsv1alpha1.RollbackReasonOperation {
+		rev, err := revisions.NewHistory(c.Client(), inst).Get(ctx, inst.Status.Rollback.Revision)
+		if err != nil {
+			return nil, lserrors.NewWrappedError(err, currentOperation, "GetRevision", err.Error())
+		}
+		return rev, nil
+	}
+
+	if inst.Status.LastSucceededRevision == nil {
+		return nil, lserrors.NewError(currentOperation, "GetLastSucceededRevision", "no succeeded revision found")
+	}
+	return inst.Status.LastSucceededRevision, nil
+}
+
+// finishRollback finishes a rollback job.
+// An automatic rollback fails the installation as its current configuration has not been applied.
+// A rollback that has been requested by the rollback operation succeeds if the revision has been re-applied
+// and the exports have been recomputed in the completing phase.
+func (c *Controller) finishRollback(ctx context.Context, inst *lsv1alpha1.Installation, succeeded bool) lserrors.LsError {
+	currentOperation := "finishRollback"
+	rollback := inst.Status.Rollback
+
+	if !succeeded {
+		msg := fmt.Sprintf("rollback to the revision applied by job %s failed", rollback.RevisionJobID)
+		c.setRollbackFailed(inst, msg)
+		return c.setInstallationPhaseAndUpdate(ctx, inst, lsv1alpha1.InstallationPhaseFailed,
+			lserrors.NewError(currentOperation, RollbackFailedReason, msg), read_write_layer.W000158)
+	}
+
+	if rollback.Reason == lsv1alpha1.RollbackReasonOperation {
+		msg := fmt.Sprintf("rolled back to revision %d applied by job %s", rollback.Revision, rollback.RevisionJobID)
+		if isRollbackEnabled(inst) {
+			// the re-applied revision is the configuration an automatic rollback has to return to
+			rev, lsErr := c.getRollbackRevision(ctx, inst)
+			if lsErr != nil {
+				return c.setInstallationPhaseAndUpdate(ctx, inst, inst.Status.InstallationPhase, lsErr, read_write_layer.W000158)
+			}
+			inst.Status.LastSucceededRevision = rev
+		}
+		c.EventRecorder().Event(inst, corev1.EventTypeNormal, RolledBackReason, msg)
+		inst.Status.Conditions = lsv1alpha1helper.CreateOrUpdateConditions(inst.Status.Conditions, lsv1alpha1.RollbackCondition,
+			lsv1alpha1.ConditionTrue, RolledBackReason, msg)
+		return c.setInstallationPhaseAndUpdate(ctx, inst, lsv1alpha1.InstallationPhaseSucceeded, nil, read_write_layer.W000158)
+	}
+
+	msg := fmt.Sprintf("rolled back to the revision applied by job %s because job %s did not succeed (%s)",
+		rollback.RevisionJobID, rollback.FailedJobID, rollback.Reason)
+	c.EventRecorder().Event(inst, corev1.EventTypeNormal, RolledBackReason, msg)
+	inst.Status.Conditions = lsv1alpha1helper.CreateOrUpdateConditions(inst.Status.Conditions, lsv1alpha1.RollbackCondition,
+		lsv1alpha1.ConditionTrue, RolledBackReason, msg)
+	return c.setInstallationPhaseAndUpdate(ctx, inst, lsv1alpha1.InstallationPhaseFailed,
+		lserrors.NewError(currentOperation, RolledBackReason, msg), read_write_layer.W000158)
+}
+
+// isRollbackOperationJob returns true if the current job of the installation re-applies a revision
+// on request of the rollback operation.
+func isRollbackOperationJob(inst *lsv1alpha1.Installation) bool {
+	return isRollbackJob(inst) && inst.Status.Rollback.Reason == lsv1alpha1.RollbackReasonOperation
+}
+
+func (c *Controller) setRollbackFailed(inst *lsv1alpha1.Installation, msg string) {
+	c.EventRecorder().Event(inst, corev1.EventTypeWarning, RollbackFailedReason, msg)
+	inst.Status.Conditions = lsv1alpha1helper.CreateOrUpdateConditions(inst.Status.Conditions, lsv1alpha1.RollbackCondition,
+		lsv1alpha1.ConditionFalse, RollbackFailedReason, msg)
+}
+
+// recordSucceededRevision remembers the applied configuration of the installation as last succeeded revision.
+// The status is not written.
+func (c *Controller) recordSucceededRevision(ctx context.Context, inst *lsv1alpha1.Installation) lserrors.LsError {
+	currentOperation := "recordSucceededRevision"
+
+	if !isRollbackEnabled(inst) || isRollbackJob(inst) {
+		return nil
+	}
+
+	rev, err := c.newRevision(ctx, inst)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currentOperation, "NewRevision", err.Error())
+	}
+	if revisions.IsEnabled(inst) {
+		// the revision has been recorded in the history right before
+		rev.Revision = inst.Status.LatestRevision
+	}
+
+	inst.Status.LastSucceededRevision = rev
+
+	if cond := lsv1alpha1helper.GetCondition(inst.Status.Conditions, lsv1alpha1.RollbackCondition); cond != nil {
+		inst.Status.Conditions = lsv1alpha1helper.CreateOrUpdateConditions(inst.Status.Conditions, lsv1alpha1.RollbackCondition,
+			lsv1alpha1.ConditionFalse, RevisionSucceededReason, fmt.Sprintf("revision of job %s succeeded", rev.JobID))
+	}
+	return nil
+}
+
+// newRevision returns the currently applied configuration of the installation.
+func (c *Controller) newRevision(ctx context.Context, inst *lsv1alpha1.Installation) (*lsv1alpha1.InstallationRevision, error) {
+	rev := &lsv1alpha1.InstallationRevision{
+		JobID:               inst.Status.JobID,
+		ObservedGeneration:  inst.Status.ObservedGeneration,
+		AppliedTime:         metav1.NewTime(c.clock.Now()),
+		ComponentDescriptor: inst.Spec.ComponentDescriptor.DeepCopy(),
+		Blueprint:           *inst.Spec.Blueprint.DeepCopy(),
+		ImportsHash:         inst.Status.ImportsHash,
+	}
+
+	exec, err := executions.GetExecutionForInstallation(ctx, c.Client(), inst)
+	if err != nil {
+		return nil, err
+	}
+	if exec != nil {
+		rev.DeployItems = exec.Spec.DeployItems
+		rev.RolloutStrategy = exec.Spec.RolloutStrategy.DeepCopy()
+	}
+
+	subInsts, err := installations.ListSubinstallations(ctx, c.Client(), inst)
+	if err != nil {
+		return nil, err
+	}
+	for _, subInst := range subInsts {
+		if !subInst.DeletionTimestamp.IsZero() {
+			continue
+		}
+		rev.Subinstallations = append(rev.Subinstallations, lsv1alpha1.SubinstallationRevision{
+			Name: subinstallationName(subInst),
+			Spec: *subInst.Spec.DeepCopy(),
+		})
+	}
+	sort.Slice(rev.Subinstallations, func(i, j int) bool {
+		return rev.Subinstallations[i].Name < rev.Subinstallations[j].Name
+	})
+	return rev, nil
+}
+
+// interruptTimedOutJob interrupts the unfinished execution and subinstallations of a timed out installation.
+// The interrupted objects fail, which then triggers the rollback of the installation.
+func (c *Controller) interruptTimedOutJob(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	exec, err := executions.GetExecutionForInstallation(ctx, c.Client(), inst)
+	if err != nil {
+		return err
+	}
+
+	if exec != nil && exec.Status.JobIDFinished != exec.Status.JobID &&
+		!lsv1alpha1helper.HasOperation(exec.ObjectMeta, lsv1alpha1.InterruptOperation) {
+		lsv1alpha1helper.SetOperation(&exec.ObjectMeta, lsv1alpha1.InterruptOperation)
+		lsv1alpha1helper.Touch(&exec.ObjectMeta)
+
+		if err = c.Writer().UpdateExecution(ctx, read_write_layer.W000159, exec); err != nil {
+			return err
+		}
+	}
+
+	subInsts, err := installations.ListSubinstallations(ctx, c.Client(), inst)
+	if err != nil {
+		return err
+	}
+
+	for _, subInst := range subInsts {
+		if subInst.Status.JobIDFinished == subInst.Status.JobID ||
+			lsv1alpha1helper.HasOperation(subInst.ObjectMeta, lsv1alpha1.InterruptOperation) {
+			continue
+		}
+
+		lsv1alpha1helper.SetOperation(&subInst.ObjectMeta, lsv1alpha1.InterruptOperation)
+		lsv1alpha1helper.Touch(&subInst.ObjectMeta)
+
+		if err = c.Writer().UpdateInstallation(ctx, read_write_layer.W000160, subInst); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
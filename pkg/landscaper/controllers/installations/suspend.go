@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// propagateSuspension sets the suspended annotation at the subinstallations and the execution of the installation
+// if the installation is suspended, and removes it otherwise.
+func (c *Controller) propagateSuspension(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(inst).String()})
+	suspended := installations.IsSuspended(inst)
+
+	subInsts, err := installations.ListSubinstallations(ctx, c.Client(), inst)
+	if err != nil {
+		return err
+	}
+
+	for _, subInst := range subInsts {
+		if lsv1alpha1helper.SetSuspended(&subInst.ObjectMeta, suspended) {
+			logger.Debug("propagate suspension to subinstallation", "subinstallation", subInst.Name, "suspended", suspended)
+			if err := c.Writer().UpdateInstallation(ctx, read_write_layer.W000167, subInst); client.IgnoreNotFound(err) != nil {
+				return err
+			}
+		}
+	}
+
+	exec, err := executions.GetExecutionForInstallation(ctx, c.Client(), inst)
+	if err != nil {
+		return err
+	}
+
+	if exec != nil && lsv1alpha1helper.SetSuspended(&exec.ObjectMeta, suspended) {
+		logger.Debug("propagate suspension to execution", "execution", exec.Name, "suspended", suspended)
+		if err := c.Writer().UpdateExecution(ctx, read_write_layer.W000168, exec); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isJobStarted returns true if the installation has started to process its current job.
+func isJobStarted(inst *lsv1alpha1.Installation) bool {
+	return inst.Status.InstallationPhase != lsv1alpha1.InstallationPhaseSucceeded &&
+		inst.Status.InstallationPhase != lsv1alpha1.InstallationPhaseFailed &&
+		inst.Status.InstallationPhase != lsv1alpha1.InstallationPhaseDeleteFailed &&
+		inst.Status.InstallationPhase != ""
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targethealth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	tokenrequestresolver "github.com/gardener/landscaper/pkg/deployer/lib/targetresolver/tokenrequest"
+	"github.com/gardener/landscaper/pkg/utils"
+)
+
+// AddControllerToManager adds the target health controller to the controller manager.
+// That controller periodically checks whether the targets of known types are reachable
+// and records the result in the status of the targets.
+// Targets are checked again as soon as their spec or their referenced secret changes.
+func AddControllerToManager(logger logging.Logger, mgr manager.Manager, config config.TargetsController) error {
+	log := logger.Reconciles("targetHealth", "Target")
+	if config.Disable {
+		log.Info("Target health controller is disabled")
+		return nil
+	}
+
+	clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return fmt.Errorf("unable to create kubernetes clientset: %w", err)
+	}
+
+	c := NewController(log, mgr.GetClient(), tokenrequestresolver.New(clientset.CoreV1()), config)
+
+	// status updates of the targets do not trigger a check, whereas changes of their referenced secrets do.
+	enqueueTargetsOfSecret := handler.EnqueueRequestsFromMapFunc(func(secret client.Object) []reconcile.Request {
+		keys, err := TargetsOfSecret(context.Background(), mgr.GetClient(), secret)
+		if err != nil {
+			log.Error(err, "unable to list targets of secret", lc.KeyResource, client.ObjectKeyFromObject(secret).String())
+			return nil
+		}
+		requests := make([]reconcile.Request, len(keys))
+		for i, key := range keys {
+			requests[i] = reconcile.Request{NamespacedName: key}
+		}
+		return requests
+	})
+
+	return builder.ControllerManagedBy(mgr).
+		For(&lsv1alpha1.Target{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, enqueueTargetsOfSecret).
+		WithOptions(utils.ConvertCommonControllerConfigToControllerOptions(config.CommonControllerConfig)).
+		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return log.Logr() }).
+		Complete(c)
+}
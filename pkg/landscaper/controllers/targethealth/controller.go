@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targethealth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/deployer/lib"
+	"github.com/gardener/landscaper/pkg/deployer/lib/targetresolver"
+	secretresolver "github.com/gardener/landscaper/pkg/deployer/lib/targetresolver/secret"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// NewController creates a new target health controller.
+// The token request resolver is used to resolve kubernetes cluster token request targets.
+func NewController(logger logging.Logger,
+	kubeClient client.Client,
+	tokenRequestResolver targetresolver.TargetResolver,
+	config config.TargetsController) reconcile.Reconciler {
+	return &controller{
+		log:                  logger,
+		client:               kubeClient,
+		tokenRequestResolver: tokenRequestResolver,
+		config:               config,
+	}
+}
+
+type controller struct {
+	log                  logging.Logger
+	client               client.Client
+	tokenRequestResolver targetresolver.TargetResolver
+	config               config.TargetsController
+	// secretVersions contains the resource versions of the secrets referenced by the targets at their last check.
+	secretVersions sync.Map
+}
+
+// unreachableCheckInterval is the interval in which unreachable targets are checked.
+// It is shorter than the maximal age of failed checks that is considered by the deployers,
+// so that deploy items fail fast only as long as the target is unreachable.
+const unreachableCheckInterval = lib.UnhealthyTargetMaxAge / 2
+
+// IsCheckedTargetType returns true if the health of targets of the given type is checked.
+func IsCheckedTargetType(targetType lsv1alpha1.TargetType) bool {
+	return targetType == targettypes.KubernetesClusterTargetType ||
+		targetType == targettypes.KubernetesClusterTokenRequestTargetType
+}
+
+func (c *controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger, ctx := c.log.StartReconcileAndAddToContext(ctx, req)
+
+	target := &lsv1alpha1.Target{}
+	if err := c.client.Get(ctx, req.NamespacedName, target); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info(err.Error())
+			c.secretVersions.Delete(req.NamespacedName)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+	if !IsCheckedTargetType(target.Spec.Type) || target.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	secretVersion, err := c.getSecretVersion(ctx, target)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// the status of the current generation and referenced secret is still valid,
+	// so the check is postponed until the next interval.
+	if target.Status.LastCheckTime != nil && target.Status.ObservedGeneration == target.Generation &&
+		c.isCheckedSecretVersion(req.NamespacedName, secretVersion) {
+		if nextCheck := target.Status.LastCheckTime.Add(c.checkInterval(target.Status)); time.Now().Before(nextCheck) {
+			return reconcile.Result{RequeueAfter: time.Until(nextCheck)}, nil
+		}
+	}
+
+	target.Status = c.check(ctx, target)
+	if !target.Status.Reachable {
+		logger.Info("target is not reachable", lc.KeyReason, target.Status.Message)
+	}
+	if err := read_write_layer.NewWriter(c.client).UpdateTargetStatus(ctx, read_write_layer.W000179, target); err != nil {
+		return reconcile.Result{}, err
+	}
+	c.secretVersions.Store(req.NamespacedName, secretVersion)
+	return reconcile.Result{RequeueAfter: c.checkInterval(target.Status)}, nil
+}
+
+// checkInterval returns the interval after which a target with the given status is checked again.
+func (c *controller) checkInterval(status lsv1alpha1.TargetStatus) time.Duration {
+	interval := c.config.CheckInterval.Duration
+	if !status.Reachable && unreachableCheckInterval < interval {
+		return unreachableCheckInterval
+	}
+	return interval
+}
+
+// getSecretVersion returns the resource version of the secret that is referenced by the target.
+// An empty version is returned if the target does not reference an existing secret.
+func (c *controller) getSecretVersion(ctx context.Context, target *lsv1alpha1.Target) (string, error) {
+	if target.Spec.SecretRef == nil {
+		return "", nil
+	}
+	secret := &corev1.Secret{}
+	if err := c.client.Get(ctx, client.ObjectKey{Name: target.Spec.SecretRef.Name, Namespace: target.Namespace}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("unable to get secret of target: %w", err)
+	}
+	return secret.ResourceVersion, nil
+}
+
+// isCheckedSecretVersion returns true if the given version of the referenced secret has already been checked.
+// Targets that have not been checked since the start of the controller are assumed to be checked without a secret.
+func (c *controller) isCheckedSecretVersion(key types.NamespacedName, secretVersion string) bool {
+	checkedVersion, ok := c.secretVersions.Load(key)
+	if !ok {
+		return len(secretVersion) == 0
+	}
+	return checkedVersion == secretVersion
+}
+
+// TargetsOfSecret returns the targets in the namespace of the given secret that reference the secret.
+func TargetsOfSecret(ctx context.Context, kubeClient client.Client, secret client.Object) ([]types.NamespacedName, error) {
+	targets := &lsv1alpha1.TargetList{}
+	if err := kubeClient.List(ctx, targets, client.InNamespace(secret.GetNamespace())); err != nil {
+		return nil, err
+	}
+	var keys []types.NamespacedName
+	for _, target := range targets.Items {
+		if target.Spec.SecretRef != nil && target.Spec.SecretRef.Name == secret.GetName() && IsCheckedTargetType(target.Spec.Type) {
+			keys = append(keys, client.ObjectKeyFromObject(&target))
+		}
+	}
+	return keys, nil
+}
+
+// check accesses the cluster of the target and returns the resulting status of the target.
+func (c *controller) check(ctx context.Context, target *lsv1alpha1.Target) lsv1alpha1.TargetStatus {
+	now := metav1.Now()
+	status := lsv1alpha1.TargetStatus{
+		ObservedGeneration: target.Generation,
+		LastCheckTime:      &now,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.CheckTimeout.Duration)
+	defer cancel()
+	kubeconfig, err := c.getKubeconfig(ctx, target)
+	if err != nil {
+		status.Message = err.Error()
+		return status
+	}
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		status.Message = fmt.Sprintf("unable to create rest config from kubeconfig: %s", err.Error())
+		return status
+	}
+	restConfig.Timeout = c.config.CheckTimeout.Duration
+
+	if expiration := CredentialExpirationTime(restConfig); expiration != nil {
+		status.CredentialExpirationTime = &metav1.Time{Time: *expiration}
+		if !now.Before(status.CredentialExpirationTime) {
+			status.Message = fmt.Sprintf("credentials expired at %s", expiration.Format(time.RFC3339))
+			return status
+		}
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		status.Message = fmt.Sprintf("unable to create discovery client: %s", err.Error())
+		return status
+	}
+	version, err := discoveryClient.ServerVersion()
+	if err != nil {
+		status.Message = fmt.Sprintf("unable to get server version: %s", err.Error())
+		return status
+	}
+	status.Reachable = true
+	status.ServerVersion = version.GitVersion
+	return status
+}
+
+// getKubeconfig resolves the target and returns the kubeconfig of its cluster.
+func (c *controller) getKubeconfig(ctx context.Context, target *lsv1alpha1.Target) ([]byte, error) {
+	var (
+		rt  *lsv1alpha1.ResolvedTarget
+		err error
+	)
+	if target.Spec.Type == targettypes.KubernetesClusterTokenRequestTargetType {
+		rt, err = c.tokenRequestResolver.Resolve(ctx, target)
+	} else if target.Spec.SecretRef != nil {
+		rt, err = secretresolver.New(c.client).Resolve(ctx, target)
+	} else {
+		rt = targetresolver.NewResolvedTarget(target)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve target: %w", err)
+	}
+
+	targetConfig := &targettypes.KubernetesClusterTargetConfig{}
+	if err := json.Unmarshal([]byte(rt.Content), targetConfig); err != nil {
+		return nil, fmt.Errorf("unable to parse target configuration: %w", err)
+	}
+	kubeconfig, err := lib.GetKubeconfigFromTargetConfig(ctx, targetConfig, target.Namespace, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get kubeconfig of target: %w", err)
+	}
+	return kubeconfig, nil
+}
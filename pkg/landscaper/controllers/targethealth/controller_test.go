@@ -0,0 +1,262 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targethealth_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/deployer/lib"
+	"github.com/gardener/landscaper/pkg/deployer/lib/targetresolver/tokenrequest"
+	"github.com/gardener/landscaper/pkg/landscaper/controllers/targethealth"
+	"github.com/gardener/landscaper/pkg/utils"
+	testutils "github.com/gardener/landscaper/test/utils"
+)
+
+// fakeTokenRequestResolver resolves token request targets to a kubeconfig with a fixed token.
+type fakeTokenRequestResolver struct {
+	token string
+}
+
+func (f fakeTokenRequestResolver) Resolve(_ context.Context, target *lsv1alpha1.Target) (*lsv1alpha1.ResolvedTarget, error) {
+	config := &targettypes.KubernetesClusterTokenRequestTargetConfig{}
+	if err := json.Unmarshal(target.Spec.Configuration.RawMessage, config); err != nil {
+		return nil, err
+	}
+	kubeconfig, err := tokenrequest.BuildKubeconfig(config, f.token)
+	if err != nil {
+		return nil, err
+	}
+	content, err := json.Marshal(targettypes.KubernetesClusterTargetConfig{
+		Kubeconfig: targettypes.ValueRef{StrVal: pointerString(string(kubeconfig))},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &lsv1alpha1.ResolvedTarget{Target: target, Content: string(content)}, nil
+}
+
+func pointerString(s string) *string {
+	return &s
+}
+
+// jwt creates an unsigned JWT with the given expiration time.
+func jwt(expiration time.Time) string {
+	encode := func(v string) string { return base64.RawURLEncoding.EncodeToString([]byte(v)) }
+	return fmt.Sprintf("%s.%s.%s", encode(`{"alg":"none"}`), encode(fmt.Sprintf(`{"exp":%d}`, expiration.Unix())), "sig")
+}
+
+var _ = Describe("Target Health Controller", func() {
+
+	var (
+		ctx        context.Context
+		server     *httptest.Server
+		requests   int
+		kubeClient client.Client
+		ctrl       reconcile.Reconciler
+		interval   time.Duration
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		requests = 0
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if r.URL.Path != "/version" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(version.Info{GitVersion: "v1.24.3"})
+		}))
+
+		interval = 5 * time.Minute
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+		ctrl = targethealth.NewController(logging.Discard(), kubeClient, fakeTokenRequestResolver{token: jwt(time.Now().Add(time.Hour))}, config.TargetsController{
+			CheckInterval: &metav1.Duration{Duration: interval},
+			CheckTimeout:  &metav1.Duration{Duration: 5 * time.Second},
+		})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	createTarget := func(targetType string, config interface{}) *lsv1alpha1.Target {
+		target, err := utils.NewTargetBuilder(targetType).Key("default", "my-target").Config(config).Build()
+		testutils.ExpectNoError(err)
+		testutils.ExpectNoError(kubeClient.Create(ctx, target))
+		return target
+	}
+
+	caData := func() []byte {
+		return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	}
+
+	kubeconfigTarget := func(token string) *lsv1alpha1.Target {
+		kubeconfig, err := tokenrequest.BuildKubeconfig(&targettypes.KubernetesClusterTokenRequestTargetConfig{
+			Server: server.URL,
+			CAData: caData(),
+		}, token)
+		testutils.ExpectNoError(err)
+		return createTarget(string(targettypes.KubernetesClusterTargetType), targettypes.KubernetesClusterTargetConfig{
+			Kubeconfig: targettypes.ValueRef{StrVal: pointerString(string(kubeconfig))},
+		})
+	}
+
+	reconcileTarget := func(target *lsv1alpha1.Target) reconcile.Result {
+		res, err := ctrl.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(target)})
+		testutils.ExpectNoError(err)
+		testutils.ExpectNoError(kubeClient.Get(ctx, client.ObjectKeyFromObject(target), target))
+		return res
+	}
+
+	It("should record the server version and credential expiration of a reachable target", func() {
+		expiration := time.Now().Add(time.Hour).Truncate(time.Second)
+		target := kubeconfigTarget(jwt(expiration))
+
+		res := reconcileTarget(target)
+		Expect(res.RequeueAfter).To(Equal(interval))
+		Expect(target.Status.Reachable).To(BeTrue())
+		Expect(target.Status.ServerVersion).To(Equal("v1.24.3"))
+		Expect(target.Status.ObservedGeneration).To(Equal(target.Generation))
+		Expect(target.Status.LastCheckTime).ToNot(BeNil())
+		Expect(target.Status.CredentialExpirationTime).ToNot(BeNil())
+		Expect(target.Status.CredentialExpirationTime.Time.Equal(expiration)).To(BeTrue())
+		Expect(target.Status.Message).To(BeEmpty())
+	})
+
+	It("should not check a target again before the check interval has passed", func() {
+		target := kubeconfigTarget("token")
+
+		reconcileTarget(target)
+		Expect(requests).To(Equal(1))
+
+		res := reconcileTarget(target)
+		Expect(requests).To(Equal(1))
+		Expect(res.RequeueAfter).To(BeNumerically(">", 0))
+		Expect(res.RequeueAfter).To(BeNumerically("<=", interval))
+	})
+
+	It("should mark a target as not reachable if its cluster cannot be accessed", func() {
+		target := kubeconfigTarget("token")
+		server.Close()
+
+		reconcileTarget(target)
+		Expect(target.Status.Reachable).To(BeFalse())
+		Expect(target.Status.LastCheckTime).ToNot(BeNil())
+		Expect(target.Status.Message).To(ContainSubstring("unable to get server version"))
+	})
+
+	It("should check an unreachable target again after a short interval", func() {
+		target := kubeconfigTarget("token")
+		server.Close()
+
+		res := reconcileTarget(target)
+		Expect(target.Status.Reachable).To(BeFalse())
+		Expect(res.RequeueAfter).To(BeNumerically("<", interval))
+		Expect(res.RequeueAfter).To(BeNumerically("<", lib.UnhealthyTargetMaxAge))
+	})
+
+	It("should check a target again if its referenced secret changes", func() {
+		kubeconfig, err := tokenrequest.BuildKubeconfig(&targettypes.KubernetesClusterTokenRequestTargetConfig{
+			Server: server.URL,
+			CAData: caData(),
+		}, "token")
+		testutils.ExpectNoError(err)
+		content, err := json.Marshal(targettypes.KubernetesClusterTargetConfig{
+			Kubeconfig: targettypes.ValueRef{StrVal: pointerString(string(kubeconfig))},
+		})
+		testutils.ExpectNoError(err)
+		secret := &corev1.Secret{}
+		secret.Name = "my-secret"
+		secret.Namespace = "default"
+		secret.Data = map[string][]byte{"config": content}
+		testutils.ExpectNoError(kubeClient.Create(ctx, secret))
+
+		target, err := utils.NewTargetBuilder(string(targettypes.KubernetesClusterTargetType)).
+			Key("default", "my-target").
+			SecretRef(&lsv1alpha1.LocalSecretReference{Name: "my-secret", Key: "config"}).
+			Build()
+		testutils.ExpectNoError(err)
+		testutils.ExpectNoError(kubeClient.Create(ctx, target))
+
+		keys, err := targethealth.TargetsOfSecret(ctx, kubeClient, secret)
+		testutils.ExpectNoError(err)
+		Expect(keys).To(ConsistOf(client.ObjectKeyFromObject(target)))
+
+		reconcileTarget(target)
+		Expect(target.Status.Reachable).To(BeTrue())
+		reconcileTarget(target)
+		Expect(requests).To(Equal(1))
+
+		secret.Data["other"] = []byte("value")
+		testutils.ExpectNoError(kubeClient.Update(ctx, secret))
+		reconcileTarget(target)
+		Expect(requests).To(Equal(2))
+	})
+
+	It("should mark a target with expired credentials as not reachable", func() {
+		target := kubeconfigTarget(jwt(time.Now().Add(-time.Minute)))
+
+		reconcileTarget(target)
+		Expect(requests).To(Equal(0))
+		Expect(target.Status.Reachable).To(BeFalse())
+		Expect(target.Status.CredentialExpirationTime).ToNot(BeNil())
+		Expect(target.Status.Message).To(ContainSubstring("credentials expired"))
+	})
+
+	It("should mark a target with an invalid kubeconfig as not reachable", func() {
+		target := createTarget(string(targettypes.KubernetesClusterTargetType), targettypes.KubernetesClusterTargetConfig{
+			Kubeconfig: targettypes.ValueRef{StrVal: pointerString("invalid")},
+		})
+
+		reconcileTarget(target)
+		Expect(target.Status.Reachable).To(BeFalse())
+		Expect(target.Status.Message).ToNot(BeEmpty())
+	})
+
+	It("should check kubernetes cluster token request targets", func() {
+		target := createTarget(string(targettypes.KubernetesClusterTokenRequestTargetType), targettypes.KubernetesClusterTokenRequestTargetConfig{
+			Server:         server.URL,
+			CAData:         caData(),
+			ServiceAccount: targettypes.ServiceAccountReference{Name: "deployer"},
+		})
+
+		reconcileTarget(target)
+		Expect(target.Status.Reachable).To(BeTrue())
+		Expect(target.Status.ServerVersion).To(Equal("v1.24.3"))
+		Expect(target.Status.CredentialExpirationTime).ToNot(BeNil())
+	})
+
+	It("should ignore targets of unknown types", func() {
+		target := createTarget("landscaper.gardener.cloud/mock", map[string]string{"server": server.URL})
+
+		res := reconcileTarget(target)
+		Expect(res).To(Equal(reconcile.Result{}))
+		Expect(requests).To(Equal(0))
+		Expect(target.Status).To(Equal(lsv1alpha1.TargetStatus{}))
+	})
+})
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targethealth
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/rest"
+)
+
+// CredentialExpirationTime returns the time when the credentials of the given rest config expire.
+// The expiration time is read from inline client certificates and from bearer tokens that are JWTs with an expiration claim.
+// Nil is returned if the expiration time cannot be determined.
+func CredentialExpirationTime(restConfig *rest.Config) *time.Time {
+	if len(restConfig.CertData) != 0 {
+		return certificateExpirationTime(restConfig.CertData)
+	}
+	if len(restConfig.BearerToken) != 0 {
+		return tokenExpirationTime(restConfig.BearerToken)
+	}
+	return nil
+}
+
+// certificateExpirationTime returns the end of the validity period of the first certificate of the PEM-encoded data.
+func certificateExpirationTime(data []byte) *time.Time {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil
+		}
+		return &cert.NotAfter
+	}
+}
+
+// tokenExpirationTime returns the time of the expiration claim of a JWT.
+// The signature of the token is not verified.
+func tokenExpirationTime(token string) *time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil
+	}
+	claims := struct {
+		Expiration *int64 `json:"exp"`
+	}{}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Expiration == nil {
+		return nil
+	}
+	expiration := time.Unix(*claims.Expiration, 0)
+	return &expiration
+}
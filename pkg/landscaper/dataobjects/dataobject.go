@@ -27,6 +27,8 @@ var _ ImportedBase = &DataObject{}
 type DataObject struct {
 	Raw  *lsv1alpha1.DataObject
 	Data interface{}
+	// Sensitive defines whether the data is stored in a secret instead of the data object itself.
+	Sensitive bool
 
 	FieldValue *lsv1alpha1.FieldValueDefinition
 	Metadata   Metadata
@@ -58,15 +60,24 @@ func New() *DataObject {
 }
 
 // NewFromDataObject creates a new internal dataobject instance from a raw data object.
+// Sensitive data objects have to be resolved with ResolveDataObject.
 func NewFromDataObject(do *lsv1alpha1.DataObject) (*DataObject, error) {
+	if do.SecretRef != nil {
+		return nil, fmt.Errorf("data object %s is sensitive and its data has to be read from secret %s", do.Name, do.SecretRef.Name)
+	}
+	return newFromData(do, do.Data.RawMessage, false)
+}
+
+func newFromData(do *lsv1alpha1.DataObject, rawData []byte, sensitive bool) (*DataObject, error) {
 	var data interface{}
-	if err := yaml.Unmarshal(do.Data.RawMessage, &data); err != nil {
+	if err := yaml.Unmarshal(rawData, &data); err != nil {
 		return nil, err
 	}
 	return &DataObject{
-		Raw:      do,
-		Data:     data,
-		Metadata: GetMetadataFromObject(do, do.Data.RawMessage),
+		Raw:       do,
+		Data:      data,
+		Sensitive: sensitive,
+		Metadata:  GetMetadataFromObject(do, rawData),
 	}, nil
 }
 
@@ -188,36 +199,47 @@ func (do *DataObject) SetKey(key string) *DataObject {
 	return do
 }
 
+// SetSensitive defines whether the data of the given data object is stored in a secret.
+func (do *DataObject) SetSensitive(sensitive bool) *DataObject {
+	do.Sensitive = sensitive
+	return do
+}
+
 // Build creates a new data object based on the given data and metadata.
 func (do DataObject) Build() (*lsv1alpha1.DataObject, error) {
-	var (
-		raw = &lsv1alpha1.DataObject{}
-		err error
-	)
-	raw.Name = lsv1alpha1helper.GenerateDataObjectName(do.Metadata.Context, do.Metadata.Key)
-	raw.Namespace = do.Metadata.Namespace
-	raw.Data.RawMessage, err = json.MarshalIndent(do.Data, "", "  ")
-	if err != nil {
+	raw := &lsv1alpha1.DataObject{}
+	if err := do.Apply(raw); err != nil {
 		return nil, err
 	}
-	do.Metadata.Hash = generateHash(raw.Data.RawMessage)
-	SetMetadataFromObject(raw, do.Metadata)
 	return raw, nil
 }
 
 // Apply applies data and metadata to a existing object.
+// The data of sensitive data objects is not applied to the object but has to be written
+// into the referenced secret with CreateOrUpdateSecret.
 func (do DataObject) Apply(raw *lsv1alpha1.DataObject) error {
-	var (
-		err error
-	)
 	raw.Name = lsv1alpha1helper.GenerateDataObjectName(do.Metadata.Context, do.Metadata.Key)
 	raw.Namespace = do.Metadata.Namespace
-	raw.Data.RawMessage, err = json.MarshalIndent(do.Data, "", "  ")
+	data, err := json.MarshalIndent(do.Data, "", "  ")
 	if err != nil {
 		return err
 	}
-	do.Metadata.Hash = generateHash(raw.Data.RawMessage)
+	do.Metadata.Hash = generateHash(data)
 	SetMetadataFromObject(raw, do.Metadata)
+	if !do.Sensitive {
+		raw.Data.RawMessage = data
+		raw.SecretRef = nil
+		return nil
+	}
+
+	raw.Data = lsv1alpha1.AnyJSON{}
+	raw.SecretRef = &lsv1alpha1.LocalSecretReference{
+		Name: raw.Name,
+		Key:  lsv1alpha1.SensitiveDataObjectSecretKey,
+	}
+	// the hash of the data is not exposed as it could be used to guess the sensitive data.
+	// It is computed from the data in the secret when the data object is resolved.
+	delete(raw.Annotations, lsv1alpha1.DataObjectHashAnnotation)
 	return nil
 }
 
@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dataobjects
+
+// ResourceExportKind describes the kind of kubernetes object a resource export is written to.
+type ResourceExportKind string
+
+const (
+	// SecretResourceExportKind describes a resource export that is written into a secret.
+	SecretResourceExportKind ResourceExportKind = "Secret"
+	// ConfigMapResourceExportKind describes a resource export that is written into a configmap.
+	ConfigMapResourceExportKind ResourceExportKind = "ConfigMap"
+)
+
+// ResourceExport is the internal representation of an export that is written into a key of a secret or configmap.
+type ResourceExport struct {
+	// Kind is the kind of the object the export is written to.
+	Kind ResourceExportKind
+	// Export is the name of the export.
+	Export string
+	// Name is the name of the secret or configmap.
+	Name string
+	// Key is the key in the secret or configmap.
+	Key string
+	// Data is the (transformed) exported data.
+	Data []byte
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dataobjects
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/api"
+)
+
+// ResolveDataObject creates a new internal dataobject instance from a raw data object.
+// The data of sensitive data objects is read from the referenced secret.
+func ResolveDataObject(ctx context.Context, kubeClient client.Client, do *lsv1alpha1.DataObject) (*DataObject, error) {
+	if do.SecretRef == nil {
+		return NewFromDataObject(do)
+	}
+	secret := &corev1.Secret{}
+	if err := kubeClient.Get(ctx, kutil.ObjectKey(do.SecretRef.Name, do.Namespace), secret); err != nil {
+		return nil, fmt.Errorf("unable to get secret %s of sensitive data object %s: %w", do.SecretRef.Name, do.Name, err)
+	}
+	data, ok := secret.Data[do.SecretRef.Key]
+	if !ok {
+		return nil, fmt.Errorf("secret %s of sensitive data object %s does not contain key %q", do.SecretRef.Name, do.Name, do.SecretRef.Key)
+	}
+	return newFromData(do, data, true)
+}
+
+// CreateOrUpdateSecret writes the data of a sensitive data object into the secret that is referenced by the raw data object.
+// The secret is controlled by the data object so that it is garbage collected together with the data object.
+// Nothing is written for data objects that are not sensitive.
+func CreateOrUpdateSecret(ctx context.Context, kubeClient client.Client, do *DataObject, raw *lsv1alpha1.DataObject) error {
+	if raw.SecretRef == nil {
+		return nil
+	}
+	data, err := json.Marshal(do.Data)
+	if err != nil {
+		return fmt.Errorf("unable to marshal data of data object %s: %w", raw.Name, err)
+	}
+
+	secret := &corev1.Secret{}
+	secret.Name = raw.SecretRef.Name
+	secret.Namespace = raw.Namespace
+	if _, err := controllerutil.CreateOrUpdate(ctx, kubeClient, secret, func() error {
+		if len(secret.ResourceVersion) != 0 && !metav1.IsControlledBy(secret, raw) {
+			return fmt.Errorf("secret already exists and is not controlled by the data object")
+		}
+		if err := controllerutil.SetControllerReference(raw, secret, api.LandscaperScheme); err != nil {
+			return err
+		}
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = map[string][]byte{
+			raw.SecretRef.Key: data,
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("unable to create or update secret %s of sensitive data object %s: %w", secret.Name, raw.Name, err)
+	}
+	return nil
+}
@@ -3,6 +3,8 @@ package execution
 import (
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/util/sets"
+
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lserrors "github.com/gardener/landscaper/apis/errors"
 )
@@ -14,12 +16,14 @@ import (
 // - failed items:    they have the same jobID as the execution, are finished and not succeeded (=> failed)
 // - runnableItems:   they have an old jobID, which can be updated because there are no pending dependencies
 // - pending items:   they have an old jobID, which can not be updated because of pending dependencies
+// - continued items: they are failed, but the rollout of the execution has been continued despite the failure
 type DeployItemClassification struct {
-	runningItems   []*executionItem
-	succeededItems []*executionItem
-	failedItems    []*executionItem
-	runnableItems  []*executionItem
-	pendingItems   []*executionItem
+	runningItems         []*executionItem
+	succeededItems       []*executionItem
+	failedItems          []*executionItem
+	runnableItems        []*executionItem
+	pendingItems         []*executionItem
+	continuedFailedItems []*executionItem
 }
 
 func (c *DeployItemClassification) HasRunningItems() bool {
@@ -42,21 +46,51 @@ func (c *DeployItemClassification) HasPendingItems() bool {
 	return len(c.pendingItems) > 0
 }
 
+func (c *DeployItemClassification) HasContinuedFailedItems() bool {
+	return len(c.continuedFailedItems) > 0
+}
+
 func (c *DeployItemClassification) AllSucceeded() bool {
-	return !c.HasRunningItems() && !c.HasFailedItems() && !c.HasRunnableItems() && !c.HasPendingItems()
+	return !c.HasRunningItems() && !c.HasFailedItems() && !c.HasRunnableItems() && !c.HasPendingItems() &&
+		!c.HasContinuedFailedItems()
 }
 
 func (c *DeployItemClassification) GetRunnableItems() []*executionItem {
 	return c.runnableItems
 }
 
+// GetFailedItemNames returns the names of the failed items for which the rollout has not been continued.
+func (c *DeployItemClassification) GetFailedItemNames() []string {
+	names := make([]string, len(c.failedItems))
+	for i, item := range c.failedItems {
+		names[i] = item.Info.Name
+	}
+	return names
+}
+
 func newDeployItemClassification(executionJobID string, items []*executionItem) (*DeployItemClassification, lserrors.LsError) {
+	return newDeployItemClassificationWithRollout(executionJobID, items, nil, nil)
+}
+
+// newDeployItemClassificationWithRollout classifies the deploy items of an execution and respects the rollout
+// strategy and the rollout status of the execution.
+// - items of a wave are only runnable if all items of the lower waves have finished the current job.
+// - failed items for which the rollout has been continued are not treated as failed.
+// - runnable items that exceed the maximal number of items in progress are pending.
+func newDeployItemClassificationWithRollout(executionJobID string, items []*executionItem,
+	strategy *lsv1alpha1.RolloutStrategy, rolloutStatus *lsv1alpha1.RolloutStatus) (*DeployItemClassification, lserrors.LsError) {
 	c := &DeployItemClassification{
-		runningItems:   []*executionItem{},
-		succeededItems: []*executionItem{},
-		failedItems:    []*executionItem{},
-		runnableItems:  []*executionItem{},
-		pendingItems:   []*executionItem{},
+		runningItems:         []*executionItem{},
+		succeededItems:       []*executionItem{},
+		failedItems:          []*executionItem{},
+		runnableItems:        []*executionItem{},
+		pendingItems:         []*executionItem{},
+		continuedFailedItems: []*executionItem{},
+	}
+
+	continued := sets.NewString()
+	if rolloutStatus != nil {
+		continued.Insert(rolloutStatus.ContinuedFailedItems...)
 	}
 
 	for i := range items {
@@ -66,14 +100,14 @@ func newDeployItemClassification(executionJobID string, items []*executionItem)
 			// The items that we are classifying here were all created in the previous phase and should exist.
 			// But a user could have deleted items with "kubectl delete" or "landscaper-cli installations force-delete".
 			// We treat missing items as failed.
-			c.failedItems = append(c.failedItems, item)
+			c.addFailedItem(item, continued)
 		} else if item.DeployItem.Status.GetJobID() == executionJobID {
 			if item.DeployItem.Status.GetJobID() != item.DeployItem.Status.JobIDFinished {
 				c.runningItems = append(c.runningItems, item)
 			} else if item.DeployItem.Status.DeployItemPhase == lsv1alpha1.DeployItemPhaseSucceeded {
 				c.succeededItems = append(c.succeededItems, item)
 			} else {
-				c.failedItems = append(c.failedItems, item)
+				c.addFailedItem(item, continued)
 			}
 		} else {
 			runnable, lsErr := isItemRunnable(executionJobID, item, items)
@@ -89,12 +123,34 @@ func newDeployItemClassification(executionJobID string, items []*executionItem)
 		}
 	}
 
+	if strategy != nil && strategy.MaxInProgress > 0 {
+		free := int(strategy.MaxInProgress) - len(c.runningItems)
+		if free < 0 {
+			free = 0
+		}
+		if len(c.runnableItems) > free {
+			c.pendingItems = append(c.pendingItems, c.runnableItems[free:]...)
+			c.runnableItems = c.runnableItems[:free]
+		}
+	}
+
 	return c, nil
 }
 
+func (c *DeployItemClassification) addFailedItem(item *executionItem, continued sets.String) {
+	if continued.Has(item.Info.Name) {
+		c.continuedFailedItems = append(c.continuedFailedItems, item)
+	} else {
+		c.failedItems = append(c.failedItems, item)
+	}
+}
+
 func isItemRunnable(executionJobID string, item *executionItem, items []*executionItem) (bool, lserrors.LsError) {
-	if len(item.Info.DependsOn) == 0 {
-		return true, nil
+	// check that all items of the lower waves have finished the current job
+	for _, otherItem := range items {
+		if otherItem.Info.Wave < item.Info.Wave && !hasFinishedJob(executionJobID, otherItem) {
+			return false, nil
+		}
 	}
 
 	for _, dependentItemName := range item.Info.DependsOn {
@@ -105,7 +161,12 @@ func isItemRunnable(executionJobID string, item *executionItem, items []*executi
 		}
 
 		// check that the dependentItem has finished the current job
-		if dependentItem.DeployItem == nil || dependentItem.DeployItem.Status.JobIDFinished != executionJobID {
+		if !hasFinishedJob(executionJobID, dependentItem) {
+			return false, nil
+		}
+
+		// items that depend on a failed item are never started
+		if dependentItem.DeployItem.Status.DeployItemPhase != lsv1alpha1.DeployItemPhaseSucceeded {
 			return false, nil
 		}
 	}
@@ -113,6 +174,10 @@ func isItemRunnable(executionJobID string, item *executionItem, items []*executi
 	return true, nil
 }
 
+func hasFinishedJob(executionJobID string, item *executionItem) bool {
+	return item.DeployItem != nil && item.DeployItem.Status.JobIDFinished == executionJobID
+}
+
 func getItemByName(name string, items []*executionItem) *executionItem {
 	for _, item := range items {
 		if item.Info.Name == name {
@@ -171,9 +236,14 @@ func newDeployItemClassificationForOrphans(executionJobID string, deployitems []
 }
 
 func isItemDeletable(item *executionItem, items []*executionItem) bool {
-	// Check whether the item appears in the DependsOn list of a sibling item that is not yet deleted
+	// Check whether the item appears in the DependsOn list of a sibling item that is not yet deleted.
+	// The rollout waves are deleted in reverse order, so the item is also not deletable if a sibling item
+	// of a higher wave is not yet deleted.
 	for _, siblingItem := range items {
 		if siblingItem.DeployItem != nil {
+			if siblingItem.Info.Wave > item.Info.Wave {
+				return false
+			}
 			for _, dependentItemName := range siblingItem.Info.DependsOn {
 				if dependentItemName == item.Info.Name {
 					return false
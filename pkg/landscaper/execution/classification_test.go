@@ -84,6 +84,96 @@ var _ = Describe("DeployItem Classification", func() {
 		Expect(classification.pendingItems).To(ConsistOf(items[5], items[6]))
 	})
 
+	Context("Rollout", func() {
+		currJobID := "02"
+		prevJobID := "01"
+
+		withWave := func(item *executionItem, wave int32) *executionItem {
+			item.Info.Wave = wave
+			return item
+		}
+
+		It("should only start items of a wave if all items of the lower waves have finished", func() {
+			items := []*executionItem{
+				withWave(buildExecutionItem("a", nil, currJobID, currJobID, lsv1alpha1.DeployItemPhaseSucceeded), 0),
+				withWave(buildExecutionItem("b", nil, currJobID, prevJobID, lsv1alpha1.DeployItemPhaseProgressing), 0),
+				withWave(buildExecutionItem("c", nil, prevJobID, prevJobID, lsv1alpha1.DeployItemPhaseSucceeded), 0),
+				withWave(buildExecutionItem("d", nil, prevJobID, prevJobID, lsv1alpha1.DeployItemPhaseSucceeded), 1),
+				withWave(buildExecutionItem("e", nil, prevJobID, prevJobID, lsv1alpha1.DeployItemPhaseSucceeded), 2),
+			}
+
+			classification, err := newDeployItemClassificationWithRollout(currJobID, items, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(classification.succeededItems).To(ConsistOf(items[0]))
+			Expect(classification.runningItems).To(ConsistOf(items[1]))
+			Expect(classification.runnableItems).To(ConsistOf(items[2]))
+			Expect(classification.pendingItems).To(ConsistOf(items[3], items[4]))
+		})
+
+		It("should not start more items than the maximal number of items in progress", func() {
+			items := []*executionItem{
+				buildExecutionItem("a", nil, currJobID, prevJobID, lsv1alpha1.DeployItemPhaseProgressing),
+				buildExecutionItem("b", nil, prevJobID, prevJobID, lsv1alpha1.DeployItemPhaseSucceeded),
+				buildExecutionItem("c", nil, prevJobID, prevJobID, lsv1alpha1.DeployItemPhaseSucceeded),
+				buildExecutionItem("d", nil, prevJobID, prevJobID, lsv1alpha1.DeployItemPhaseSucceeded),
+			}
+
+			classification, err := newDeployItemClassificationWithRollout(currJobID, items,
+				&lsv1alpha1.RolloutStrategy{MaxInProgress: 2}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(classification.runningItems).To(ConsistOf(items[0]))
+			Expect(classification.runnableItems).To(ConsistOf(items[1]))
+			Expect(classification.pendingItems).To(ConsistOf(items[2], items[3]))
+		})
+
+		It("should not treat failed items as failed if the rollout has been continued", func() {
+			items := []*executionItem{
+				buildExecutionItem("a", nil, currJobID, currJobID, lsv1alpha1.DeployItemPhaseFailed),
+				buildExecutionItem("b", []string{"a"}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhaseSucceeded),
+				buildExecutionItem("c", nil, prevJobID, prevJobID, lsv1alpha1.DeployItemPhaseSucceeded),
+				buildExecutionItemWithoutDeployItem("d", nil),
+			}
+
+			classification, err := newDeployItemClassificationWithRollout(currJobID, items,
+				&lsv1alpha1.RolloutStrategy{PauseOnFailure: true},
+				&lsv1alpha1.RolloutStatus{ContinuedFailedItems: []string{"a"}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(classification.continuedFailedItems).To(ConsistOf(items[0]))
+			Expect(classification.failedItems).To(ConsistOf(items[3]))
+			Expect(classification.GetFailedItemNames()).To(ConsistOf("d"))
+			Expect(classification.runnableItems).To(ConsistOf(items[2]))
+			// items that depend on failed items are never started
+			Expect(classification.pendingItems).To(ConsistOf(items[1]))
+			Expect(classification.AllSucceeded()).To(BeFalse())
+		})
+
+		It("should delete the waves in reverse order", func() {
+			items := []*executionItem{
+				withWave(buildExecutionItem("a", nil, prevJobID, prevJobID, lsv1alpha1.DeployItemPhaseSucceeded), 0),
+				withWave(buildExecutionItem("b", nil, prevJobID, prevJobID, lsv1alpha1.DeployItemPhaseSucceeded), 1),
+				withWave(buildExecutionItem("c", nil, currJobID, prevJobID, lsv1alpha1.DeployItemPhaseDeleting), 2),
+				withWave(buildExecutionItemWithoutDeployItem("d", nil), 3),
+			}
+
+			classification, err := newDeployItemClassificationForDelete(currJobID, items)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(classification.succeededItems).To(ConsistOf(items[3]))
+			Expect(classification.runningItems).To(ConsistOf(items[2]))
+			Expect(classification.runnableItems).To(BeEmpty())
+			Expect(classification.pendingItems).To(ConsistOf(items[0], items[1]))
+
+			items[2].DeployItem = nil
+			classification, err = newDeployItemClassificationForDelete(currJobID, items)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(classification.runnableItems).To(ConsistOf(items[1]))
+			Expect(classification.pendingItems).To(ConsistOf(items[0]))
+		})
+	})
+
 	It("should classify execution items for delete", func() {
 		currJobID := "02"
 		prevJobID := "01"
@@ -86,7 +86,8 @@ func (o *Operation) TriggerDeployItems(ctx context.Context) (*DeployItemClassifi
 	}
 
 	// Trigger new and updated deploy items
-	classification, lsErr := newDeployItemClassification(o.exec.Status.JobID, items)
+	classification, lsErr := newDeployItemClassificationWithRollout(o.exec.Status.JobID, items,
+		o.exec.Spec.RolloutStrategy, o.exec.Status.Rollout)
 	if lsErr != nil {
 		return nil, lsErr
 	}
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// rolloutPausedReason is the reason of the last error of an execution whose rollout is paused.
+const rolloutPausedReason = "RolloutPaused"
+
+// ShouldPauseOnFailure returns true if the rollout of the execution has to be paused if deploy items have failed.
+func ShouldPauseOnFailure(exec *lsv1alpha1.Execution) bool {
+	return exec.Spec.RolloutStrategy != nil && exec.Spec.RolloutStrategy.PauseOnFailure
+}
+
+// PauseRollout pauses the rollout of the execution because of the failed deploy items of the given classification.
+// The execution remains in its current phase until the rollout is continued.
+func (o *Operation) PauseRollout(ctx context.Context, classification *DeployItemClassification) lserrors.LsError {
+	op := "PauseRollout"
+
+	msg := fmt.Sprintf("rollout paused because of failed deploy items %s: set the operation annotation %q to continue the rollout",
+		strings.Join(classification.GetFailedItemNames(), ", "), lsv1alpha1.ContinueOperation)
+
+	// the status is only updated if the rollout is paused or the failed items have changed
+	lastError := o.exec.Status.LastError
+	if o.exec.Status.Rollout != nil && o.exec.Status.Rollout.Paused &&
+		lastError != nil && lastError.Reason == rolloutPausedReason && lastError.Message == msg {
+		return nil
+	}
+
+	if o.exec.Status.Rollout == nil {
+		o.exec.Status.Rollout = &lsv1alpha1.RolloutStatus{}
+	}
+	o.exec.Status.Rollout.Paused = true
+	o.exec.Status.LastError = lserrors.TryUpdateLsError(o.exec.Status.LastError, lserrors.NewError(op, rolloutPausedReason, msg))
+
+	if err := o.Writer().UpdateExecutionStatus(ctx, read_write_layer.W000154, o.exec); err != nil {
+		return lserrors.NewWrappedError(err, op, "UpdateExecutionStatus", err.Error())
+	}
+	return nil
+}
+
+// ContinueRollout continues a paused rollout of the execution.
+// The currently failed deploy items are ignored for the remaining rollout.
+func (o *Operation) ContinueRollout(ctx context.Context) lserrors.LsError {
+	op := "ContinueRollout"
+
+	if o.exec.Status.Rollout == nil || !o.exec.Status.Rollout.Paused {
+		return nil
+	}
+
+	items, _, lsErr := o.getDeployItems(ctx)
+	if lsErr != nil {
+		return lsErr
+	}
+
+	classification, lsErr := newDeployItemClassificationWithRollout(o.exec.Status.JobID, items,
+		o.exec.Spec.RolloutStrategy, o.exec.Status.Rollout)
+	if lsErr != nil {
+		return lsErr
+	}
+
+	continued := sets.NewString(o.exec.Status.Rollout.ContinuedFailedItems...)
+	continued.Insert(classification.GetFailedItemNames()...)
+	o.exec.Status.Rollout.ContinuedFailedItems = continued.List()
+	o.exec.Status.Rollout.Paused = false
+
+	if err := o.Writer().UpdateExecutionStatus(ctx, read_write_layer.W000153, o.exec); err != nil {
+		return lserrors.NewWrappedError(err, op, "UpdateExecutionStatus", err.Error())
+	}
+	return nil
+}
@@ -34,5 +34,5 @@ func (o *ExecutionOperation) GetExportedValues(ctx context.Context, inst *instal
 		return nil, err
 	}
 
-	return dataobjects.NewFromDataObject(rawDO)
+	return dataobjects.ResolveDataObject(ctx, o.Client(), rawDO)
 }
@@ -22,8 +22,7 @@ import (
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
-	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
-	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/templaters"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
@@ -53,13 +52,18 @@ func New(op *installations.Operation) *ExecutionOperation {
 }
 
 func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context, inst *installations.InstallationImportsAndBlueprint) (core.DeployItemTemplateList, error) {
-	cond := lsv1alpha1helper.GetOrInitCondition(inst.GetInstallation().Status.Conditions, lsv1alpha1.ReconcileExecutionCondition)
-
 	templateStateHandler := template.KubernetesStateHandler{
 		KubeClient: o.Client(),
 		Inst:       inst.GetInstallation(),
 	}
-	tmpl := template.New(gotemplate.New(o.BlobResolver, templateStateHandler), spiff.New(templateStateHandler))
+	return o.renderDeployItemTemplates(ctx, inst, templateStateHandler)
+}
+
+func (o *ExecutionOperation) renderDeployItemTemplates(_ context.Context, inst *installations.InstallationImportsAndBlueprint,
+	templateStateHandler template.GenericStateHandler) (core.DeployItemTemplateList, error) {
+	cond := lsv1alpha1helper.GetOrInitCondition(inst.GetInstallation().Status.Conditions, lsv1alpha1.ReconcileExecutionCondition)
+
+	tmpl := templaters.New(templaters.Options{BlobResolver: o.BlobResolver, State: templateStateHandler})
 	executions, err := tmpl.TemplateDeployExecutions(
 		template.NewDeployExecutionOptions(
 			template.NewBlueprintExecutionOptions(
@@ -122,6 +126,7 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context, inst
 			Labels:             elem.Labels,
 			Configuration:      elem.Configuration,
 			DependsOn:          elem.DependsOn,
+			Wave:               elem.Wave,
 			UpdateOnChangeOnly: elem.UpdateOnChangeOnly,
 		}
 	}
@@ -160,6 +165,7 @@ func (o *ExecutionOperation) Ensure(ctx context.Context, inst *installations.Ins
 	if _, err := o.Writer().CreateOrUpdateExecution(ctx, read_write_layer.W000022, exec, func() error {
 		exec.Spec.Context = inst.GetInstallation().Spec.Context
 		exec.Spec.DeployItems = versionedDeployItemTemplateList
+		exec.Spec.RolloutStrategy = inst.GetBlueprint().Info.RolloutStrategy.DeepCopy()
 
 		if lsv1alpha1helper.HasOperation(inst.GetInstallation().ObjectMeta, lsv1alpha1.ForceReconcileOperation) {
 			metav1.SetMetaDataAnnotation(&exec.ObjectMeta, lsv1alpha1.OperationAnnotation, string(lsv1alpha1.ForceReconcileOperation))
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package executions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+)
+
+// Plan renders the deploy item templates of the installation and compares them with the deploy items
+// of the current execution without writing anything.
+// The template state is read from the cluster but not persisted.
+func (o *ExecutionOperation) Plan(ctx context.Context, inst *installations.InstallationImportsAndBlueprint) ([]lsv1alpha1.PlannedChange, error) {
+	templateStateHandler := template.NewReadOnlyStateHandler(template.KubernetesStateHandler{
+		KubeClient: o.Client(),
+		Inst:       inst.GetInstallation(),
+	})
+	execTemplates, err := o.renderDeployItemTemplates(ctx, inst, templateStateHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	exec, err := GetExecutionForInstallation(ctx, o.Client(), inst.GetInstallation())
+	if err != nil {
+		return nil, err
+	}
+
+	current := lsv1alpha1.DeployItemTemplateList{}
+	if exec != nil {
+		current = exec.Spec.DeployItems
+	}
+
+	if execTemplates == nil {
+		// the execution is not touched if the blueprint does not define any deploy items
+		return PlanDeployItems(exec, current)
+	}
+
+	desired := lsv1alpha1.DeployItemTemplateList{}
+	if err := lsv1alpha1.Convert_core_DeployItemTemplateList_To_v1alpha1_DeployItemTemplateList(&execTemplates, &desired, nil); err != nil {
+		return nil, fmt.Errorf("error converting internal representation of deployitem templates to versioned one: %w", err)
+	}
+	return PlanDeployItems(exec, desired)
+}
+
+// PlanDeployItems compares the deploy item templates of the given execution with the desired deploy item templates.
+// The execution may be nil if it does not exist yet.
+func PlanDeployItems(exec *lsv1alpha1.Execution, desired lsv1alpha1.DeployItemTemplateList) ([]lsv1alpha1.PlannedChange, error) {
+	currentTemplates := map[string]lsv1alpha1.DeployItemTemplate{}
+	references := map[string]*lsv1alpha1.ObjectReference{}
+	if exec != nil {
+		for _, tmpl := range exec.Spec.DeployItems {
+			currentTemplates[tmpl.Name] = tmpl
+		}
+		for _, ref := range exec.Status.DeployItemReferences {
+			r := ref.Reference.ObjectReference
+			references[ref.Name] = &r
+		}
+	}
+
+	changes := make([]lsv1alpha1.PlannedChange, 0, len(desired))
+	desiredNames := map[string]bool{}
+	for _, tmpl := range desired {
+		desiredNames[tmpl.Name] = true
+		currentTmpl, ok := currentTemplates[tmpl.Name]
+		if !ok {
+			changes = append(changes, lsv1alpha1.PlannedChange{
+				Name:   tmpl.Name,
+				Kind:   installations.PlanKindDeployItem,
+				Action: lsv1alpha1.PlanActionCreate,
+			})
+			continue
+		}
+
+		changedFields, err := installations.ChangedFields(currentTmpl, tmpl)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, installations.NewPlannedChange(tmpl.Name, installations.PlanKindDeployItem,
+			references[tmpl.Name], changedFields))
+	}
+
+	removedNames := make([]string, 0)
+	for name := range currentTemplates {
+		if !desiredNames[name] {
+			removedNames = append(removedNames, name)
+		}
+	}
+	sort.Strings(removedNames)
+	for _, name := range removedNames {
+		changes = append(changes, lsv1alpha1.PlannedChange{
+			Name:      name,
+			Kind:      installations.PlanKindDeployItem,
+			Action:    lsv1alpha1.PlanActionDelete,
+			Reference: references[name],
+		})
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package executions_test
+
+import (
+	"context"
+
+	"github.com/gardener/component-spec/bindings-go/ctf"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/imports"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/reconcilehelper"
+	lsoperation "github.com/gardener/landscaper/pkg/landscaper/operation"
+	componentsregistry "github.com/gardener/landscaper/pkg/landscaper/registry/components"
+	"github.com/gardener/landscaper/test/utils/envtest"
+)
+
+var _ = Describe("Plan", func() {
+
+	var (
+		op *installations.Operation
+
+		fakeInstallations map[string]*lsv1alpha1.Installation
+		fakeClient        client.Client
+		fakeCompRepo      ctf.ComponentResolver
+	)
+
+	Load := func(inst string) (context.Context, *installations.InstallationImportsAndBlueprint) {
+		ctx := context.Background()
+		inInstRoot, err := installations.CreateInternalInstallation(ctx, op.ComponentsRegistry(), fakeInstallations[inst])
+		Expect(err).ToNot(HaveOccurred())
+		op.Inst = inInstRoot
+		Expect(op.SetInstallationContext(ctx)).To(Succeed())
+
+		rh, err := reconcilehelper.NewReconcileHelper(ctx, op)
+		Expect(err).ToNot(HaveOccurred())
+		imps, err := rh.ImportsSatisfied()
+		Expect(err).NotTo(HaveOccurred())
+		c := imports.NewConstructor(op)
+		Expect(c.Construct(ctx, imps)).To(Succeed())
+		return ctx, inInstRoot
+	}
+
+	BeforeEach(func() {
+		var (
+			err   error
+			state *envtest.State
+		)
+		fakeClient, state, err = envtest.NewFakeClientFromPath("./testdata/state")
+		Expect(err).ToNot(HaveOccurred())
+
+		createDefaultContextsForNamespace(fakeClient)
+		fakeInstallations = state.Installations
+
+		fakeCompRepo, err = componentsregistry.NewLocalClient("./testdata/registry")
+		Expect(err).ToNot(HaveOccurred())
+
+		op = &installations.Operation{
+			Operation: lsoperation.NewOperation(fakeClient, api.LandscaperScheme, record.NewFakeRecorder(1024)).
+				SetComponentsRegistry(fakeCompRepo),
+		}
+	})
+
+	It("should plan to create all deploy items if no execution exists", func() {
+		ctx, inst := Load("test2/root")
+		changes, err := executions.New(op).Plan(ctx, inst)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(HaveLen(3))
+		for _, change := range changes {
+			Expect(change.Kind).To(Equal(installations.PlanKindDeployItem))
+			Expect(change.Action).To(Equal(lsv1alpha1.PlanActionCreate))
+		}
+
+		exec := &lsv1alpha1.Execution{}
+		Expect(fakeClient.Get(ctx, kutil.ObjectKeyFromObject(inst.GetInstallation()), exec)).ToNot(Succeed())
+	})
+
+	It("should report unchanged and changed deploy items of an existing execution", func() {
+		ctx, inst := Load("test2/root")
+		Expect(executions.New(op).Ensure(ctx, inst)).To(Succeed())
+
+		changes, err := executions.New(op).Plan(ctx, inst)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(HaveLen(3))
+		for _, change := range changes {
+			Expect(change.Action).To(Equal(lsv1alpha1.PlanActionUnchanged))
+		}
+
+		exec := &lsv1alpha1.Execution{}
+		Expect(fakeClient.Get(ctx, kutil.ObjectKeyFromObject(inst.GetInstallation()), exec)).To(Succeed())
+		exec.Spec.DeployItems[0].Configuration = &runtime.RawExtension{Raw: []byte(`{"changed": true}`)}
+		exec.Spec.DeployItems = append(exec.Spec.DeployItems, lsv1alpha1.DeployItemTemplate{Name: "removed"})
+		Expect(fakeClient.Update(ctx, exec)).To(Succeed())
+
+		changes, err = executions.New(op).Plan(ctx, inst)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(HaveLen(4))
+		Expect(changes[0].Action).To(Equal(lsv1alpha1.PlanActionUpdate))
+		Expect(changes[0].ChangedFields).To(ConsistOf("config"))
+		Expect(changes[3].Name).To(Equal("removed"))
+		Expect(changes[3].Action).To(Equal(lsv1alpha1.PlanActionDelete))
+	})
+
+})
+
+var _ = Describe("PlanDeployItems", func() {
+
+	It("should compare the deploy item templates of an execution", func() {
+		exec := &lsv1alpha1.Execution{}
+		exec.Spec.DeployItems = lsv1alpha1.DeployItemTemplateList{
+			{Name: "a", Type: "mock", Configuration: &runtime.RawExtension{Raw: []byte(`{"a": 1}`)}},
+			{Name: "b", Type: "mock", Configuration: &runtime.RawExtension{Raw: []byte(`{"b": 1}`)}},
+			{Name: "c", Type: "mock"},
+		}
+		exec.Status.DeployItemReferences = []lsv1alpha1.VersionedNamedObjectReference{
+			{Name: "a", Reference: lsv1alpha1.VersionedObjectReference{ObjectReference: lsv1alpha1.ObjectReference{Name: "di-a", Namespace: "default"}}},
+		}
+
+		desired := lsv1alpha1.DeployItemTemplateList{
+			{Name: "a", Type: "mock", Configuration: &runtime.RawExtension{Raw: []byte(`{ "a": 1 }`)}},
+			{Name: "b", Type: "mock", Configuration: &runtime.RawExtension{Raw: []byte(`{"b": 2}`)}, DependsOn: []string{"a"}},
+			{Name: "d", Type: "mock"},
+		}
+
+		changes, err := executions.PlanDeployItems(exec, desired)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(Equal([]lsv1alpha1.PlannedChange{
+			{
+				Name:      "a",
+				Kind:      installations.PlanKindDeployItem,
+				Action:    lsv1alpha1.PlanActionUnchanged,
+				Reference: &lsv1alpha1.ObjectReference{Name: "di-a", Namespace: "default"},
+			},
+			{
+				Name:          "b",
+				Kind:          installations.PlanKindDeployItem,
+				Action:        lsv1alpha1.PlanActionUpdate,
+				ChangedFields: []string{"config.b", "dependsOn"},
+			},
+			{
+				Name:   "d",
+				Kind:   installations.PlanKindDeployItem,
+				Action: lsv1alpha1.PlanActionCreate,
+			},
+			{
+				Name:   "c",
+				Kind:   installations.PlanKindDeployItem,
+				Action: lsv1alpha1.PlanActionDelete,
+			},
+		}))
+	})
+
+	It("should plan to create all deploy items if there is no execution", func() {
+		changes, err := executions.PlanDeployItems(nil, lsv1alpha1.DeployItemTemplateList{{Name: "a"}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(HaveLen(1))
+		Expect(changes[0].Action).To(Equal(lsv1alpha1.PlanActionCreate))
+	})
+
+})
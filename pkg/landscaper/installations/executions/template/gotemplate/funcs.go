@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	gotmpl "text/template"
 
@@ -36,8 +37,9 @@ func LandscaperSprigFuncMap() gotmpl.FuncMap {
 // available in the executors templates.
 func LandscaperTplFuncMap(fs vfs.FileSystem, cd *cdv2.ComponentDescriptor, cdList *cdv2.ComponentDescriptorList, blobResolver ctf.BlobResolver) map[string]interface{} {
 	funcs := map[string]interface{}{
-		"readFile": readFileFunc(fs),
-		"readDir":  readDir(fs),
+		"readFile":  readFileFunc(fs),
+		"readDir":   readDir(fs),
+		"readFiles": readFilesFunc(fs),
 
 		"toYaml": toYAML,
 
@@ -80,6 +82,36 @@ func readDir(fs vfs.FileSystem) func(path string) []os.FileInfo {
 	}
 }
 
+// readFilesFunc returns a function that reads all files of a directory and its subdirectories.
+// The files are returned as map of their paths relative to the directory to their content.
+func readFilesFunc(fs vfs.FileSystem) func(root string) (map[string]string, error) {
+	return func(root string) (map[string]string, error) {
+		files := map[string]string{}
+		err := vfs.Walk(fs, root, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				return nil
+			}
+			data, err := vfs.ReadFile(fs, path)
+			if err != nil {
+				return err
+			}
+			relPath, err := filepath.Rel(root, path)
+			if err != nil {
+				return err
+			}
+			files[filepath.ToSlash(relPath)] = string(data)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to read files of %q: %w", root, err)
+		}
+		return files, nil
+	}
+}
+
 // toYAML takes an interface, marshals it to yaml, and returns a string. It will
 // always return a string, even on marshal error (empty string).
 //
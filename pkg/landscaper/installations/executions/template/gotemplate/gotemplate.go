@@ -39,7 +39,7 @@ func New(blobResolver ctf.BlobResolver, state lstmpl.GenericStateHandler) *Templ
 	return &Templater{
 		blobResolver:   blobResolver,
 		state:          state,
-		inputFormatter: lstmpl.NewTemplateInputFormatter(false, "imports", "values", "state"),
+		inputFormatter: lstmpl.NewTemplateInputFormatter(false, "imports", "values", "state", "dataobjects"),
 	}
 }
 
@@ -64,4 +64,25 @@ var _ = Describe("TemplateDeployExecutions", func() {
 		Expect(res).To(BeEquivalentTo("config:\n  value: foo\n  const: bar"))
 	})
 
+	It("should render a go template with all files of a directory", func() {
+		fs := memoryfs.New()
+		Expect(fs.MkdirAll("kustomize/base", 0755)).To(Succeed())
+		Expect(vfs.WriteFile(fs, "kustomize/kustomization.yaml", []byte("resources:\n- base"), 0600)).To(Succeed())
+		Expect(vfs.WriteFile(fs, "kustomize/base/cm.yaml", []byte("kind: ConfigMap"), 0600)).To(Succeed())
+		bp := blueprints.New(nil, fs)
+		tmpl := `{{ readFiles "kustomize" | toJson }}`
+		t := gotemplate.NewTemplateExecution(bp, nil, nil, nil)
+		res, err := t.Execute(tmpl, map[string]interface{}{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(MatchJSON(`{"kustomization.yaml": "resources:\n- base", "base/cm.yaml": "kind: ConfigMap"}`))
+	})
+
+	It("should return an error if the files of a directory cannot be read", func() {
+		bp := blueprints.New(nil, memoryfs.New())
+		tmpl := `{{ readFiles "kustomize" | toJson }}`
+		t := gotemplate.NewTemplateExecution(bp, nil, nil, nil)
+		_, err := t.Execute(tmpl, map[string]interface{}{})
+		Expect(err).To(MatchError(ContainSubstring("unable to read files of \"kustomize\"")))
+	})
+
 })
@@ -30,7 +30,7 @@ type Templater struct {
 func New(state template.GenericStateHandler) *Templater {
 	return &Templater{
 		state:          state,
-		inputFormatter: template.NewTemplateInputFormatter(false, "imports", "values", "state"),
+		inputFormatter: template.NewTemplateInputFormatter(false, "imports", "values", "state", "dataobjects"),
 	}
 }
 
@@ -119,3 +119,32 @@ func (m MemoryStateHandler) Get(_ context.Context, name string) ([]byte, error)
 	}
 	return data, nil
 }
+
+// ReadOnlyStateHandler implements the GenericStateHandler interface.
+// It reads the state from an underlying state handler but keeps all stored state in memory
+// so that templates can be rendered without persisting any state.
+type ReadOnlyStateHandler struct {
+	Base    GenericStateHandler
+	written MemoryStateHandler
+}
+
+var _ GenericStateHandler = &ReadOnlyStateHandler{}
+
+// NewReadOnlyStateHandler creates a new read-only state handler that reads from the given state handler.
+func NewReadOnlyStateHandler(base GenericStateHandler) *ReadOnlyStateHandler {
+	return &ReadOnlyStateHandler{
+		Base:    base,
+		written: NewMemoryStateHandler(),
+	}
+}
+
+func (s *ReadOnlyStateHandler) Store(ctx context.Context, name string, data []byte) error {
+	return s.written.Store(ctx, name, data)
+}
+
+func (s *ReadOnlyStateHandler) Get(ctx context.Context, name string) ([]byte, error) {
+	if data, err := s.written.Get(ctx, name); err == nil {
+		return data, nil
+	}
+	return s.Base.Get(ctx, name)
+}
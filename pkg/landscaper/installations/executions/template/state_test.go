@@ -63,4 +63,28 @@ var _ = Describe("State", func() {
 
 	})
 
+	Context("read-only handler", func() {
+
+		It("should read state from the base handler but not write to it", func() {
+			ctx := context.Background()
+			base := NewMemoryStateHandler()
+			Expect(base.Store(ctx, "my-exec", []byte("old data"))).To(Succeed())
+			stateHdlr := NewReadOnlyStateHandler(base)
+
+			res, err := stateHdlr.Get(ctx, "my-exec")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal([]byte("old data")))
+
+			Expect(stateHdlr.Store(ctx, "my-exec", []byte("new data"))).To(Succeed())
+			res, err = stateHdlr.Get(ctx, "my-exec")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal([]byte("new data")))
+			Expect(base["my-exec"]).To(Equal([]byte("old data")))
+
+			_, err = stateHdlr.Get(ctx, "other")
+			Expect(err).To(MatchError(StateNotFoundErr))
+		})
+
+	})
+
 })
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package templaters
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/gardener/component-spec/bindings-go/ctf"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lstmpl "github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+)
+
+// Options contains the dependencies that are passed to the execution templaters of a templating run.
+type Options struct {
+	// BlobResolver is used to resolve blobs of the component descriptor.
+	// It is optional and can be nil if no blobs have to be resolved.
+	BlobResolver ctf.BlobResolver
+	// State is used to read and store the state of the template executions.
+	State lstmpl.GenericStateHandler
+	// InputFormatter is used to print the template input in error messages.
+	// If it is nil, the default input formatter of the templater is used.
+	InputFormatter *lstmpl.TemplateInputFormatter
+}
+
+// Factory creates a new execution templater with the given options.
+type Factory func(opts Options) lstmpl.ExecutionTemplater
+
+var (
+	factoriesMux sync.RWMutex
+	factories    = map[lsv1alpha1.TemplateType]Factory{
+		lsv1alpha1.GOTemplateType:    newGoTemplater,
+		lsv1alpha1.SpiffTemplateType: newSpiffTemplater,
+	}
+)
+
+// Register adds a factory for an additional execution templater.
+// The factory is used by all templaters that are created with New afterwards.
+// An error is returned if a templater for the given type is already registered.
+func Register(templateType lsv1alpha1.TemplateType, factory Factory) error {
+	if len(templateType) == 0 {
+		return fmt.Errorf("a template type must be defined")
+	}
+	if factory == nil {
+		return fmt.Errorf("no factory defined for template type %q", templateType)
+	}
+	factoriesMux.Lock()
+	defer factoriesMux.Unlock()
+	if _, ok := factories[templateType]; ok {
+		return fmt.Errorf("a templater for the template type %q is already registered", templateType)
+	}
+	factories[templateType] = factory
+	return nil
+}
+
+// MustRegister adds a factory for an additional execution templater and panics if that is not possible.
+func MustRegister(templateType lsv1alpha1.TemplateType, factory Factory) {
+	if err := Register(templateType, factory); err != nil {
+		panic(err)
+	}
+}
+
+// TemplateTypes returns the sorted list of all registered template types.
+func TemplateTypes() []lsv1alpha1.TemplateType {
+	factoriesMux.RLock()
+	defer factoriesMux.RUnlock()
+	types := make([]lsv1alpha1.TemplateType, 0, len(factories))
+	for templateType := range factories {
+		types = append(types, templateType)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i] < types[j]
+	})
+	return types
+}
+
+// New creates a new templater with all registered execution templaters.
+func New(opts Options) *lstmpl.Templater {
+	factoriesMux.RLock()
+	defer factoriesMux.RUnlock()
+	templaters := make([]lstmpl.ExecutionTemplater, 0, len(factories))
+	for templateType, factory := range factories {
+		templater := factory(opts)
+		if templater.Type() != templateType {
+			// the templater must be available with the type it has been registered for.
+			templater = typedTemplater{ExecutionTemplater: templater, templateType: templateType}
+		}
+		templaters = append(templaters, templater)
+	}
+	return lstmpl.New(templaters...)
+}
+
+func newGoTemplater(opts Options) lstmpl.ExecutionTemplater {
+	t := gotemplate.New(opts.BlobResolver, opts.State)
+	if opts.InputFormatter != nil {
+		t.WithInputFormatter(opts.InputFormatter)
+	}
+	return t
+}
+
+func newSpiffTemplater(opts Options) lstmpl.ExecutionTemplater {
+	t := spiff.New(opts.State)
+	if opts.InputFormatter != nil {
+		t.WithInputFormatter(opts.InputFormatter)
+	}
+	return t
+}
+
+// typedTemplater overwrites the type of an execution templater.
+type typedTemplater struct {
+	lstmpl.ExecutionTemplater
+	templateType lsv1alpha1.TemplateType
+}
+
+func (t typedTemplater) Type() lsv1alpha1.TemplateType {
+	return t.templateType
+}
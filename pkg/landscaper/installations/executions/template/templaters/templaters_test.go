@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package templaters_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/templaters"
+)
+
+var _ = Describe("Templaters", func() {
+
+	It("should contain the built-in template types", func() {
+		Expect(templaters.TemplateTypes()).To(ContainElements(
+			lsv1alpha1.GOTemplateType,
+			lsv1alpha1.SpiffTemplateType,
+		))
+	})
+
+	It("should not register a template type twice", func() {
+		err := templaters.Register(lsv1alpha1.GOTemplateType, func(opts templaters.Options) template.ExecutionTemplater {
+			return gotemplate.New(opts.BlobResolver, opts.State)
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should not register an empty template type or a nil factory", func() {
+		Expect(templaters.Register("", func(opts templaters.Options) template.ExecutionTemplater {
+			return gotemplate.New(opts.BlobResolver, opts.State)
+		})).ToNot(Succeed())
+		Expect(templaters.Register("Nil", nil)).ToNot(Succeed())
+	})
+
+	It("should template executions with an additionally registered templater", func() {
+		// the go templater is registered as additional type to simulate a custom templating engine.
+		const customType lsv1alpha1.TemplateType = "Custom"
+		Expect(templaters.Register(customType, func(opts templaters.Options) template.ExecutionTemplater {
+			return gotemplate.New(opts.BlobResolver, opts.State)
+		})).To(Succeed())
+		Expect(templaters.TemplateTypes()).To(ContainElement(customType))
+
+		raw, err := json.Marshal("exports:\n  key: {{ .values.val }}\n")
+		Expect(err).ToNot(HaveOccurred())
+		blue := &lsv1alpha1.Blueprint{}
+		blue.ExportExecutions = []lsv1alpha1.TemplateExecutor{
+			{
+				Name:     "custom",
+				Type:     customType,
+				Template: lsv1alpha1.AnyJSON{RawMessage: raw},
+			},
+		}
+
+		op := templaters.New(templaters.Options{State: template.NewMemoryStateHandler()})
+		res, err := op.TemplateExportExecutions(template.NewExportExecutionOptions(
+			template.NewBlueprintExecutionOptions(nil, &blueprints.Blueprint{Info: blue}, nil, nil, nil),
+			map[string]interface{}{"val": "foo"}))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(HaveKeyWithValue("key", "foo"))
+	})
+
+})
@@ -17,8 +17,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 
-	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
-	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/templaters"
 
 	"github.com/mandelsoft/spiff/spiffing"
 	spiffyaml "github.com/mandelsoft/spiff/yaml"
@@ -46,7 +45,8 @@ func NewConstructor(op *installations.Operation) *Constructor {
 }
 
 // Construct loads the exported data from the execution and the subinstallations.
-func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject, []*dataobjects.TargetExtension, error) {
+// It returns the exported data objects, targets and the exports that are written into secrets and configmaps.
+func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject, []*dataobjects.TargetExtension, []*dataobjects.ResourceExport, error) {
 	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(c.Inst.GetInstallation()).String()})
 
 	var (
@@ -60,7 +60,7 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 
 	execDo, err := executions.New(c.Operation).GetExportedValues(ctx, c.Inst)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	if execDo != nil {
 		internalExports["deployitems"] = execDo.Data
@@ -68,12 +68,12 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 
 	dataObjectMap, err := c.aggregateDataObjectsInContext(ctx)
 	if err != nil {
-		return nil, nil, fmt.Errorf("unable to aggregate data object: %w", err)
+		return nil, nil, nil, fmt.Errorf("unable to aggregate data object: %w", err)
 	}
 	internalExports["dataobjects"] = dataObjectMap
 	targetsMap, err := c.aggregateTargetsInContext(ctx)
 	if err != nil {
-		return nil, nil, fmt.Errorf("unable to aggregate target: %w", err)
+		return nil, nil, nil, fmt.Errorf("unable to aggregate target: %w", err)
 	}
 	internalExports["targets"] = targetsMap
 
@@ -81,12 +81,12 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 		KubeClient: c.Client(),
 		Inst:       c.Inst.GetInstallation(),
 	}
-	exports, err := template.New(gotemplate.New(c.BlobResolver, stateHdlr), spiff.New(stateHdlr)).
+	exports, err := templaters.New(templaters.Options{BlobResolver: c.BlobResolver, State: stateHdlr}).
 		TemplateExportExecutions(template.NewExportExecutionOptions(template.NewBlueprintExecutionOptions(
 			c.Inst.GetInstallation(), c.Inst.GetBlueprint(), c.ComponentDescriptor, c.ResolvedComponentDescriptorList, c.Inst.GetImports()),
 			internalExports))
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	// validate all exports
@@ -104,21 +104,21 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 		case lsv1alpha1.ExportTypeData:
 			validator, err := c.JSONSchemaValidator(def.Schema.RawMessage)
 			if err != nil {
-				return nil, nil, fmt.Errorf("%s: validator creation failed: %s", fldPath.String(), err.Error())
+				return nil, nil, nil, fmt.Errorf("%s: validator creation failed: %s", fldPath.String(), err.Error())
 			}
 			if err := validator.ValidateGoStruct(data); err != nil {
-				return nil, nil, fmt.Errorf("%s: exported data does not satisfy the configured schema: %s", fldPath.String(), err.Error())
+				return nil, nil, nil, fmt.Errorf("%s: exported data does not satisfy the configured schema: %s", fldPath.String(), err.Error())
 			}
 		case lsv1alpha1.ExportTypeTarget:
 			var targetType string
 			if err := jsonpath.GetValue(".type", data, &targetType); err != nil {
-				return nil, nil, fmt.Errorf("%s: exported target does not match the expected target template schema: %w", fldPath.String(), err)
+				return nil, nil, nil, fmt.Errorf("%s: exported target does not match the expected target template schema: %w", fldPath.String(), err)
 			}
 			if def.TargetType != targetType {
-				return nil, nil, fmt.Errorf("%s: exported target type is %s but expected %s", fldPath.String(), targetType, def.TargetType)
+				return nil, nil, nil, fmt.Errorf("%s: exported target type is %s but expected %s", fldPath.String(), targetType, def.TargetType)
 			}
 		default:
-			return nil, nil, fmt.Errorf("%s: unknown export type '%s'", fldPath.String(), string(def.Type))
+			return nil, nil, nil, fmt.Errorf("%s: unknown export type '%s'", fldPath.String(), string(def.Type))
 		}
 	}
 
@@ -126,7 +126,7 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 	if c.Inst.GetInstallation().Spec.ExportDataMappings != nil && len(c.Inst.GetInstallation().Spec.ExportDataMappings) > 0 {
 		exportDataMappings, err := c.templateDataMappings(fldPath, exports)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		// add exportDataMappings to available exports, potentially overwriting existing exports with that name
 		for expName, expValue := range exportDataMappings {
@@ -141,11 +141,12 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 		dataExportPath := dataExportsPath.Child(dataExport.Name)
 		data, ok := exports[dataExport.Name]
 		if !ok {
-			return nil, nil, fmt.Errorf("%s: data export is not defined", dataExportPath.String())
+			return nil, nil, nil, fmt.Errorf("%s: data export is not defined", dataExportPath.String())
 		}
 		do := dataobjects.New().
 			SetSourceType(lsv1alpha1.ExportDataObjectSourceType).
 			SetKey(dataExport.DataRef).
+			SetSensitive(c.isSensitiveExport(dataExport.Name)).
 			SetData(data)
 		dataObjects[i] = do
 	}
@@ -156,18 +157,38 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 		targetExportPath := targetExportsPath.Child(targetExport.Name)
 		data, ok := exports[targetExport.Name]
 		if !ok {
-			return nil, nil, fmt.Errorf("%s: target export is not defined", targetExportPath.String())
+			return nil, nil, nil, fmt.Errorf("%s: target export is not defined", targetExportPath.String())
 		}
 		target, err := ConvertTargetTemplateToTargetExtension(data)
 		if err != nil {
-			return nil, nil, fmt.Errorf("%s: unable to build target from template: %w", targetExportPath.String(), err)
+			return nil, nil, nil, fmt.Errorf("%s: unable to build target from template: %w", targetExportPath.String(), err)
 		}
 		target.SetSourceType(lsv1alpha1.ExportDataObjectSourceType).
 			SetKey(targetExport.Target)
 		targets[i] = target
 	}
 
-	return dataObjects, targets, nil
+	resourceExports, err := c.constructResourceExports(fldPath, exports)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return dataObjects, targets, resourceExports, nil
+}
+
+// isSensitiveExport checks whether the export with the given name is defined as sensitive by the blueprint.
+// Export data mappings are handled as sensitive if any export of the blueprint is sensitive.
+func (c *Constructor) isSensitiveExport(name string) bool {
+	if _, ok := c.Inst.GetInstallation().Spec.ExportDataMappings[name]; ok {
+		for _, def := range c.Inst.GetBlueprint().Info.Exports {
+			if def.Sensitive {
+				return true
+			}
+		}
+		return false
+	}
+	def, err := c.Inst.GetExportDefinition(name)
+	return err == nil && def.Sensitive
 }
 
 func (c *Constructor) aggregateDataObjectsInContext(ctx context.Context) (map[string]interface{}, error) {
@@ -178,13 +199,12 @@ func (c *Constructor) aggregateDataObjectsInContext(ctx context.Context) (map[st
 	}
 
 	aggDataObjects := map[string]interface{}{}
-	for _, do := range dataObjectList.Items {
-		meta := dataobjects.GetMetadataFromObject(&do, do.Data.RawMessage)
-		var data interface{}
-		if err := yaml.Unmarshal(do.Data.RawMessage, &data); err != nil {
-			return nil, fmt.Errorf("error while decoding data object %s: %w", do.Name, err)
+	for i := range dataObjectList.Items {
+		do, err := dataobjects.ResolveDataObject(ctx, c.Client(), &dataObjectList.Items[i])
+		if err != nil {
+			return nil, fmt.Errorf("error while decoding data object %s: %w", dataObjectList.Items[i].Name, err)
 		}
-		aggDataObjects[meta.Key] = data
+		aggDataObjects[do.Metadata.Key] = do.Data
 	}
 	return aggDataObjects, nil
 }
@@ -66,7 +66,7 @@ var _ = Describe("Constructor", func() {
 		op.Inst = inInstRoot
 
 		c := exports.NewConstructor(op)
-		res, _, err := c.Construct(ctx)
+		res, _, _, err := c.Construct(ctx)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(res).ToNot(BeNil())
 		Expect(res).To(HaveLen(2), "should export 2 data object for 2 exports")
@@ -90,6 +90,105 @@ var _ = Describe("Constructor", func() {
 		}))
 	})
 
+	It("should mark data exports as sensitive if the blueprint defines them as sensitive", func() {
+		ctx := context.Background()
+		inInstRoot, err := installations.CreateInternalInstallation(ctx, op.ComponentsRegistry(), fakeInstallations["test2/root"])
+		Expect(err).ToNot(HaveOccurred())
+		op.Inst = inInstRoot
+		for i := range op.Inst.GetBlueprint().Info.Exports {
+			if op.Inst.GetBlueprint().Info.Exports[i].Name == "root.y" {
+				op.Inst.GetBlueprint().Info.Exports[i].Sensitive = true
+			}
+		}
+
+		c := exports.NewConstructor(op)
+		res, _, _, err := c.Construct(ctx)
+		Expect(err).ToNot(HaveOccurred())
+
+		id := func(element interface{}) string {
+			return element.(*dataobjects.DataObject).Metadata.Key
+		}
+		Expect(res).To(MatchAllElements(id, Elements{
+			"root.y": PointTo(MatchFields(IgnoreExtras, Fields{
+				"Data":      Equal("val-exec-y"),
+				"Sensitive": BeTrue(),
+			})),
+			"root.z": PointTo(MatchFields(IgnoreExtras, Fields{
+				"Data":      Equal("val-exec-z"),
+				"Sensitive": BeFalse(),
+			})),
+		}))
+	})
+
+	It("should construct secret and configmap exports from the exported config", func() {
+		ctx := context.Background()
+		inInstRoot, err := installations.CreateInternalInstallation(ctx, op.ComponentsRegistry(), fakeInstallations["test2/root"])
+		Expect(err).ToNot(HaveOccurred())
+		op.Inst = inInstRoot
+		op.Inst.GetInstallation().Spec.Exports.Secrets = []lsv1alpha1.SecretExport{
+			{
+				Name:      "root.y",
+				SecretRef: lsv1alpha1.LocalSecretReference{Name: "my-secret", Key: "y"},
+			},
+		}
+		op.Inst.GetInstallation().Spec.Exports.ConfigMaps = []lsv1alpha1.ConfigMapExport{
+			{
+				Name:           "root.z",
+				ConfigMapRef:   lsv1alpha1.LocalConfigMapReference{Name: "my-cm", Key: "z"},
+				Transformation: &lsv1alpha1.ExportTransformation{Template: "value: {{ . | upper }}"},
+			},
+		}
+
+		c := exports.NewConstructor(op)
+		_, _, res, err := c.Construct(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(ConsistOf(
+			&dataobjects.ResourceExport{Kind: dataobjects.SecretResourceExportKind, Export: "root.y", Name: "my-secret", Key: "y", Data: []byte("val-exec-y")},
+			&dataobjects.ResourceExport{Kind: dataobjects.ConfigMapResourceExportKind, Export: "root.z", Name: "my-cm", Key: "z", Data: []byte("value: VAL-EXEC-Z")},
+		))
+	})
+
+	It("should fail to construct a secret export of an undefined export", func() {
+		ctx := context.Background()
+		inInstRoot, err := installations.CreateInternalInstallation(ctx, op.ComponentsRegistry(), fakeInstallations["test2/root"])
+		Expect(err).ToNot(HaveOccurred())
+		op.Inst = inInstRoot
+		op.Inst.GetInstallation().Spec.Exports.Secrets = []lsv1alpha1.SecretExport{
+			{
+				Name:      "root.unknown",
+				SecretRef: lsv1alpha1.LocalSecretReference{Name: "my-secret", Key: "y"},
+			},
+		}
+
+		c := exports.NewConstructor(op)
+		_, _, _, err = c.Construct(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Secret export is not defined"))
+	})
+
+	It("should fail to construct a configmap export of a sensitive export", func() {
+		ctx := context.Background()
+		inInstRoot, err := installations.CreateInternalInstallation(ctx, op.ComponentsRegistry(), fakeInstallations["test2/root"])
+		Expect(err).ToNot(HaveOccurred())
+		op.Inst = inInstRoot
+		for i := range op.Inst.GetBlueprint().Info.Exports {
+			if op.Inst.GetBlueprint().Info.Exports[i].Name == "root.y" {
+				op.Inst.GetBlueprint().Info.Exports[i].Sensitive = true
+			}
+		}
+		op.Inst.GetInstallation().Spec.Exports.ConfigMaps = []lsv1alpha1.ConfigMapExport{
+			{
+				Name:         "root.y",
+				ConfigMapRef: lsv1alpha1.LocalConfigMapReference{Name: "my-cm", Key: "y"},
+			},
+		}
+
+		c := exports.NewConstructor(op)
+		_, _, _, err = c.Construct(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("sensitive exports can only be written to secrets"))
+	})
+
 	It("should construct the exported config from a child", func() {
 		ctx := context.Background()
 		inInstRoot, err := installations.CreateInternalInstallation(ctx, op.ComponentsRegistry(), fakeInstallations["test1/root"])
@@ -105,7 +204,7 @@ var _ = Describe("Constructor", func() {
 		}
 
 		c := exports.NewConstructor(op)
-		res, _, err := c.Construct(ctx)
+		res, _, _, err := c.Construct(ctx)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(res).ToNot(BeNil())
 		Expect(res).To(HaveLen(2), "should export 2 data object from b and c")
@@ -147,7 +246,7 @@ var _ = Describe("Constructor", func() {
 		}
 
 		c := exports.NewConstructor(op)
-		_, _, err = c.Construct(ctx)
+		_, _, _, err = c.Construct(ctx)
 		Expect(err).To(HaveOccurred())
 	})
 
@@ -167,7 +266,7 @@ var _ = Describe("Constructor", func() {
 		}
 
 		c := exports.NewConstructor(op)
-		res, targets, err := c.Construct(ctx)
+		res, targets, _, err := c.Construct(ctx)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(res).ToNot(BeNil())
 		Expect(res).To(HaveLen(2), "should export 2 data object from execution and a")
@@ -205,7 +304,7 @@ var _ = Describe("Constructor", func() {
 			Expect(op.SetInstallationContext(ctx)).To(Succeed())
 
 			c := exports.NewConstructor(op)
-			_, res, err := c.Construct(ctx)
+			_, res, _, err := c.Construct(ctx)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(res).ToNot(BeNil())
 			Expect(res).To(HaveLen(2), "should export 2 targets from execution and installation e")
@@ -239,7 +338,7 @@ var _ = Describe("Constructor", func() {
 			Expect(fakeClient.Update(ctx, target))
 
 			c := exports.NewConstructor(op)
-			_, _, err = c.Construct(ctx)
+			_, _, _, err = c.Construct(ctx)
 			Expect(err).To(HaveOccurred())
 		})
 	})
@@ -252,7 +351,7 @@ var _ = Describe("Constructor", func() {
 			op.Inst = inInstRoot
 
 			c := exports.NewConstructor(op)
-			res, _, err := c.Construct(ctx)
+			res, _, _, err := c.Construct(ctx)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(res).ToNot(BeNil())
 			Expect(res).To(HaveLen(1), "should export 1 data object for 1 exportDataMapping")
@@ -285,7 +384,7 @@ var _ = Describe("Constructor", func() {
 			}
 
 			c := exports.NewConstructor(op)
-			res, _, err := c.Construct(ctx)
+			res, _, _, err := c.Construct(ctx)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(res).ToNot(BeNil())
 			Expect(res).To(HaveLen(2), "should export 2 data object from b and c")
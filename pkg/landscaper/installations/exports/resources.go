@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package exports
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	gotmpl "text/template"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects/jsonpath"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
+)
+
+// constructResourceExports creates the secret and configmap exports of the installation from the exported values.
+func (c *Constructor) constructResourceExports(fldPath *field.Path, exports map[string]interface{}) ([]*dataobjects.ResourceExport, error) {
+	instExports := c.Inst.GetInstallation().Spec.Exports
+	resourceExports := make([]*dataobjects.ResourceExport, 0, len(instExports.Secrets)+len(instExports.ConfigMaps))
+
+	secretExportsPath := fldPath.Child("exports").Child("secrets")
+	for _, secretExport := range instExports.Secrets {
+		res, err := newResourceExport(secretExportsPath.Child(secretExport.Name), exports, dataobjects.SecretResourceExportKind,
+			secretExport.Name, secretExport.SecretRef.Name, secretExport.SecretRef.Key, secretExport.Transformation)
+		if err != nil {
+			return nil, err
+		}
+		resourceExports = append(resourceExports, res)
+	}
+
+	configMapExportsPath := fldPath.Child("exports").Child("configMaps")
+	for _, configMapExport := range instExports.ConfigMaps {
+		if c.isSensitiveExport(configMapExport.Name) {
+			// configmaps are not meant for confidential data
+			return nil, fmt.Errorf("%s: sensitive exports can only be written to secrets", configMapExportsPath.Child(configMapExport.Name).String())
+		}
+		res, err := newResourceExport(configMapExportsPath.Child(configMapExport.Name), exports, dataobjects.ConfigMapResourceExportKind,
+			configMapExport.Name, configMapExport.ConfigMapRef.Name, configMapExport.ConfigMapRef.Key, configMapExport.Transformation)
+		if err != nil {
+			return nil, err
+		}
+		resourceExports = append(resourceExports, res)
+	}
+
+	return resourceExports, nil
+}
+
+func newResourceExport(fldPath *field.Path,
+	exports map[string]interface{},
+	kind dataobjects.ResourceExportKind,
+	exportName, name, key string,
+	transformation *lsv1alpha1.ExportTransformation) (*dataobjects.ResourceExport, error) {
+	data, ok := exports[exportName]
+	if !ok {
+		return nil, fmt.Errorf("%s: %s export is not defined", fldPath.String(), kind)
+	}
+	raw, err := TransformExport(data, transformation)
+	if err != nil {
+		return nil, fmt.Errorf("%s: unable to transform export: %w", fldPath.String(), err)
+	}
+	return &dataobjects.ResourceExport{
+		Kind:   kind,
+		Export: exportName,
+		Name:   name,
+		Key:    key,
+		Data:   raw,
+	}, nil
+}
+
+// TransformExport applies the optional transformation to the exported data and returns the data
+// as it is written into a secret or configmap.
+// String values are returned as they are, all other values are encoded as json.
+func TransformExport(data interface{}, transformation *lsv1alpha1.ExportTransformation) ([]byte, error) {
+	if transformation != nil {
+		switch {
+		case len(transformation.JSONPath) != 0:
+			var value interface{}
+			if err := jsonpath.GetValue(transformation.JSONPath, data, &value); err != nil {
+				return nil, fmt.Errorf("unable to get value for jsonpath %q: %w", transformation.JSONPath, err)
+			}
+			data = value
+		case len(transformation.Template) != 0:
+			tmpl, err := gotmpl.New("export").Funcs(gotemplate.LandscaperSprigFuncMap()).Option("missingkey=error").Parse(transformation.Template)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse template: %w", err)
+			}
+			buf := &bytes.Buffer{}
+			if err := tmpl.Execute(buf, data); err != nil {
+				return nil, fmt.Errorf("unable to execute template: %w", err)
+			}
+			return buf.Bytes(), nil
+		}
+	}
+
+	if str, ok := data.(string); ok {
+		return []byte(str), nil
+	}
+	return json.Marshal(data)
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package exports_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/exports"
+)
+
+var _ = Describe("TransformExport", func() {
+
+	data := map[string]interface{}{
+		"host": "example.com",
+		"port": float64(443),
+	}
+
+	It("should write a string as it is", func() {
+		res, err := exports.TransformExport("val", nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(res)).To(Equal("val"))
+	})
+
+	It("should encode other values as json", func() {
+		res, err := exports.TransformExport(data, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(MatchJSON(`{"host":"example.com","port":443}`))
+	})
+
+	It("should select a value with a jsonpath", func() {
+		res, err := exports.TransformExport(data, &lsv1alpha1.ExportTransformation{JSONPath: ".port"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(res)).To(Equal("443"))
+	})
+
+	It("should fail if the jsonpath does not match", func() {
+		_, err := exports.TransformExport(data, &lsv1alpha1.ExportTransformation{JSONPath: ".user"})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should render a template", func() {
+		res, err := exports.TransformExport(data, &lsv1alpha1.ExportTransformation{Template: "https://{{ .host }}:{{ .port }}"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(res)).To(Equal("https://example.com:443"))
+	})
+
+	It("should fail if the template references a missing value", func() {
+		_, err := exports.TransformExport(data, &lsv1alpha1.ExportTransformation{Template: "{{ .user }}"})
+		Expect(err).To(HaveOccurred())
+	})
+
+})
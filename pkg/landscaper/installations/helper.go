@@ -44,6 +44,11 @@ func IsRootInstallation(inst *lsv1alpha1.Installation) bool {
 	return !isOwned
 }
 
+// IsSuspended returns true if the installation is suspended, either by its spec or by a suspended parent.
+func IsSuspended(inst *lsv1alpha1.Installation) bool {
+	return inst.Spec.Suspend || lsv1alpha1helper.IsSuspended(inst.ObjectMeta)
+}
+
 // GetParentInstallationName returns the name of parent installation that encompasses the given installation.
 func GetParentInstallationName(inst *lsv1alpha1.Installation) string {
 	name, _ := kubernetes.OwnerOfGVK(inst.OwnerReferences, componentInstallationGVK)
@@ -133,13 +138,19 @@ func GetDataImport(ctx context.Context,
 	inst *InstallationAndImports,
 	dataImport lsv1alpha1.DataImport) (*dataobjects.DataObject, *metav1.OwnerReference, error) {
 
-	var rawDataObject *lsv1alpha1.DataObject
+	var (
+		rawDataObject *lsv1alpha1.DataObject
+		sensitive     bool
+	)
 	// get deploy item from current context
 	if len(dataImport.DataRef) != 0 {
 		rawDataObject = &lsv1alpha1.DataObject{}
-		doName := lsv1alpha1helper.GenerateDataObjectName(contextName, dataImport.DataRef)
-		if err := kubeClient.Get(ctx, kubernetes.ObjectKey(doName, inst.GetInstallation().Namespace), rawDataObject); err != nil {
-			return nil, nil, fmt.Errorf("unable to fetch data object %s (%s/%s): %w", doName, contextName, dataImport.DataRef, err)
+		doKey, err := ImportObjectKey(ctx, kubeClient, contextName, inst.GetInstallation(), lsv1alpha1.DataObjectImportGrantKind, dataImport.DataRef)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := kubeClient.Get(ctx, doKey, rawDataObject); err != nil {
+			return nil, nil, fmt.Errorf("unable to fetch data object %s (%s/%s): %w", doKey.String(), contextName, dataImport.DataRef, err)
 		}
 	}
 	if dataImport.SecretRef != nil {
@@ -151,6 +162,8 @@ func GetDataImport(ctx context.Context,
 		rawDataObject.Data.RawMessage = data
 		// set the generation as it is used to detect outdated imports.
 		rawDataObject.SetGeneration(gen)
+		// data that is imported from secrets is handled as sensitive data.
+		sensitive = true
 	}
 	if dataImport.ConfigMapRef != nil {
 		_, data, gen, err := lsutils.ResolveConfigMapReference(ctx, kubeClient, dataImport.ConfigMapRef)
@@ -163,10 +176,11 @@ func GetDataImport(ctx context.Context,
 		rawDataObject.SetGeneration(gen)
 	}
 
-	do, err := dataobjects.NewFromDataObject(rawDataObject)
+	do, err := dataobjects.ResolveDataObject(ctx, kubeClient, rawDataObject)
 	if err != nil {
 		return nil, nil, err
 	}
+	do.Sensitive = do.Sensitive || sensitive
 	do.Def = &dataImport
 
 	owner := kubernetes.GetOwner(do.Raw.ObjectMeta)
@@ -214,10 +228,12 @@ func GetTargets(ctx context.Context,
 
 // GetTargetImport fetches the target import from the cluster.
 func GetTargetImport(ctx context.Context, kubeClient client.Client, contextName string, inst *lsv1alpha1.Installation, targetImport lsv1alpha1.TargetImport) (*dataobjects.TargetExtension, error) {
-	targetName := targetImport.Target
 	target := &lsv1alpha1.Target{}
-	targetName = lsv1alpha1helper.GenerateDataObjectName(contextName, targetName)
-	if err := kubeClient.Get(ctx, kubernetes.ObjectKey(targetName, inst.Namespace), target); err != nil {
+	targetKey, err := ImportObjectKey(ctx, kubeClient, contextName, inst, lsv1alpha1.TargetImportGrantKind, targetImport.Target)
+	if err != nil {
+		return nil, err
+	}
+	if err := kubeClient.Get(ctx, targetKey, target); err != nil {
 		return nil, err
 	}
 
@@ -237,8 +253,11 @@ func GetTargetListImportByNames(
 	for i, targetName := range targetImport.Targets {
 		// get deploy item from current context
 		raw := &lsv1alpha1.Target{}
-		targetName = lsv1alpha1helper.GenerateDataObjectName(contextName, targetName)
-		if err := kubeClient.Get(ctx, kubernetes.ObjectKey(targetName, inst.Namespace), raw); err != nil {
+		targetKey, err := ImportObjectKey(ctx, kubeClient, contextName, inst, lsv1alpha1.TargetImportGrantKind, targetName)
+		if err != nil {
+			return nil, err
+		}
+		if err := kubeClient.Get(ctx, targetKey, raw); err != nil {
 			return nil, err
 		}
 		targets[i] = *raw
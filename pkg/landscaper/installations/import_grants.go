@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+)
+
+// ParseCrossNamespaceReference parses a data or target reference of the form "<namespace>/<name>".
+// It returns false if the reference does not point to an object in another namespace.
+func ParseCrossNamespaceReference(ref string) (namespace string, name string, ok bool) {
+	parts := strings.Split(ref, "/")
+	if len(parts) != 2 {
+		return "", "", false
+	}
+	if len(validation.IsDNS1123Label(parts[0])) != 0 || len(validation.IsDNS1123Subdomain(parts[1])) != 0 {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// ImportObjectKey returns the key of the data object or target that is referenced by an import of the given installation.
+// Root installations can reference objects of other namespaces with "<namespace>/<name>",
+// which requires an ImportGrant in the other namespace that grants the object to the namespace of the installation.
+func ImportObjectKey(ctx context.Context,
+	kubeClient client.Client,
+	contextName string,
+	inst *lsv1alpha1.Installation,
+	kind lsv1alpha1.ImportGrantKind,
+	ref string) (client.ObjectKey, error) {
+	if len(contextName) == 0 {
+		if namespace, name, ok := ParseCrossNamespaceReference(ref); ok && namespace != inst.Namespace {
+			if err := CheckImportGrant(ctx, kubeClient, inst.Namespace, kind, namespace, name); err != nil {
+				return client.ObjectKey{}, err
+			}
+			return kutil.ObjectKey(name, namespace), nil
+		}
+	}
+	return kutil.ObjectKey(lsv1alpha1helper.GenerateDataObjectName(contextName, ref), inst.Namespace), nil
+}
+
+// CheckImportGrant checks whether an ImportGrant in the given namespace allows installations
+// of the importing namespace to import the object with the given kind and name.
+func CheckImportGrant(ctx context.Context,
+	kubeClient client.Client,
+	importingNamespace string,
+	kind lsv1alpha1.ImportGrantKind,
+	namespace, name string) error {
+	grants := &lsv1alpha1.ImportGrantList{}
+	if err := kubeClient.List(ctx, grants, client.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("unable to list import grants in namespace %q: %w", namespace, err)
+	}
+	for _, grant := range grants.Items {
+		if importGrantAllows(grant.Spec, importingNamespace, kind, name) {
+			return nil
+		}
+	}
+	return fmt.Errorf("no import grant in namespace %q allows namespace %q to import %s %q", namespace, importingNamespace, kind, name)
+}
+
+func importGrantAllows(spec lsv1alpha1.ImportGrantSpec, importingNamespace string, kind lsv1alpha1.ImportGrantKind, name string) bool {
+	fromAllowed := false
+	for _, from := range spec.From {
+		if from.Namespace == importingNamespace {
+			fromAllowed = true
+			break
+		}
+	}
+	if !fromAllowed {
+		return false
+	}
+	for _, to := range spec.To {
+		if to.Kind == kind && (len(to.Name) == 0 || to.Name == name) {
+			return true
+		}
+	}
+	return false
+}
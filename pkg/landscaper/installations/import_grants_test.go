@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	testutils "github.com/gardener/landscaper/test/utils"
+)
+
+var _ = Describe("ImportGrants", func() {
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+		inst       *lsv1alpha1.Installation
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+		inst = &lsv1alpha1.Installation{}
+		inst.Name = "tenant-inst"
+		inst.Namespace = "tenant-a"
+	})
+
+	createGrant := func(to ...lsv1alpha1.ImportGrantTo) {
+		grant := &lsv1alpha1.ImportGrant{}
+		grant.Name = "grant"
+		grant.Namespace = "platform"
+		grant.Spec.From = []lsv1alpha1.ImportGrantFrom{{Namespace: "tenant-a"}}
+		grant.Spec.To = to
+		testutils.ExpectNoError(kubeClient.Create(ctx, grant))
+	}
+
+	Context("ParseCrossNamespaceReference", func() {
+
+		It("should parse a namespaced reference", func() {
+			namespace, name, ok := installations.ParseCrossNamespaceReference("platform/ingress-domain")
+			Expect(ok).To(BeTrue())
+			Expect(namespace).To(Equal("platform"))
+			Expect(name).To(Equal("ingress-domain"))
+		})
+
+		It("should not parse references without or with invalid namespace", func() {
+			for _, ref := range []string{"ingress-domain", "#ingress-domain", "a/b/c", "Platform/ingress-domain", "platform/"} {
+				_, _, ok := installations.ParseCrossNamespaceReference(ref)
+				Expect(ok).To(BeFalse(), ref)
+			}
+		})
+	})
+
+	Context("ImportObjectKey", func() {
+
+		It("should return the contextified key of a local reference", func() {
+			key, err := installations.ImportObjectKey(ctx, kubeClient, "ctx", inst, lsv1alpha1.DataObjectImportGrantKind, "my-data")
+			testutils.ExpectNoError(err)
+			Expect(key).To(Equal(client.ObjectKey{Name: lsv1alpha1helper.GenerateDataObjectName("ctx", "my-data"), Namespace: "tenant-a"}))
+		})
+
+		It("should return the key of a granted data object in another namespace", func() {
+			createGrant(lsv1alpha1.ImportGrantTo{Kind: lsv1alpha1.DataObjectImportGrantKind, Name: "ingress-domain"})
+
+			key, err := installations.ImportObjectKey(ctx, kubeClient, "", inst, lsv1alpha1.DataObjectImportGrantKind, "platform/ingress-domain")
+			testutils.ExpectNoError(err)
+			Expect(key).To(Equal(client.ObjectKey{Name: "ingress-domain", Namespace: "platform"}))
+		})
+
+		It("should grant all objects of a kind if no name is given", func() {
+			createGrant(lsv1alpha1.ImportGrantTo{Kind: lsv1alpha1.TargetImportGrantKind})
+
+			key, err := installations.ImportObjectKey(ctx, kubeClient, "", inst, lsv1alpha1.TargetImportGrantKind, "platform/my-cluster")
+			testutils.ExpectNoError(err)
+			Expect(key).To(Equal(client.ObjectKey{Name: "my-cluster", Namespace: "platform"}))
+		})
+
+		It("should fail if no grant exists for the object", func() {
+			createGrant(lsv1alpha1.ImportGrantTo{Kind: lsv1alpha1.TargetImportGrantKind})
+
+			_, err := installations.ImportObjectKey(ctx, kubeClient, "", inst, lsv1alpha1.DataObjectImportGrantKind, "platform/ingress-domain")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`no import grant in namespace "platform" allows namespace "tenant-a" to import DataObject "ingress-domain"`))
+		})
+
+		It("should fail if the grant does not allow the namespace of the installation", func() {
+			createGrant(lsv1alpha1.ImportGrantTo{Kind: lsv1alpha1.DataObjectImportGrantKind})
+			inst.Namespace = "tenant-b"
+
+			_, err := installations.ImportObjectKey(ctx, kubeClient, "", inst, lsv1alpha1.DataObjectImportGrantKind, "platform/ingress-domain")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should not resolve namespaced references of subinstallations", func() {
+			createGrant(lsv1alpha1.ImportGrantTo{Kind: lsv1alpha1.DataObjectImportGrantKind})
+
+			key, err := installations.ImportObjectKey(ctx, kubeClient, "ctx", inst, lsv1alpha1.DataObjectImportGrantKind, "platform/ingress-domain")
+			testutils.ExpectNoError(err)
+			Expect(key).To(Equal(client.ObjectKey{Name: lsv1alpha1helper.GenerateDataObjectName("ctx", "platform/ingress-domain"), Namespace: "tenant-a"}))
+		})
+	})
+
+	Context("GetDataImport", func() {
+
+		It("should import a granted data object of another namespace", func() {
+			createGrant(lsv1alpha1.ImportGrantTo{Kind: lsv1alpha1.DataObjectImportGrantKind, Name: "ingress-domain"})
+			do := &lsv1alpha1.DataObject{}
+			do.Name = "ingress-domain"
+			do.Namespace = "platform"
+			do.Data = lsv1alpha1.NewAnyJSON([]byte(`"example.com"`))
+			testutils.ExpectNoError(kubeClient.Create(ctx, do))
+
+			res, _, err := installations.GetDataImport(ctx, kubeClient, "", installations.NewInstallationAndImports(inst), lsv1alpha1.DataImport{
+				Name:    "domain",
+				DataRef: "platform/ingress-domain",
+			})
+			testutils.ExpectNoError(err)
+			Expect(res.Data).To(Equal("example.com"))
+		})
+	})
+
+})
@@ -10,6 +10,7 @@ import (
 
 	"github.com/mandelsoft/spiff/spiffing"
 	spiffyaml "github.com/mandelsoft/spiff/yaml"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/yaml"
 
@@ -105,9 +106,27 @@ func (c *Constructor) Construct(ctx context.Context, imps *Imports) error {
 	c.SetTargetListImports(imps.TargetLists)
 
 	inst.SetImports(imports)
+	inst.SetSensitiveImports(sensitiveImports(imps.DataObjects, templatedDataMappings))
 	return nil
 }
 
+// sensitiveImports returns the names of all imports whose values originate from sensitive data objects.
+// The values of data mappings are handled as sensitive if any imported data object is sensitive.
+func sensitiveImports(importedDataObjects map[string]*dataobjects.DataObject, templatedDataMappings map[string]interface{}) sets.String {
+	names := sets.NewString()
+	for name, do := range importedDataObjects {
+		if do.Sensitive {
+			names.Insert(name)
+		}
+	}
+	if names.Len() != 0 {
+		for name := range templatedDataMappings {
+			names.Insert(name)
+		}
+	}
+	return names
+}
+
 // constructImports is an auxiliary function that can be called in a recursive manner to traverse the tree of conditional imports
 func (c *Constructor) constructImports(
 	importList lsv1alpha1.ImportDefinitionList,
@@ -9,8 +9,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
-	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/templaters"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
@@ -43,7 +42,7 @@ func (o *ImportOperation) Ensure(ctx context.Context, inst *installations.Instal
 		KubeClient: o.Client(),
 		Inst:       inst.GetInstallation(),
 	}
-	tmpl := template.New(gotemplate.New(o.BlobResolver, templateStateHandler), spiff.New(templateStateHandler))
+	tmpl := templaters.New(templaters.Options{BlobResolver: o.BlobResolver, State: templateStateHandler})
 	errors, bindings, err := tmpl.TemplateImportExecutions(
 		template.NewBlueprintExecutionOptions(
 			o.Context().External.InjectComponentDescriptorRef(inst.GetInstallation()),
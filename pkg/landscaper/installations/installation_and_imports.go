@@ -1,6 +1,8 @@
 package installations
 
 import (
+	"k8s.io/apimachinery/pkg/util/sets"
+
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
 )
@@ -9,6 +11,8 @@ import (
 type InstallationAndImports struct {
 	imports      map[string]interface{}
 	installation *lsv1alpha1.Installation
+	// sensitiveImports contains the names of the imports whose values originate from sensitive data
+	sensitiveImports sets.String
 	// indexes the import state with from/to as key
 	importsStatus ImportStatus
 }
@@ -43,6 +47,16 @@ func (i *InstallationAndImports) SetImports(imports map[string]interface{}) {
 	i.imports = imports
 }
 
+// SetSensitiveImports sets the names of the imports whose values originate from sensitive data.
+func (i *InstallationAndImports) SetSensitiveImports(names sets.String) {
+	i.sensitiveImports = names
+}
+
+// IsSensitiveImport checks whether the value of the import with the given name originates from sensitive data.
+func (i *InstallationAndImports) IsSensitiveImport(name string) bool {
+	return i.sensitiveImports.Has(name)
+}
+
 func (i *InstallationAndImports) GetInstallation() *lsv1alpha1.Installation {
 	return i.installation
 }
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenancewindow
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+)
+
+// Window is a recurring time window that is opened and closed by cron schedules.
+type Window struct {
+	begin    cron.Schedule
+	end      cron.Schedule
+	location *time.Location
+}
+
+// New parses the given maintenance window specification.
+func New(spec *lsv1alpha1.MaintenanceWindow) (*Window, error) {
+	begin, err := cron.ParseStandard(spec.Begin)
+	if err != nil {
+		return nil, fmt.Errorf("invalid begin %q of maintenance window: %w", spec.Begin, err)
+	}
+	end, err := cron.ParseStandard(spec.End)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end %q of maintenance window: %w", spec.End, err)
+	}
+	location := time.UTC
+	if len(spec.Timezone) != 0 {
+		location, err = time.LoadLocation(spec.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timezone %q of maintenance window: %w", spec.Timezone, err)
+		}
+	}
+	return &Window{
+		begin:    begin,
+		end:      end,
+		location: location,
+	}, nil
+}
+
+// IsOpen returns true if the window is open at the given time.
+// This is the case if the window closes before it opens the next time.
+func (w *Window) IsOpen(t time.Time) bool {
+	t = t.In(w.location)
+	return w.end.Next(t).Before(w.begin.Next(t))
+}
+
+// NextBegin returns the time after the given time when the window opens the next time.
+func (w *Window) NextBegin(t time.Time) time.Time {
+	return w.begin.Next(t.In(w.location))
+}
+
+// GetForInstallation returns the maintenance window that applies to the given installation.
+// The maintenance window of the installation takes precedence over the one of its context.
+// Nil is returned if no maintenance window is configured.
+func GetForInstallation(ctx context.Context, kubeClient client.Client, inst *lsv1alpha1.Installation) (*lsv1alpha1.MaintenanceWindow, error) {
+	if inst.Spec.MaintenanceWindow != nil {
+		return inst.Spec.MaintenanceWindow, nil
+	}
+	if len(inst.Spec.Context) == 0 {
+		return nil, nil
+	}
+
+	lsCtx := &lsv1alpha1.Context{}
+	if err := kubeClient.Get(ctx, kutil.ObjectKey(inst.Spec.Context, inst.Namespace), lsCtx); err != nil {
+		return nil, fmt.Errorf("unable to get context %q: %w", inst.Spec.Context, err)
+	}
+	return lsCtx.MaintenanceWindow, nil
+}
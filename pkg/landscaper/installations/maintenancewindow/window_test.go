@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenancewindow_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/maintenancewindow"
+)
+
+var _ = Describe("Window", func() {
+
+	It("should be open between begin and end", func() {
+		w, err := maintenancewindow.New(&lsv1alpha1.MaintenanceWindow{Begin: "0 22 * * *", End: "0 4 * * *"})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(w.IsOpen(time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC))).To(BeFalse())
+		Expect(w.IsOpen(time.Date(2022, 6, 1, 22, 0, 0, 0, time.UTC))).To(BeTrue())
+		Expect(w.IsOpen(time.Date(2022, 6, 2, 3, 59, 0, 0, time.UTC))).To(BeTrue())
+		Expect(w.IsOpen(time.Date(2022, 6, 2, 4, 0, 0, 0, time.UTC))).To(BeFalse())
+
+		Expect(w.NextBegin(time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC))).
+			To(BeTemporally("==", time.Date(2022, 6, 1, 22, 0, 0, 0, time.UTC)))
+	})
+
+	It("should evaluate the schedules in the given timezone", func() {
+		w, err := maintenancewindow.New(&lsv1alpha1.MaintenanceWindow{Begin: "0 22 * * *", End: "0 4 * * *", Timezone: "Europe/Berlin"})
+		Expect(err).ToNot(HaveOccurred())
+
+		// 21:00 UTC is 23:00 in Berlin during summer time
+		Expect(w.IsOpen(time.Date(2022, 6, 1, 21, 0, 0, 0, time.UTC))).To(BeTrue())
+		Expect(w.IsOpen(time.Date(2022, 6, 1, 19, 0, 0, 0, time.UTC))).To(BeFalse())
+		Expect(w.NextBegin(time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC))).
+			To(BeTemporally("==", time.Date(2022, 6, 1, 20, 0, 0, 0, time.UTC)))
+	})
+
+	It("should return an error for an invalid specification", func() {
+		_, err := maintenancewindow.New(&lsv1alpha1.MaintenanceWindow{Begin: "0 22 * *", End: "0 4 * * *"})
+		Expect(err).To(HaveOccurred())
+
+		_, err = maintenancewindow.New(&lsv1alpha1.MaintenanceWindow{Begin: "0 22 * * *", End: "0 4 * * *", Timezone: "Mars/Olympus"})
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("GetForInstallation", func() {
+		var (
+			ctx    context.Context
+			window *lsv1alpha1.MaintenanceWindow
+			inst   *lsv1alpha1.Installation
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			window = &lsv1alpha1.MaintenanceWindow{Begin: "0 22 * * *", End: "0 4 * * *"}
+			inst = &lsv1alpha1.Installation{}
+			inst.Name = "root"
+			inst.Namespace = "default"
+			inst.Spec.Context = "my-context"
+		})
+
+		It("should prefer the maintenance window of the installation", func() {
+			lsCtx := &lsv1alpha1.Context{}
+			lsCtx.Name = "my-context"
+			lsCtx.Namespace = "default"
+			lsCtx.MaintenanceWindow = &lsv1alpha1.MaintenanceWindow{Begin: "0 1 * * *", End: "0 2 * * *"}
+			kubeClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(lsCtx).Build()
+			inst.Spec.MaintenanceWindow = window
+
+			res, err := maintenancewindow.GetForInstallation(ctx, kubeClient, inst)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(window))
+		})
+
+		It("should fall back to the maintenance window of the context", func() {
+			lsCtx := &lsv1alpha1.Context{}
+			lsCtx.Name = "my-context"
+			lsCtx.Namespace = "default"
+			lsCtx.MaintenanceWindow = window
+			kubeClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(lsCtx).Build()
+
+			res, err := maintenancewindow.GetForInstallation(ctx, kubeClient, inst)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(window))
+		})
+	})
+
+})
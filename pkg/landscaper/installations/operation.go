@@ -201,7 +201,7 @@ func (o *Operation) GetImportedDataObjects(ctx context.Context) (map[string]*dat
 		if OwnerReferenceIsInstallationButNoParent(owner, o.Inst.GetInstallation()) {
 			sourceRef = &lsv1alpha1.ObjectReference{
 				Name:      owner.Name,
-				Namespace: o.Inst.GetInstallation().Namespace,
+				Namespace: do.Raw.Namespace,
 			}
 			inst := &lsv1alpha1.Installation{}
 			if err := read_write_layer.GetInstallation(ctx, o.Client(), sourceRef.NamespacedName(), inst); err != nil {
@@ -254,7 +254,7 @@ func (o *Operation) GetImportedTargets(ctx context.Context) (map[string]*dataobj
 		if OwnerReferenceIsInstallationButNoParent(owner, o.Inst.GetInstallation()) {
 			sourceRef = &lsv1alpha1.ObjectReference{
 				Name:      owner.Name,
-				Namespace: o.Inst.GetInstallation().Namespace,
+				Namespace: target.GetTarget().Namespace,
 			}
 			inst := &lsv1alpha1.Installation{}
 			if err := read_write_layer.GetInstallation(ctx, o.Client(), sourceRef.NamespacedName(), inst); err != nil {
@@ -313,7 +313,7 @@ func (o *Operation) GetImportedTargetLists(ctx context.Context) (map[string]*dat
 			if OwnerReferenceIsInstallationButNoParent(owner, o.Inst.GetInstallation()) {
 				sourceRef = &lsv1alpha1.ObjectReference{
 					Name:      owner.Name,
-					Namespace: o.Inst.GetInstallation().Namespace,
+					Namespace: t.GetTarget().Namespace,
 				}
 				inst := &lsv1alpha1.Installation{}
 				if err := read_write_layer.GetInstallation(ctx, o.Client(), sourceRef.NamespacedName(), inst); err != nil {
@@ -404,7 +404,7 @@ func (o *Operation) SetExportConfigGeneration(ctx context.Context) error {
 }
 
 // CreateOrUpdateExports creates or updates the data objects that holds the exported values of the installation.
-func (o *Operation) CreateOrUpdateExports(ctx context.Context, dataExports []*dataobjects.DataObject, targetExports []*dataobjects.TargetExtension) error {
+func (o *Operation) CreateOrUpdateExports(ctx context.Context, dataExports []*dataobjects.DataObject, targetExports []*dataobjects.TargetExtension, resourceExports []*dataobjects.ResourceExport) error {
 	cond := lsv1alpha1helper.GetOrInitCondition(o.Inst.GetInstallation().Status.Conditions, lsv1alpha1.CreateExportsCondition)
 
 	configGen, err := CreateGenerationHash(o.Inst.GetInstallation())
@@ -442,6 +442,12 @@ func (o *Operation) CreateOrUpdateExports(ctx context.Context, dataExports []*da
 					fmt.Sprintf("unable to create data object for export %s", do.Metadata.Key)))
 			return fmt.Errorf("unable to create or update data object %s for export %s: %w", raw.Name, do.Metadata.Key, err)
 		}
+		if err := dataobjects.CreateOrUpdateSecret(ctx, o.Client(), do, raw); err != nil {
+			o.Inst.GetInstallation().Status.Conditions = lsv1alpha1helper.MergeConditions(o.Inst.GetInstallation().Status.Conditions,
+				lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse, "CreateDataObjects",
+					fmt.Sprintf("unable to create secret for sensitive export %s", do.Metadata.Key)))
+			return err
+		}
 	}
 
 	for _, target := range targetExports {
@@ -467,6 +473,13 @@ func (o *Operation) CreateOrUpdateExports(ctx context.Context, dataExports []*da
 		}
 	}
 
+	if err := o.createOrUpdateResourceExports(ctx, resourceExports); err != nil {
+		o.Inst.GetInstallation().Status.Conditions = lsv1alpha1helper.MergeConditions(o.Inst.GetInstallation().Status.Conditions,
+			lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse, "CreateResourceExports",
+				fmt.Sprintf("unable to create secret and configmap exports: %s", err.Error())))
+		return err
+	}
+
 	o.Inst.GetInstallation().Status.ConfigGeneration = configGen
 	cond = lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionTrue, "DataObjectsCreated", "DataObjects successfully created")
 	return o.UpdateInstallationStatus(ctx, o.Inst.GetInstallation(), cond)
@@ -523,11 +536,7 @@ func (o *Operation) createOrUpdateImports(ctx context.Context, importDefs lsv1al
 
 func (o *Operation) createOrUpdateDataImport(ctx context.Context, src string, importDef lsv1alpha1.ImportDefinition, importData interface{}) error {
 	cond := lsv1alpha1helper.GetOrInitCondition(o.Inst.GetInstallation().Status.Conditions, lsv1alpha1.CreateImportsCondition)
-	do := dataobjects.New().
-		SetNamespace(o.Inst.GetInstallation().Namespace).SetSource(src).
-		SetContext(src).
-		SetKey(importDef.Name).SetSourceType(lsv1alpha1.ImportDataObjectSourceType).
-		SetData(importData)
+	do := o.newDataImport(src, importDef, importData)
 	raw, err := do.Build()
 	if err != nil {
 		o.Inst.GetInstallation().Status.Conditions = lsv1alpha1helper.MergeConditions(o.Inst.GetInstallation().Status.Conditions,
@@ -550,26 +559,22 @@ func (o *Operation) createOrUpdateDataImport(ctx context.Context, src string, im
 				fmt.Sprintf("unable to create data object for import '%s'", importDef.Name)))
 		return fmt.Errorf("unable to create or update data object '%s' for import '%s': %w", raw.Name, importDef.Name, err)
 	}
+	if err := dataobjects.CreateOrUpdateSecret(ctx, o.Client(), do, raw); err != nil {
+		o.Inst.GetInstallation().Status.Conditions = lsv1alpha1helper.MergeConditions(o.Inst.GetInstallation().Status.Conditions,
+			lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
+				"CreateDataObjects",
+				fmt.Sprintf("unable to create secret for sensitive import '%s'", importDef.Name)))
+		return err
+	}
 	return nil
 }
 
 func (o *Operation) createOrUpdateTargetImport(ctx context.Context, src string, importDef lsv1alpha1.ImportDefinition, values interface{}) error {
 	cond := lsv1alpha1helper.GetOrInitCondition(o.Inst.GetInstallation().Status.Conditions, lsv1alpha1.CreateImportsCondition)
-	data, err := json.Marshal(values)
+	targetExtension, err := o.newTargetImport(src, importDef, values)
 	if err != nil {
 		return err
 	}
-	target := &lsv1alpha1.Target{}
-	if _, _, err := api.Decoder.Decode(data, nil, target); err != nil {
-		return err
-	}
-	targetExtension := dataobjects.NewTargetExtension(target, nil)
-
-	targetExtension.SetNamespace(o.Inst.GetInstallation().Namespace).
-		SetContext(src).
-		SetKey(importDef.Name).
-		SetIndex(nil).
-		SetSource(src).SetSourceType(lsv1alpha1.ImportDataObjectSourceType)
 
 	targetForUpdate := &lsv1alpha1.Target{}
 	targetExtension.ApplyNameAndNamespace(targetForUpdate)
@@ -593,26 +598,9 @@ func (o *Operation) createOrUpdateTargetImport(ctx context.Context, src string,
 
 func (o *Operation) createOrUpdateTargetListImport(ctx context.Context, src string, importDef lsv1alpha1.ImportDefinition, values []interface{}) error {
 	cond := lsv1alpha1helper.GetOrInitCondition(o.Inst.GetInstallation().Status.Conditions, lsv1alpha1.CreateImportsCondition)
-	tars := make([]lsv1alpha1.Target, len(values))
-	for i := range values {
-		tar := &lsv1alpha1.Target{}
-		data, err := json.Marshal(values[i])
-		if err != nil {
-			return err
-		}
-		if _, _, err := api.Decoder.Decode(data, nil, tar); err != nil {
-			return err
-		}
-		tars[i] = *tar
-	}
-	targetExtensionList := dataobjects.NewTargetExtensionList(tars, nil)
-	for i := range targetExtensionList.GetTargetExtensions() {
-		tar := targetExtensionList.GetTargetExtensions()[i]
-		tar.SetNamespace(o.Inst.GetInstallation().Namespace).
-			SetContext(src).
-			SetKey(importDef.Name).
-			SetIndex(pointer.Int(i)).
-			SetSource(src).SetSourceType(lsv1alpha1.ImportDataObjectSourceType)
+	targetExtensionList, err := o.newTargetListImport(src, importDef, values)
+	if err != nil {
+		return err
 	}
 
 	targets, err := targetExtensionList.Build(importDef.Name)
@@ -643,6 +631,62 @@ func (o *Operation) createOrUpdateTargetListImport(ctx context.Context, src stri
 	return nil
 }
 
+// newDataImport creates the data object that holds the imported value of a data import.
+func (o *Operation) newDataImport(src string, importDef lsv1alpha1.ImportDefinition, importData interface{}) *dataobjects.DataObject {
+	return dataobjects.New().
+		SetNamespace(o.Inst.GetInstallation().Namespace).SetSource(src).
+		SetContext(src).
+		SetKey(importDef.Name).SetSourceType(lsv1alpha1.ImportDataObjectSourceType).
+		SetSensitive(o.Inst.IsSensitiveImport(importDef.Name)).
+		SetData(importData)
+}
+
+// newTargetImport creates the target that holds the imported value of a target import.
+func (o *Operation) newTargetImport(src string, importDef lsv1alpha1.ImportDefinition, values interface{}) (*dataobjects.TargetExtension, error) {
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	target := &lsv1alpha1.Target{}
+	if _, _, err := api.Decoder.Decode(data, nil, target); err != nil {
+		return nil, err
+	}
+	targetExtension := dataobjects.NewTargetExtension(target, nil)
+
+	targetExtension.SetNamespace(o.Inst.GetInstallation().Namespace).
+		SetContext(src).
+		SetKey(importDef.Name).
+		SetIndex(nil).
+		SetSource(src).SetSourceType(lsv1alpha1.ImportDataObjectSourceType)
+	return targetExtension, nil
+}
+
+// newTargetListImport creates the targets that hold the imported values of a targetlist import.
+func (o *Operation) newTargetListImport(src string, importDef lsv1alpha1.ImportDefinition, values []interface{}) (*dataobjects.TargetExtensionList, error) {
+	tars := make([]lsv1alpha1.Target, len(values))
+	for i := range values {
+		tar := &lsv1alpha1.Target{}
+		data, err := json.Marshal(values[i])
+		if err != nil {
+			return nil, err
+		}
+		if _, _, err := api.Decoder.Decode(data, nil, tar); err != nil {
+			return nil, err
+		}
+		tars[i] = *tar
+	}
+	targetExtensionList := dataobjects.NewTargetExtensionList(tars, nil)
+	for i := range targetExtensionList.GetTargetExtensions() {
+		tar := targetExtensionList.GetTargetExtensions()[i]
+		tar.SetNamespace(o.Inst.GetInstallation().Namespace).
+			SetContext(src).
+			SetKey(importDef.Name).
+			SetIndex(pointer.Int(i)).
+			SetSource(src).SetSourceType(lsv1alpha1.ImportDataObjectSourceType)
+	}
+	return targetExtensionList, nil
+}
+
 // GetExportForKey creates a dataobject from a dataobject
 func (o *Operation) GetExportForKey(ctx context.Context, key string) (*dataobjects.DataObject, error) {
 	doName := lsv1alpha1helper.GenerateDataObjectName(o.context.Name, key)
@@ -650,7 +694,7 @@ func (o *Operation) GetExportForKey(ctx context.Context, key string) (*dataobjec
 	if err := o.Client().Get(ctx, kutil.ObjectKey(doName, o.Inst.GetInstallation().Namespace), rawDO); err != nil {
 		return nil, err
 	}
-	return dataobjects.NewFromDataObject(rawDO)
+	return dataobjects.ResolveDataObject(ctx, o.Client(), rawDO)
 }
 
 func importsAnyExport(exporter *InstallationImportsAndBlueprint, importer *InstallationAndImports) bool {
@@ -10,10 +10,14 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
@@ -112,6 +116,90 @@ var _ = Describe("Operation", func() {
 			Expect(targetList.Items[0].Spec.Configuration.RawMessage).To(Equal(json.RawMessage("false")))
 		})
 
+		It("should store the data of sensitive imports in a secret", func() {
+			ctx := context.Background()
+			defer ctx.Done()
+
+			op.Inst.GetInstallation().Name = "test"
+			op.Inst.GetInstallation().Namespace = "default"
+			op.Inst.GetBlueprint().Info.Imports = []lsv1alpha1.ImportDefinition{
+				{
+					FieldValueDefinition: lsv1alpha1.FieldValueDefinition{
+						Name:   "my-import",
+						Schema: &lsv1alpha1.JSONSchemaDefinition{RawMessage: []byte(`{"type": "string"}`)},
+					},
+					Type: lsv1alpha1.ImportTypeData,
+				},
+			}
+			op.Inst.SetImports(map[string]interface{}{
+				"my-import": "my-password",
+			})
+			op.Inst.SetSensitiveImports(sets.NewString("my-import"))
+
+			testutils.ExpectNoError(op.CreateOrUpdateImports(ctx))
+
+			doList := &lsv1alpha1.DataObjectList{}
+			testutils.ExpectNoError(kubeClient.List(ctx, doList))
+			Expect(doList.Items).To(HaveLen(1))
+			Expect(doList.Items[0].Data.RawMessage).To(BeEmpty())
+			Expect(doList.Items[0].SecretRef).ToNot(BeNil())
+
+			do, err := dataobjects.ResolveDataObject(ctx, kubeClient, &doList.Items[0])
+			testutils.ExpectNoError(err)
+			Expect(do.Data).To(Equal("my-password"))
+			Expect(do.Sensitive).To(BeTrue())
+		})
+
+	})
+
+	Context("GetImportedDataObjects", func() {
+
+		It("should record the source installation of a data object imported from another namespace", func() {
+			ctx := context.Background()
+			defer ctx.Done()
+
+			source := &lsv1alpha1.Installation{}
+			source.Name = "platform-inst"
+			source.Namespace = "platform"
+			source.UID = "platform-inst-uid"
+			source.Status.ConfigGeneration = "abc"
+			testutils.ExpectNoError(kubeClient.Create(ctx, source))
+
+			grant := &lsv1alpha1.ImportGrant{}
+			grant.Name = "grant"
+			grant.Namespace = "platform"
+			grant.Spec.From = []lsv1alpha1.ImportGrantFrom{{Namespace: "tenant-a"}}
+			grant.Spec.To = []lsv1alpha1.ImportGrantTo{{Kind: lsv1alpha1.DataObjectImportGrantKind}}
+			testutils.ExpectNoError(kubeClient.Create(ctx, grant))
+
+			do := &lsv1alpha1.DataObject{}
+			do.Name = "ingress-domain"
+			do.Namespace = "platform"
+			do.Data = lsv1alpha1.NewAnyJSON([]byte(`"example.com"`))
+			testutils.ExpectNoError(controllerutil.SetOwnerReference(source, do, api.LandscaperScheme))
+			testutils.ExpectNoError(kubeClient.Create(ctx, do))
+
+			op.Inst.GetInstallation().Name = "test"
+			op.Inst.GetInstallation().Namespace = "tenant-a"
+			op.Inst.GetInstallation().Spec.Imports.Data = []lsv1alpha1.DataImport{
+				{
+					Name:    "domain",
+					DataRef: "platform/ingress-domain",
+				},
+			}
+
+			dataObjects, err := op.GetImportedDataObjects(ctx)
+			testutils.ExpectNoError(err)
+			Expect(dataObjects).To(HaveKey("domain"))
+			Expect(dataObjects["domain"].Data).To(Equal("example.com"))
+
+			status, err := op.Inst.ImportStatus().GetData("domain")
+			testutils.ExpectNoError(err)
+			Expect(status.DataRef).To(Equal("platform/ingress-domain"))
+			Expect(status.SourceRef).To(Equal(&lsv1alpha1.ObjectReference{Name: "platform-inst", Namespace: "platform"}))
+			Expect(status.ConfigGeneration).To(Equal("abc"))
+		})
+
 	})
 
 	Context("CreateOrUpdateExports", func() {
@@ -152,7 +240,7 @@ var _ = Describe("Operation", func() {
 						Source:     "test",
 					},
 				},
-			}, nil)
+			}, nil, nil)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("dataobject 'default/myexport' for export 'myexport' conflicts with existing dataobject owned by another installation: object 'default/myexport' is already owned by another object with kind 'Installation' (owninginst)"))
 		})
@@ -196,7 +284,7 @@ var _ = Describe("Operation", func() {
 
 			err := op.CreateOrUpdateExports(ctx, nil, []*dataobjects.TargetExtension{
 				targetExtension,
-			})
+			}, nil)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("target object 'default/myexport' for export 'myexport' conflicts with existing target owned by another installation: object 'default/myexport' is already owned by another object with kind 'Installation' (owninginst)"))
 		})
@@ -234,7 +322,7 @@ var _ = Describe("Operation", func() {
 
 			testutils.ExpectNoError(kubeClient.Create(ctx, op.Inst.GetInstallation()))
 
-			testutils.ExpectNoError(op.CreateOrUpdateExports(ctx, nil, targetExtensions))
+			testutils.ExpectNoError(op.CreateOrUpdateExports(ctx, nil, targetExtensions, nil))
 
 			targetList := &lsv1alpha1.TargetList{}
 			testutils.ExpectNoError(kubeClient.List(ctx, targetList))
@@ -259,7 +347,7 @@ var _ = Describe("Operation", func() {
 				targetExtension,
 			}
 
-			testutils.ExpectNoError(op.CreateOrUpdateExports(ctx, nil, targetExtensions))
+			testutils.ExpectNoError(op.CreateOrUpdateExports(ctx, nil, targetExtensions, nil))
 
 			targetList = &lsv1alpha1.TargetList{}
 			testutils.ExpectNoError(kubeClient.List(ctx, targetList))
@@ -270,6 +358,103 @@ var _ = Describe("Operation", func() {
 			Expect(targetList.Items[0].Spec.Configuration.RawMessage).To(Equal(json.RawMessage("false")))
 		})
 
+		It("should write secret and configmap exports and remove exports that are not defined anymore", func() {
+			ctx := context.Background()
+			defer ctx.Done()
+
+			op.Inst.GetInstallation().Name = "test"
+			op.Inst.GetInstallation().Namespace = "default"
+			testutils.ExpectNoError(kubeClient.Create(ctx, op.Inst.GetInstallation()))
+
+			testutils.ExpectNoError(op.CreateOrUpdateExports(ctx, nil, nil, []*dataobjects.ResourceExport{
+				{Kind: dataobjects.SecretResourceExportKind, Export: "a", Name: "my-secret", Key: "a", Data: []byte("val-a")},
+				{Kind: dataobjects.SecretResourceExportKind, Export: "b", Name: "my-secret", Key: "b", Data: []byte("val-b")},
+				{Kind: dataobjects.ConfigMapResourceExportKind, Export: "c", Name: "my-cm", Key: "c", Data: []byte(`{"foo":"bar"}`)},
+			}))
+
+			secret := &corev1.Secret{}
+			testutils.ExpectNoError(kubeClient.Get(ctx, client.ObjectKey{Name: "my-secret", Namespace: "default"}, secret))
+			Expect(secret.Data).To(Equal(map[string][]byte{"a": []byte("val-a"), "b": []byte("val-b")}))
+			Expect(secret.Labels).To(HaveKeyWithValue(lsv1alpha1.DataObjectSourceLabel, lsv1alpha1helper.DataObjectSourceFromInstallation(op.Inst.GetInstallation())))
+			Expect(secret.OwnerReferences).To(HaveLen(1))
+			Expect(secret.OwnerReferences[0].Name).To(Equal("test"))
+			cm := &corev1.ConfigMap{}
+			testutils.ExpectNoError(kubeClient.Get(ctx, client.ObjectKey{Name: "my-cm", Namespace: "default"}, cm))
+			Expect(cm.Data).To(Equal(map[string]string{"c": `{"foo":"bar"}`}))
+
+			testutils.ExpectNoError(op.CreateOrUpdateExports(ctx, nil, nil, []*dataobjects.ResourceExport{
+				{Kind: dataobjects.SecretResourceExportKind, Export: "a", Name: "my-secret", Key: "a", Data: []byte("val-a2")},
+			}))
+
+			testutils.ExpectNoError(kubeClient.Get(ctx, client.ObjectKey{Name: "my-secret", Namespace: "default"}, secret))
+			Expect(secret.Data).To(Equal(map[string][]byte{"a": []byte("val-a2")}))
+			err := kubeClient.Get(ctx, client.ObjectKey{Name: "my-cm", Namespace: "default"}, cm)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should store sensitive data exports in a secret that is controlled by the data object", func() {
+			ctx := context.Background()
+			defer ctx.Done()
+
+			op.Inst.GetInstallation().Name = "test"
+			op.Inst.GetInstallation().Namespace = "default"
+			testutils.ExpectNoError(kubeClient.Create(ctx, op.Inst.GetInstallation()))
+
+			export := dataobjects.New().
+				SetSourceType(lsv1alpha1.ExportDataObjectSourceType).
+				SetKey("credentials").
+				SetSensitive(true).
+				SetData(map[string]interface{}{"password": "my-password"})
+			testutils.ExpectNoError(op.CreateOrUpdateExports(ctx, []*dataobjects.DataObject{export}, nil, nil))
+
+			doList := &lsv1alpha1.DataObjectList{}
+			testutils.ExpectNoError(kubeClient.List(ctx, doList))
+			Expect(doList.Items).To(HaveLen(1))
+			rawDO := &doList.Items[0]
+			Expect(rawDO.Data.RawMessage).To(BeEmpty())
+			Expect(rawDO.Annotations).ToNot(HaveKey(lsv1alpha1.DataObjectHashAnnotation))
+			Expect(rawDO.SecretRef).To(Equal(&lsv1alpha1.LocalSecretReference{Name: rawDO.Name, Key: lsv1alpha1.SensitiveDataObjectSecretKey}))
+
+			secret := &corev1.Secret{}
+			testutils.ExpectNoError(kubeClient.Get(ctx, client.ObjectKey{Name: rawDO.Name, Namespace: "default"}, secret))
+			Expect(secret.Data).To(HaveKeyWithValue(lsv1alpha1.SensitiveDataObjectSecretKey, []byte(`{"password":"my-password"}`)))
+			Expect(secret.OwnerReferences).To(HaveLen(1))
+			Expect(secret.OwnerReferences[0].Kind).To(Equal("DataObject"))
+			Expect(secret.OwnerReferences[0].Name).To(Equal(rawDO.Name))
+
+			_, err := dataobjects.NewFromDataObject(rawDO)
+			Expect(err).To(HaveOccurred())
+			do, err := dataobjects.ResolveDataObject(ctx, kubeClient, rawDO)
+			testutils.ExpectNoError(err)
+			Expect(do.Data).To(Equal(map[string]interface{}{"password": "my-password"}))
+			Expect(do.Metadata.Key).To(Equal("credentials"))
+			Expect(do.Metadata.Hash).ToNot(BeEmpty())
+		})
+
+		It("should not overwrite an existing secret that is not managed by the installation", func() {
+			ctx := context.Background()
+			defer ctx.Done()
+
+			secret := &corev1.Secret{}
+			secret.Name = "my-secret"
+			secret.Namespace = "default"
+			secret.Data = map[string][]byte{"a": []byte("foreign")}
+			testutils.ExpectNoError(kubeClient.Create(ctx, secret))
+
+			op.Inst.GetInstallation().Name = "test"
+			op.Inst.GetInstallation().Namespace = "default"
+			testutils.ExpectNoError(kubeClient.Create(ctx, op.Inst.GetInstallation()))
+
+			err := op.CreateOrUpdateExports(ctx, nil, nil, []*dataobjects.ResourceExport{
+				{Kind: dataobjects.SecretResourceExportKind, Export: "a", Name: "my-secret", Key: "a", Data: []byte("val-a")},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("object 'default/my-secret' already exists and is not managed by the installation"))
+
+			testutils.ExpectNoError(kubeClient.Get(ctx, client.ObjectKeyFromObject(secret), secret))
+			Expect(secret.Data).To(Equal(map[string][]byte{"a": []byte("foreign")}))
+		})
+
 	})
 
 })
@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+)
+
+const (
+	// PlanKindDataObject is the kind of planned changes of data objects.
+	PlanKindDataObject = "DataObject"
+	// PlanKindTarget is the kind of planned changes of targets.
+	PlanKindTarget = "Target"
+	// PlanKindInstallation is the kind of planned changes of subinstallations.
+	PlanKindInstallation = "Installation"
+	// PlanKindDeployItem is the kind of planned changes of deploy items.
+	PlanKindDeployItem = "DeployItem"
+)
+
+// PlanImports computes the changes that CreateOrUpdateImports would apply to the data objects and targets
+// that hold the imported values, without writing them.
+func (o *Operation) PlanImports(ctx context.Context) ([]lsv1alpha1.PlannedChange, error) {
+	return o.planImports(ctx, o.Inst.GetBlueprint().Info.Imports)
+}
+
+func (o *Operation) planImports(ctx context.Context, importDefs lsv1alpha1.ImportDefinitionList) ([]lsv1alpha1.PlannedChange, error) {
+	var (
+		changes        = make([]lsv1alpha1.PlannedChange, 0)
+		importedValues = o.Inst.GetImports()
+		src            = lsv1alpha1helper.DataObjectSourceFromInstallation(o.Inst.GetInstallation())
+	)
+	for _, importDef := range importDefs {
+		importData, ok := importedValues[importDef.Name]
+		if !ok {
+			if importDef.Required != nil && !*importDef.Required {
+				continue
+			}
+			return nil, fmt.Errorf("import %s not defined", importDef.Name)
+		}
+
+		if len(importDef.ConditionalImports) > 0 {
+			conditionalChanges, err := o.planImports(ctx, importDef.ConditionalImports)
+			if err != nil {
+				return nil, err
+			}
+			changes = append(changes, conditionalChanges...)
+		}
+
+		switch importDef.Type {
+		case lsv1alpha1.ImportTypeData:
+			do := o.newDataImport(src, importDef, importData)
+			raw, err := do.Build()
+			if err != nil {
+				return nil, fmt.Errorf("unable to build data object for import '%s': %w", importDef.Name, err)
+			}
+			change, err := o.PlanObject(ctx, importDef.Name, PlanKindDataObject, raw, func() error {
+				return do.Apply(raw)
+			})
+			if err != nil {
+				return nil, err
+			}
+			changes = append(changes, change)
+		case lsv1alpha1.ImportTypeTarget:
+			targetExtension, err := o.newTargetImport(src, importDef, importData)
+			if err != nil {
+				return nil, err
+			}
+			target := &lsv1alpha1.Target{}
+			targetExtension.ApplyNameAndNamespace(target)
+			change, err := o.PlanObject(ctx, importDef.Name, PlanKindTarget, target, func() error {
+				return targetExtension.Apply(target)
+			})
+			if err != nil {
+				return nil, err
+			}
+			changes = append(changes, change)
+		case lsv1alpha1.ImportTypeTargetList:
+			importDataList, ok2 := importData.([]interface{})
+			if !ok2 {
+				return nil, fmt.Errorf("targetlist import '%s' is not a list", importDef.Name)
+			}
+			targetExtensionList, err := o.newTargetListImport(src, importDef, importDataList)
+			if err != nil {
+				return nil, err
+			}
+			targets, err := targetExtensionList.Build(importDef.Name)
+			if err != nil {
+				return nil, fmt.Errorf("unable to build targets for import '%s': %w", importDef.Name, err)
+			}
+			for i := range targets {
+				index := i
+				target := &lsv1alpha1.Target{}
+				target.Name = targets[i].Name
+				target.Namespace = targets[i].Namespace
+				change, err := o.PlanObject(ctx, fmt.Sprintf("%s[%d]", importDef.Name, index), PlanKindTarget, target, func() error {
+					return targetExtensionList.Apply(target, index)
+				})
+				if err != nil {
+					return nil, err
+				}
+				changes = append(changes, change)
+			}
+		default:
+			return nil, fmt.Errorf("unknown import type '%s' for import '%s'", string(importDef.Type), importDef.Name)
+		}
+	}
+	return changes, nil
+}
+
+// PlanObject computes the change that a create or update of the given object would apply.
+// The object must contain the name and namespace of the object.
+// It is overwritten with the current state of the object from the cluster before the mutate function is called,
+// which is the same behavior as for controllerutil.CreateOrUpdate.
+func (o *Operation) PlanObject(ctx context.Context, name, kind string, obj client.Object, mutate func() error) (lsv1alpha1.PlannedChange, error) {
+	key := client.ObjectKeyFromObject(obj)
+	if err := o.Client().Get(ctx, key, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return lsv1alpha1.PlannedChange{
+				Name:   name,
+				Kind:   kind,
+				Action: lsv1alpha1.PlanActionCreate,
+			}, nil
+		}
+		return lsv1alpha1.PlannedChange{}, fmt.Errorf("unable to get %s %q: %w", kind, key.String(), err)
+	}
+
+	current := obj.DeepCopyObject()
+	if err := mutate(); err != nil {
+		return lsv1alpha1.PlannedChange{}, fmt.Errorf("unable to compute the desired state of %s %q: %w", kind, key.String(), err)
+	}
+
+	changedFields, err := ChangedFields(current, obj)
+	if err != nil {
+		return lsv1alpha1.PlannedChange{}, err
+	}
+	return NewPlannedChange(name, kind, &lsv1alpha1.ObjectReference{Name: key.Name, Namespace: key.Namespace}, changedFields), nil
+}
+
+// NewPlannedChange creates the planned change of an existing object.
+// The object is updated if any field changes, otherwise it is unchanged.
+func NewPlannedChange(name, kind string, ref *lsv1alpha1.ObjectReference, changedFields []string) lsv1alpha1.PlannedChange {
+	change := lsv1alpha1.PlannedChange{
+		Name:      name,
+		Kind:      kind,
+		Action:    lsv1alpha1.PlanActionUnchanged,
+		Reference: ref,
+	}
+	if len(changedFields) != 0 {
+		change.Action = lsv1alpha1.PlanActionUpdate
+		change.ChangedFields = changedFields
+	}
+	return change
+}
+
+// ChangedFields returns the sorted json paths of all fields that differ between the current and the desired object.
+// Nested objects are compared recursively whereas lists are compared as a whole.
+func ChangedFields(current, desired interface{}) ([]string, error) {
+	currentValue, err := toGenericValue(current)
+	if err != nil {
+		return nil, err
+	}
+	desiredValue, err := toGenericValue(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := make([]string, 0)
+	collectChangedFields("", currentValue, desiredValue, &changed)
+	sort.Strings(changed)
+	return changed, nil
+}
+
+func toGenericValue(obj interface{}) (interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal object: %w", err)
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal object: %w", err)
+	}
+	return value, nil
+}
+
+func collectChangedFields(path string, current, desired interface{}, changed *[]string) {
+	currentMap, currentIsMap := current.(map[string]interface{})
+	desiredMap, desiredIsMap := desired.(map[string]interface{})
+	if !currentIsMap || !desiredIsMap {
+		if !reflect.DeepEqual(current, desired) {
+			*changed = append(*changed, path)
+		}
+		return
+	}
+
+	keys := make(map[string]struct{}, len(currentMap)+len(desiredMap))
+	for key := range currentMap {
+		keys[key] = struct{}{}
+	}
+	for key := range desiredMap {
+		keys[key] = struct{}{}
+	}
+	for key := range keys {
+		collectChangedFields(joinFieldPath(path, key), currentMap[key], desiredMap[key], changed)
+	}
+}
+
+func joinFieldPath(path, key string) string {
+	if strings.ContainsAny(key, "./") {
+		return fmt.Sprintf("%s[%s]", path, key)
+	}
+	if len(path) == 0 {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+)
+
+var _ = Describe("Plan", func() {
+
+	Context("ChangedFields", func() {
+
+		It("should return no fields for equal objects", func() {
+			do := &lsv1alpha1.DataObject{}
+			do.Name = "a"
+			do.Data = lsv1alpha1.NewAnyJSON([]byte(`{"a": 1}`))
+
+			changed, err := installations.ChangedFields(do, do.DeepCopy())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changed).To(BeEmpty())
+		})
+
+		It("should return the paths of all changed nested fields", func() {
+			current := &lsv1alpha1.DataObject{}
+			current.Name = "a"
+			current.Labels = map[string]string{"data.landscaper.gardener.cloud/hash": "abc"}
+			current.Data = lsv1alpha1.NewAnyJSON([]byte(`{"a": {"b": 1, "c": [1]}, "d": "x"}`))
+
+			desired := current.DeepCopy()
+			desired.Labels["data.landscaper.gardener.cloud/hash"] = "def"
+			desired.Data = lsv1alpha1.NewAnyJSON([]byte(`{"a": {"b": 2, "c": [1, 2]}, "e": "y"}`))
+
+			changed, err := installations.ChangedFields(current, desired)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changed).To(Equal([]string{
+				"data.a.b",
+				"data.a.c",
+				"data.d",
+				"data.e",
+				"metadata.labels[data.landscaper.gardener.cloud/hash]",
+			}))
+		})
+
+		It("should ignore the formatting of raw json", func() {
+			current := lsv1alpha1.DeployItemTemplate{Configuration: &runtime.RawExtension{Raw: []byte(`{"a":1}`)}}
+			desired := lsv1alpha1.DeployItemTemplate{Configuration: &runtime.RawExtension{Raw: []byte("{\n  \"a\": 1\n}")}}
+
+			changed, err := installations.ChangedFields(current, desired)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changed).To(BeEmpty())
+		})
+
+	})
+
+	Context("NewPlannedChange", func() {
+
+		It("should be unchanged if no field changed", func() {
+			change := installations.NewPlannedChange("a", installations.PlanKindDataObject, nil, nil)
+			Expect(change.Action).To(Equal(lsv1alpha1.PlanActionUnchanged))
+			Expect(change.ChangedFields).To(BeNil())
+		})
+
+		It("should be updated if fields changed", func() {
+			change := installations.NewPlannedChange("a", installations.PlanKindDataObject, nil, []string{"data"})
+			Expect(change.Action).To(Equal(lsv1alpha1.PlanActionUpdate))
+			Expect(change.ChangedFields).To(ConsistOf("data"))
+		})
+
+	})
+
+})
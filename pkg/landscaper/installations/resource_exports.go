@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
+	lsutil "github.com/gardener/landscaper/pkg/utils"
+)
+
+// ResourceExportLabels returns the labels of the secrets and configmaps that hold the secret and configmap exports
+// of the given installation.
+func ResourceExportLabels(inst *lsv1alpha1.Installation) map[string]string {
+	return map[string]string{
+		lsv1alpha1.DataObjectSourceLabel:     lsv1alpha1helper.DataObjectSourceFromInstallation(inst),
+		lsv1alpha1.DataObjectSourceTypeLabel: string(lsv1alpha1.ExportDataObjectSourceType),
+	}
+}
+
+// ListResourceExports returns all secrets and configmaps that hold secret and configmap exports of the given installation.
+func ListResourceExports(ctx context.Context, kubeClient client.Client, inst *lsv1alpha1.Installation) ([]corev1.Secret, []corev1.ConfigMap, error) {
+	secretList := &corev1.SecretList{}
+	if err := kubeClient.List(ctx, secretList, client.InNamespace(inst.Namespace), client.MatchingLabels(ResourceExportLabels(inst))); err != nil {
+		return nil, nil, fmt.Errorf("unable to list exported secrets: %w", err)
+	}
+	configMapList := &corev1.ConfigMapList{}
+	if err := kubeClient.List(ctx, configMapList, client.InNamespace(inst.Namespace), client.MatchingLabels(ResourceExportLabels(inst))); err != nil {
+		return nil, nil, fmt.Errorf("unable to list exported configmaps: %w", err)
+	}
+	return secretList.Items, configMapList.Items, nil
+}
+
+// createOrUpdateResourceExports writes the secret and configmap exports of the installation.
+// Every secret and configmap only contains the keys of the exports, so secrets and configmaps
+// as well as keys that are not exported anymore are removed.
+func (o *Operation) createOrUpdateResourceExports(ctx context.Context, resourceExports []*dataobjects.ResourceExport) error {
+	inst := o.Inst.GetInstallation()
+
+	secrets := map[string]map[string][]byte{}
+	configMaps := map[string]map[string]string{}
+	for _, res := range resourceExports {
+		switch res.Kind {
+		case dataobjects.SecretResourceExportKind:
+			if _, ok := secrets[res.Name]; !ok {
+				secrets[res.Name] = map[string][]byte{}
+			}
+			secrets[res.Name][res.Key] = res.Data
+		case dataobjects.ConfigMapResourceExportKind:
+			if _, ok := configMaps[res.Name]; !ok {
+				configMaps[res.Name] = map[string]string{}
+			}
+			configMaps[res.Name][res.Key] = string(res.Data)
+		default:
+			return fmt.Errorf("unknown kind %q of export %s", res.Kind, res.Export)
+		}
+	}
+
+	for name, data := range secrets {
+		secret := &corev1.Secret{}
+		secret.Name = name
+		secret.Namespace = inst.Namespace
+		if _, err := controllerutil.CreateOrUpdate(ctx, o.Client(), secret, func() error {
+			if err := setResourceExportMetadata(inst, secret); err != nil {
+				return err
+			}
+			secret.Type = corev1.SecretTypeOpaque
+			secret.Data = data
+			return nil
+		}); err != nil {
+			return fmt.Errorf("unable to create or update secret %s for exports: %w", client.ObjectKeyFromObject(secret).String(), err)
+		}
+	}
+
+	for name, data := range configMaps {
+		cm := &corev1.ConfigMap{}
+		cm.Name = name
+		cm.Namespace = inst.Namespace
+		if _, err := controllerutil.CreateOrUpdate(ctx, o.Client(), cm, func() error {
+			if err := setResourceExportMetadata(inst, cm); err != nil {
+				return err
+			}
+			cm.Data = data
+			return nil
+		}); err != nil {
+			return fmt.Errorf("unable to create or update configmap %s for exports: %w", client.ObjectKeyFromObject(cm).String(), err)
+		}
+	}
+
+	existingSecrets, existingConfigMaps, err := ListResourceExports(ctx, o.Client(), inst)
+	if err != nil {
+		return err
+	}
+	for i := range existingSecrets {
+		secret := &existingSecrets[i]
+		if _, ok := secrets[secret.Name]; ok {
+			continue
+		}
+		if err := o.Client().Delete(ctx, secret); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("unable to delete secret %s that is not exported anymore: %w", client.ObjectKeyFromObject(secret).String(), err)
+		}
+	}
+	for i := range existingConfigMaps {
+		cm := &existingConfigMaps[i]
+		if _, ok := configMaps[cm.Name]; ok {
+			continue
+		}
+		if err := o.Client().Delete(ctx, cm); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("unable to delete configmap %s that is not exported anymore: %w", client.ObjectKeyFromObject(cm).String(), err)
+		}
+	}
+	return nil
+}
+
+// setResourceExportMetadata sets the labels and the owner reference of a secret or configmap export.
+// Existing objects that are not managed by the installation are never overwritten.
+func setResourceExportMetadata(inst *lsv1alpha1.Installation, obj client.Object) error {
+	src := lsv1alpha1helper.DataObjectSourceFromInstallation(inst)
+	if len(obj.GetResourceVersion()) != 0 && obj.GetLabels()[lsv1alpha1.DataObjectSourceLabel] != src {
+		return fmt.Errorf("object '%s' already exists and is not managed by the installation", client.ObjectKeyFromObject(obj).String())
+	}
+	if err, err2 := lsutil.SetExclusiveOwnerReference(inst, obj); err != nil {
+		return fmt.Errorf("object '%s' conflicts with an existing object owned by another installation: %w", client.ObjectKeyFromObject(obj).String(), err)
+	} else if err2 != nil {
+		return fmt.Errorf("error setting owner reference: %w", err2)
+	}
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	for key, value := range ResourceExportLabels(inst) {
+		labels[key] = value
+	}
+	obj.SetLabels(labels)
+	return nil
+}
@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package revisions
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/api"
+)
+
+// History manages the revision history of an installation.
+// Every revision is stored in a secret in the namespace of the installation that is owned by the installation.
+// The revisions are numbered consecutively and identified by the job that applied them.
+type History struct {
+	kubeClient client.Client
+	inst       *lsv1alpha1.Installation
+}
+
+// NewHistory creates a new revision history for the given installation.
+func NewHistory(kubeClient client.Client, inst *lsv1alpha1.Installation) *History {
+	return &History{
+		kubeClient: kubeClient,
+		inst:       inst,
+	}
+}
+
+// IsEnabled returns true if a revision history is configured for the installation.
+func IsEnabled(inst *lsv1alpha1.Installation) bool {
+	return inst.Spec.RevisionHistoryLimit != nil && *inst.Spec.RevisionHistoryLimit > 0
+}
+
+// List returns all revisions of the history ordered by their number.
+func (h *History) List(ctx context.Context) ([]lsv1alpha1.InstallationRevision, error) {
+	secrets, err := h.listSecrets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]lsv1alpha1.InstallationRevision, 0, len(secrets))
+	for i := range secrets {
+		rev, err := decodeRevision(&secrets[i])
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, *rev)
+	}
+	return revisions, nil
+}
+
+// Get returns the revision with the given number.
+// A not found error is returned if the revision is not part of the history.
+func (h *History) Get(ctx context.Context, revision int64) (*lsv1alpha1.InstallationRevision, error) {
+	secret := &corev1.Secret{}
+	if err := h.kubeClient.Get(ctx, kutil.ObjectKey(SecretName(h.inst.Name, revision), h.inst.Namespace), secret); err != nil {
+		return nil, err
+	}
+	return decodeRevision(secret)
+}
+
+// Record adds the given revision to the history and returns it with its assigned number.
+// A revision that has already been recorded for the same job keeps its number and is overwritten.
+func (h *History) Record(ctx context.Context, rev *lsv1alpha1.InstallationRevision) (*lsv1alpha1.InstallationRevision, error) {
+	secrets, err := h.listSecrets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rev = rev.DeepCopy()
+	rev.Revision = 0
+	var latest int64
+	for i := range secrets {
+		number, err := revisionNumber(&secrets[i])
+		if err != nil {
+			return nil, err
+		}
+		if secrets[i].Labels[lsv1alpha1.InstallationRevisionJobIDLabel] == rev.JobID {
+			rev.Revision = number
+		}
+		if number > latest {
+			latest = number
+		}
+	}
+	if rev.Revision == 0 {
+		rev.Revision = latest + 1
+	}
+
+	data, err := json.Marshal(rev)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode revision: %w", err)
+	}
+
+	secret := &corev1.Secret{}
+	secret.Name = SecretName(h.inst.Name, rev.Revision)
+	secret.Namespace = h.inst.Namespace
+	if _, err := controllerutil.CreateOrUpdate(ctx, h.kubeClient, secret, func() error {
+		secret.Labels = map[string]string{
+			lsv1alpha1.InstallationRevisionInstallationLabel: h.inst.Name,
+			lsv1alpha1.InstallationRevisionLabel:             strconv.FormatInt(rev.Revision, 10),
+			lsv1alpha1.InstallationRevisionJobIDLabel:        rev.JobID,
+		}
+		secret.Data = map[string][]byte{
+			lsv1alpha1.InstallationRevisionDataKey: data,
+		}
+		return controllerutil.SetControllerReference(h.inst, secret, api.LandscaperScheme)
+	}); err != nil {
+		return nil, fmt.Errorf("unable to store revision %d: %w", rev.Revision, err)
+	}
+	return rev, nil
+}
+
+// Prune deletes the oldest revisions of the history so that at most limit revisions are kept.
+func (h *History) Prune(ctx context.Context, limit int) error {
+	secrets, err := h.listSecrets(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < len(secrets)-limit; i++ {
+		if err := h.kubeClient.Delete(ctx, &secrets[i]); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("unable to delete revision secret %s: %w", secrets[i].Name, err)
+		}
+	}
+	return nil
+}
+
+// listSecrets returns the secrets of the history ordered by the number of their revision.
+func (h *History) listSecrets(ctx context.Context) ([]corev1.Secret, error) {
+	secretList := &corev1.SecretList{}
+	if err := h.kubeClient.List(ctx, secretList,
+		client.InNamespace(h.inst.Namespace),
+		client.MatchingLabels{lsv1alpha1.InstallationRevisionInstallationLabel: h.inst.Name}); err != nil {
+		return nil, fmt.Errorf("unable to list revision secrets: %w", err)
+	}
+
+	secrets := secretList.Items
+	numbers := make(map[string]int64, len(secrets))
+	for i := range secrets {
+		number, err := revisionNumber(&secrets[i])
+		if err != nil {
+			return nil, err
+		}
+		numbers[secrets[i].Name] = number
+	}
+	sort.Slice(secrets, func(i, j int) bool {
+		return numbers[secrets[i].Name] < numbers[secrets[j].Name]
+	})
+	return secrets, nil
+}
+
+// SecretName returns the name of the secret that stores the given revision of an installation.
+func SecretName(instName string, revision int64) string {
+	name := fmt.Sprintf("%s/revision/%d", instName, revision)
+	h := sha1.New()
+	_, _ = h.Write([]byte(name))
+	// we need base32 encoding as some base64 (even url safe base64) characters are not supported by k8s
+	// see https://kubernetes.io/docs/concepts/overview/working-with-objects/names/
+	return base32.NewEncoding(lsv1alpha1helper.Base32EncodeStdLowerCase).WithPadding(base32.NoPadding).EncodeToString(h.Sum(nil))
+}
+
+// IsNotFound returns true if the error indicates that a revision is not part of the history.
+func IsNotFound(err error) bool {
+	return apierrors.IsNotFound(err)
+}
+
+func revisionNumber(secret *corev1.Secret) (int64, error) {
+	number, err := strconv.ParseInt(secret.Labels[lsv1alpha1.InstallationRevisionLabel], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid revision label of secret %s: %w", secret.Name, err)
+	}
+	return number, nil
+}
+
+func decodeRevision(secret *corev1.Secret) (*lsv1alpha1.InstallationRevision, error) {
+	data, ok := secret.Data[lsv1alpha1.InstallationRevisionDataKey]
+	if !ok {
+		return nil, fmt.Errorf("revision secret %s has no key %q", secret.Name, lsv1alpha1.InstallationRevisionDataKey)
+	}
+	rev := &lsv1alpha1.InstallationRevision{}
+	if err := json.Unmarshal(data, rev); err != nil {
+		return nil, fmt.Errorf("unable to decode revision secret %s: %w", secret.Name, err)
+	}
+	return rev, nil
+}
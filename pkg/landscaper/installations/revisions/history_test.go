@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package revisions_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/revisions"
+)
+
+var _ = Describe("History", func() {
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+		inst       *lsv1alpha1.Installation
+		history    *revisions.History
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+		inst = &lsv1alpha1.Installation{}
+		inst.Name = "root"
+		inst.Namespace = "default"
+		inst.UID = "abc-def"
+		history = revisions.NewHistory(kubeClient, inst)
+	})
+
+	It("should number recorded revisions consecutively", func() {
+		rev1, err := history.Record(ctx, &lsv1alpha1.InstallationRevision{JobID: "job1", ImportsHash: "hash1"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rev1.Revision).To(Equal(int64(1)))
+
+		rev2, err := history.Record(ctx, &lsv1alpha1.InstallationRevision{JobID: "job2", ImportsHash: "hash2"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rev2.Revision).To(Equal(int64(2)))
+
+		res, err := history.Get(ctx, 1)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.JobID).To(Equal("job1"))
+		Expect(res.ImportsHash).To(Equal("hash1"))
+
+		list, err := history.List(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list).To(HaveLen(2))
+		Expect(list[0].Revision).To(Equal(int64(1)))
+		Expect(list[1].Revision).To(Equal(int64(2)))
+	})
+
+	It("should overwrite the revision of the same job", func() {
+		_, err := history.Record(ctx, &lsv1alpha1.InstallationRevision{JobID: "job1", ImportsHash: "hash1"})
+		Expect(err).ToNot(HaveOccurred())
+		rev, err := history.Record(ctx, &lsv1alpha1.InstallationRevision{JobID: "job1", ImportsHash: "hash2"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rev.Revision).To(Equal(int64(1)))
+
+		list, err := history.List(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list).To(HaveLen(1))
+		Expect(list[0].ImportsHash).To(Equal("hash2"))
+	})
+
+	It("should store the revisions in secrets owned by the installation", func() {
+		_, err := history.Record(ctx, &lsv1alpha1.InstallationRevision{JobID: "job1"})
+		Expect(err).ToNot(HaveOccurred())
+
+		secret := &corev1.Secret{}
+		Expect(kubeClient.Get(ctx, client.ObjectKey{Name: revisions.SecretName("root", 1), Namespace: "default"}, secret)).To(Succeed())
+		Expect(secret.Labels).To(HaveKeyWithValue(lsv1alpha1.InstallationRevisionInstallationLabel, "root"))
+		Expect(secret.Labels).To(HaveKeyWithValue(lsv1alpha1.InstallationRevisionLabel, "1"))
+		Expect(secret.Labels).To(HaveKeyWithValue(lsv1alpha1.InstallationRevisionJobIDLabel, "job1"))
+		Expect(secret.OwnerReferences).To(HaveLen(1))
+		Expect(secret.OwnerReferences[0].Name).To(Equal("root"))
+	})
+
+	It("should prune the oldest revisions", func() {
+		for _, jobID := range []string{"job1", "job2", "job3"} {
+			_, err := history.Record(ctx, &lsv1alpha1.InstallationRevision{JobID: jobID})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		Expect(history.Prune(ctx, 2)).To(Succeed())
+
+		list, err := history.List(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list).To(HaveLen(2))
+		Expect(list[0].Revision).To(Equal(int64(2)))
+		Expect(list[1].Revision).To(Equal(int64(3)))
+
+		_, err = history.Get(ctx, 1)
+		Expect(revisions.IsNotFound(err)).To(BeTrue())
+
+		// numbers of pruned revisions are not reused
+		rev, err := history.Record(ctx, &lsv1alpha1.InstallationRevision{JobID: "job4"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rev.Revision).To(Equal(int64(4)))
+	})
+
+	It("should only be enabled with a positive revision history limit", func() {
+		Expect(revisions.IsEnabled(inst)).To(BeFalse())
+		inst.Spec.RevisionHistoryLimit = pointer.Int32(0)
+		Expect(revisions.IsEnabled(inst)).To(BeFalse())
+		inst.Spec.RevisionHistoryLimit = pointer.Int32(3)
+		Expect(revisions.IsEnabled(inst)).To(BeTrue())
+	})
+
+})
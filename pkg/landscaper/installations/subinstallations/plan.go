@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package subinstallations
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/utils/dependencies"
+)
+
+// Plan computes the changes that Ensure would apply to the subinstallations without writing them.
+// The template state is read from the cluster but not persisted.
+func (o *Operation) Plan(ctx context.Context) ([]lsv1alpha1.PlannedChange, error) {
+	inst := o.Inst.GetInstallation()
+
+	subInstallations, err := o.GetSubInstallations(ctx, inst)
+	if err != nil {
+		return nil, err
+	}
+
+	installationTmpl, err := o.getInstallationTemplates(template.NewReadOnlyStateHandler(o.kubernetesStateHandler()))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get installation templates of blueprint: %w", err)
+	}
+	o.removeUnsatisfiedOptionalImports(installationTmpl)
+
+	if err := o.ValidateSubinstallations(installationTmpl); err != nil {
+		return nil, err
+	}
+	if len(installationTmpl) != 0 {
+		if _, err := dependencies.CheckForCyclesAndDuplicateExports(installationTmpl, false); err != nil {
+			return nil, err
+		}
+	}
+
+	changes := make([]lsv1alpha1.PlannedChange, 0, len(installationTmpl))
+	for _, subInstTmpl := range installationTmpl {
+		subInst := subInstallations[subInstTmpl.Name]
+		if subInst == nil {
+			changes = append(changes, lsv1alpha1.PlannedChange{
+				Name:   subInstTmpl.Name,
+				Kind:   installations.PlanKindInstallation,
+				Action: lsv1alpha1.PlanActionCreate,
+			})
+			continue
+		}
+
+		subBlueprint, subCdDef, err := GetBlueprintDefinitionFromInstallationTemplate(inst,
+			subInstTmpl,
+			o.ComponentDescriptor,
+			o.ComponentsRegistry(),
+			o.Context().External.RepositoryContext,
+			o.Context().External.Overwriter)
+		if err != nil {
+			return nil, err
+		}
+
+		desired := subInst.DeepCopy()
+		if err := o.applySubinstallationTemplate(inst, subInstTmpl, desired, subBlueprint, subCdDef); err != nil {
+			return nil, err
+		}
+		changedFields, err := installations.ChangedFields(subInst, desired)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, installations.NewPlannedChange(subInstTmpl.Name, installations.PlanKindInstallation,
+			&lsv1alpha1.ObjectReference{Name: subInst.Name, Namespace: subInst.Namespace}, changedFields))
+	}
+
+	orphanedNames := make([]string, 0)
+	for name := range subInstallations {
+		if _, ok := getInstallationTemplate(installationTmpl, name); !ok {
+			orphanedNames = append(orphanedNames, name)
+		}
+	}
+	sort.Strings(orphanedNames)
+	for _, name := range orphanedNames {
+		subInst := subInstallations[name]
+		changes = append(changes, lsv1alpha1.PlannedChange{
+			Name:      name,
+			Kind:      installations.PlanKindInstallation,
+			Action:    lsv1alpha1.PlanActionDelete,
+			Reference: &lsv1alpha1.ObjectReference{Name: subInst.Name, Namespace: subInst.Namespace},
+		})
+	}
+
+	return changes, nil
+}
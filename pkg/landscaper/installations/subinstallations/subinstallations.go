@@ -23,8 +23,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
-	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
-	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/templaters"
 
 	"github.com/gardener/landscaper/apis/core/validation"
 
@@ -49,23 +48,12 @@ func (o *Operation) Ensure(ctx context.Context) error {
 		return err
 	}
 
-	installationTmpl, err := o.getInstallationTemplates()
+	installationTmpl, err := o.getInstallationTemplates(o.kubernetesStateHandler())
 	if err != nil {
 		err = fmt.Errorf("unable to get installation templates of blueprint: %w", err)
 		return o.NewError(err, "GetInstallationTemplates", err.Error())
 	}
-
-	for _, instT := range installationTmpl {
-		// remove imports based on optional and conditional imports which are not satisfied in the parent
-		imports := []lsv1alpha1.DataImport{}
-		for _, imp := range instT.Imports.Data {
-			_, ok := o.Inst.GetImports()[imp.DataRef]
-			if ok || !isOptionalParentImport(imp.DataRef, o.Inst.GetBlueprint().Info.Imports, false) {
-				imports = append(imports, imp)
-			}
-		}
-		instT.Imports.Data = imports
-	}
+	o.removeUnsatisfiedOptionalImports(installationTmpl)
 
 	// validate all installation templates before do any follow up actions
 	if err := o.ValidateSubinstallations(installationTmpl); err != nil {
@@ -87,6 +75,21 @@ func (o *Operation) Ensure(ctx context.Context) error {
 	return o.UpdateInstallationStatus(ctx, inst, cond)
 }
 
+// removeUnsatisfiedOptionalImports removes imports based on optional and conditional imports
+// which are not satisfied in the parent.
+func (o *Operation) removeUnsatisfiedOptionalImports(installationTmpl []*lsv1alpha1.InstallationTemplate) {
+	for _, instT := range installationTmpl {
+		imports := []lsv1alpha1.DataImport{}
+		for _, imp := range instT.Imports.Data {
+			_, ok := o.Inst.GetImports()[imp.DataRef]
+			if ok || !isOptionalParentImport(imp.DataRef, o.Inst.GetBlueprint().Info.Imports, false) {
+				imports = append(imports, imp)
+			}
+		}
+		instT.Imports.Data = imports
+	}
+}
+
 // isOptionalParentImport returns true if the specified import data reference
 // - exists in the parents blueprint (= in the given import definition list) AND
 //   - is optional (required: false) OR
@@ -193,15 +196,19 @@ func (o *Operation) cleanupOrphanedSubInstallations(ctx context.Context,
 	return deleted, nil
 }
 
+// kubernetesStateHandler returns the state handler that stores the template state of the installation in the cluster.
+func (o *Operation) kubernetesStateHandler() template.GenericStateHandler {
+	return template.KubernetesStateHandler{
+		KubeClient: o.Client(),
+		Inst:       o.Inst.GetInstallation(),
+	}
+}
+
 // getInstallationTemplates returns all installation templates defined by the referenced blueprint.
-func (o *Operation) getInstallationTemplates() ([]*lsv1alpha1.InstallationTemplate, error) {
+func (o *Operation) getInstallationTemplates(templateStateHandler template.GenericStateHandler) ([]*lsv1alpha1.InstallationTemplate, error) {
 	var instTmpls []*lsv1alpha1.InstallationTemplate
 	if len(o.Inst.GetBlueprint().Info.SubinstallationExecutions) != 0 {
-		templateStateHandler := template.KubernetesStateHandler{
-			KubeClient: o.Client(),
-			Inst:       o.Inst.GetInstallation(),
-		}
-		tmpl := template.New(gotemplate.New(o.BlobResolver, templateStateHandler), spiff.New(templateStateHandler))
+		tmpl := templaters.New(templaters.Options{BlobResolver: o.BlobResolver, State: templateStateHandler})
 		templatedTmpls, err := tmpl.TemplateSubinstallationExecutions(template.NewDeployExecutionOptions(
 			template.NewBlueprintExecutionOptions(
 				o.Context().External.InjectComponentDescriptorRef(o.Inst.GetInstallation().DeepCopy()),
@@ -283,28 +290,7 @@ func (o *Operation) createOrUpdateNewInstallation(ctx context.Context,
 	}
 
 	_, err = o.Writer().CreateOrUpdateInstallation(ctx, read_write_layer.W000001, subInst, func() error {
-		subInst.Labels = map[string]string{
-			lsv1alpha1.EncompassedByLabel: inst.Name,
-		}
-		subInst.Annotations = map[string]string{
-			lsv1alpha1.SubinstallationNameAnnotation: subInstTmpl.Name,
-		}
-		if err := controllerutil.SetControllerReference(inst, subInst, o.Scheme()); err != nil {
-			return errors.Wrapf(err, "unable to set owner reference")
-		}
-		subInst.Spec = lsv1alpha1.InstallationSpec{
-			Context:             inst.Spec.Context,
-			RegistryPullSecrets: inst.Spec.RegistryPullSecrets,
-			ComponentDescriptor: subCdDef,
-			Blueprint:           *subBlueprint,
-			Imports:             subInstTmpl.Imports,
-			ImportDataMappings:  subInstTmpl.ImportDataMappings,
-			Exports:             subInstTmpl.Exports,
-			ExportDataMappings:  subInstTmpl.ExportDataMappings,
-		}
-
-		o.Scheme().Default(subInst)
-		return nil
+		return o.applySubinstallationTemplate(inst, subInstTmpl, subInst, subBlueprint, subCdDef)
 	})
 	if err != nil {
 		cond = lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
@@ -340,6 +326,36 @@ func (o *Operation) createOrUpdateNewInstallation(ctx context.Context,
 	return subInst, nil
 }
 
+// applySubinstallationTemplate sets the metadata and the spec of a subinstallation based on its template.
+func (o *Operation) applySubinstallationTemplate(inst *lsv1alpha1.Installation,
+	subInstTmpl *lsv1alpha1.InstallationTemplate,
+	subInst *lsv1alpha1.Installation,
+	subBlueprint *lsv1alpha1.BlueprintDefinition,
+	subCdDef *lsv1alpha1.ComponentDescriptorDefinition) error {
+	subInst.Labels = map[string]string{
+		lsv1alpha1.EncompassedByLabel: inst.Name,
+	}
+	subInst.Annotations = map[string]string{
+		lsv1alpha1.SubinstallationNameAnnotation: subInstTmpl.Name,
+	}
+	if err := controllerutil.SetControllerReference(inst, subInst, o.Scheme()); err != nil {
+		return errors.Wrapf(err, "unable to set owner reference")
+	}
+	subInst.Spec = lsv1alpha1.InstallationSpec{
+		Context:             inst.Spec.Context,
+		RegistryPullSecrets: inst.Spec.RegistryPullSecrets,
+		ComponentDescriptor: subCdDef,
+		Blueprint:           *subBlueprint,
+		Imports:             subInstTmpl.Imports,
+		ImportDataMappings:  subInstTmpl.ImportDataMappings,
+		Exports:             subInstTmpl.Exports,
+		ExportDataMappings:  subInstTmpl.ExportDataMappings,
+	}
+
+	o.Scheme().Default(subInst)
+	return nil
+}
+
 // getSubinstallationNameByReference returns the name of subinstallation by the refernce
 func getSubinstallationNameByReference(refs []lsv1alpha1.NamedObjectReference, namespace, name string) (string, bool) {
 	for _, ref := range refs {
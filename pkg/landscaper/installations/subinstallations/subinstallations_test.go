@@ -289,6 +289,72 @@ var _ = Describe("SubInstallation", func() {
 			Expect(subinsts[0].Spec.Context).To(Equal("custom"))
 		})
 
+		Context("Plan", func() {
+
+			It("should plan to create all subinstallations without creating them", func() {
+				ctx := context.Background()
+				defer ctx.Done()
+
+				inst := fakeInstallations["test4/root"]
+				Expect(inst).ToNot(BeNil())
+				si := createSubInstallationsOperation(ctx, inst)
+				changes, err := si.Plan(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(changes).To(ConsistOf(
+					lsv1alpha1.PlannedChange{Name: "def-1", Kind: installations.PlanKindInstallation, Action: lsv1alpha1.PlanActionCreate},
+					lsv1alpha1.PlannedChange{Name: "def-2", Kind: installations.PlanKindInstallation, Action: lsv1alpha1.PlanActionCreate},
+				))
+
+				subInsts, err := installations.ListSubinstallations(ctx, fakeClient, inst)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(subInsts).To(BeEmpty())
+			})
+
+			It("should plan no changes for up-to-date subinstallations", func() {
+				ctx := context.Background()
+				defer ctx.Done()
+
+				inst, _ := expectSubInstallationsSucceed(ctx, "test4", "root", lsv1alpha1.NamedObjectReference{
+					Name: "def-1",
+					Reference: lsv1alpha1.ObjectReference{
+						Name:      "def-1",
+						Namespace: "test4"},
+				}, lsv1alpha1.NamedObjectReference{
+					Name: "def-2",
+					Reference: lsv1alpha1.ObjectReference{
+						Name:      "def-2",
+						Namespace: "test4"},
+				})
+
+				si := createSubInstallationsOperation(ctx, inst)
+				changes, err := si.Plan(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(changes).To(HaveLen(2))
+				for _, change := range changes {
+					Expect(change.Action).To(Equal(lsv1alpha1.PlanActionUnchanged))
+					Expect(change.Reference).ToNot(BeNil())
+				}
+			})
+
+			It("should plan to delete a subinstallation that is not referenced anymore", func() {
+				ctx := context.Background()
+				defer ctx.Done()
+
+				inst := fakeInstallations["test10/root"]
+				Expect(inst).ToNot(BeNil())
+				si := createSubInstallationsOperation(ctx, inst)
+				changes, err := si.Plan(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(changes).To(HaveLen(1))
+				Expect(changes[0].Action).To(Equal(lsv1alpha1.PlanActionDelete))
+				Expect(changes[0].Reference).ToNot(BeNil())
+
+				subinst := &lsv1alpha1.Installation{}
+				Expect(fakeClient.Get(ctx, types.NamespacedName{Name: changes[0].Reference.Name, Namespace: changes[0].Reference.Namespace}, subinst)).To(Succeed())
+			})
+
+		})
+
 		Context("Cleanup", func() {
 
 			It("should remove a subinstallation that is not referenced anymore", func() {
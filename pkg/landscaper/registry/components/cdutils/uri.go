@@ -16,6 +16,7 @@ import (
 	"github.com/gardener/component-spec/bindings-go/ctf"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/landscaper/registry/componentoverwrites"
 )
 
@@ -88,7 +89,7 @@ func ParseURI(cdURI string) (*URI, error) {
 // It also returns the resource kind.
 func (u *URI) Get(cd *cdv2.ComponentDescriptor, compResolver ctf.ComponentResolver, repositoryContext *cdv2.UnstructuredTypedObject) (lsv1alpha1.ComponentDescriptorKind, interface{}, error) {
 	var (
-		ctx       = context.Background()
+		ctx       = logging.NewContextWithDiscard(context.Background())
 		component = cd
 	)
 	defer ctx.Done()
@@ -130,7 +131,7 @@ func (u *URI) Get(cd *cdv2.ComponentDescriptor, compResolver ctf.ComponentResolv
 // ComponentVersionOverwrites are taken into account, but unlike the returned component, the reference is not overwritten.
 func (u *URI) GetComponent(cd *cdv2.ComponentDescriptor, compResolver ctf.ComponentResolver, repositoryContext *cdv2.UnstructuredTypedObject, overwriter componentoverwrites.Overwriter) (*cdv2.ComponentDescriptor, *lsv1alpha1.ComponentDescriptorReference, error) {
 	var (
-		ctx       = context.Background()
+		ctx       = logging.NewContextWithDiscard(context.Background())
 		component = cd
 		cdRef     = &lsv1alpha1.ComponentDescriptorReference{
 			RepositoryContext: cd.GetEffectiveRepositoryContext(),
@@ -176,7 +177,7 @@ func (u *URI) GetComponent(cd *cdv2.ComponentDescriptor, compResolver ctf.Compon
 // It also returns the resource kind.
 func (u *URI) GetResource(cd *cdv2.ComponentDescriptor, compResolver ctf.ComponentResolver, repositoryContext *cdv2.UnstructuredTypedObject) (*cdv2.ComponentDescriptor, cdv2.Resource, error) {
 	var (
-		ctx       = context.Background()
+		ctx       = logging.NewContextWithDiscard(context.Background())
 		component = cd
 	)
 	defer ctx.Done()
@@ -0,0 +1,274 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+const (
+	installationSubsystemName = "installation"
+	executionSubsystemName    = "execution"
+	deployItemSubsystemName   = "deployitem"
+	controllerSubsystemName   = "controller"
+
+	labelFromPhase    = "from"
+	labelToPhase      = "to"
+	labelPhase        = "phase"
+	labelErrorCode    = "error_code"
+	labelDeployerType = "type"
+	labelController   = "controller"
+
+	// noneLabelValue is used for empty phases and for errors without error codes.
+	noneLabelValue = "None"
+)
+
+// jobDurationBuckets are the histogram buckets for the time between the creation of a JobID and its completion.
+// Jobs range from a few seconds for simple deploy items up to hours for large landscapes.
+var jobDurationBuckets = []float64{1, 5, 10, 30, 60, 120, 300, 600, 1200, 1800, 3600, 7200, 14400}
+
+var (
+	// InstallationPhaseTransitions counts the phase transitions of installations.
+	InstallationPhaseTransitions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: installationSubsystemName,
+			Name:      "phase_transitions_total",
+			Help:      "Total number of phase transitions of installations.",
+		},
+		[]string{labelFromPhase, labelToPhase},
+	)
+
+	// InstallationFailures counts the installations that ended in a failed phase by error code.
+	InstallationFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: installationSubsystemName,
+			Name:      "failures_total",
+			Help:      "Total number of installations that reached a failed phase by error code.",
+		},
+		[]string{labelPhase, labelErrorCode},
+	)
+
+	// InstallationJobDuration observes the time from the creation of an installation's JobID until it is finished.
+	InstallationJobDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: installationSubsystemName,
+			Name:      "job_duration_seconds",
+			Help:      "Time in seconds from the creation of an installation's JobID until the job is finished.",
+			Buckets:   jobDurationBuckets,
+		},
+		[]string{labelPhase},
+	)
+
+	// ExecutionPhaseTransitions counts the phase transitions of executions.
+	ExecutionPhaseTransitions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: executionSubsystemName,
+			Name:      "phase_transitions_total",
+			Help:      "Total number of phase transitions of executions.",
+		},
+		[]string{labelFromPhase, labelToPhase},
+	)
+
+	// ExecutionFailures counts the executions that ended in a failed phase by error code.
+	ExecutionFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: executionSubsystemName,
+			Name:      "failures_total",
+			Help:      "Total number of executions that reached a failed phase by error code.",
+		},
+		[]string{labelPhase, labelErrorCode},
+	)
+
+	// ExecutionJobDuration observes the time from the creation of an execution's JobID until it is finished.
+	ExecutionJobDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: executionSubsystemName,
+			Name:      "job_duration_seconds",
+			Help:      "Time in seconds from the creation of an execution's JobID until the job is finished.",
+			Buckets:   jobDurationBuckets,
+		},
+		[]string{labelPhase},
+	)
+
+	// DeployItemPhaseTransitions counts the phase transitions of deploy items.
+	DeployItemPhaseTransitions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: deployItemSubsystemName,
+			Name:      "phase_transitions_total",
+			Help:      "Total number of phase transitions of deploy items.",
+		},
+		[]string{labelDeployerType, labelFromPhase, labelToPhase},
+	)
+
+	// DeployItemFailures counts the deploy items that ended in a failed phase by error code.
+	DeployItemFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: deployItemSubsystemName,
+			Name:      "failures_total",
+			Help:      "Total number of deploy items that reached a failed phase by error code.",
+		},
+		[]string{labelDeployerType, labelErrorCode},
+	)
+
+	// DeployItemJobDuration observes the time from the creation of a deploy item's JobID until it is finished.
+	DeployItemJobDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: deployItemSubsystemName,
+			Name:      "job_duration_seconds",
+			Help:      "Time in seconds from the creation of a deploy item's JobID until the job is finished.",
+			Buckets:   jobDurationBuckets,
+		},
+		[]string{labelDeployerType, labelPhase},
+	)
+
+	// ReconcileDuration observes the duration of a single reconcile run per controller.
+	ReconcileDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: controllerSubsystemName,
+			Name:      "reconcile_duration_seconds",
+			Help:      "Duration in seconds of a single reconcile run per controller.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{labelController},
+	)
+)
+
+// RegisterLifecycleMetrics allows to register the installation, execution and deploy item lifecycle metrics
+// with a given prometheus registerer.
+// Metrics that are already registered are ignored so that the function can be called by every deployer
+// that runs in the same process as the landscaper.
+func RegisterLifecycleMetrics(reg prometheus.Registerer) {
+	collectors := []prometheus.Collector{
+		InstallationPhaseTransitions,
+		InstallationFailures,
+		InstallationJobDuration,
+		ExecutionPhaseTransitions,
+		ExecutionFailures,
+		ExecutionJobDuration,
+		DeployItemPhaseTransitions,
+		DeployItemFailures,
+		DeployItemJobDuration,
+		ReconcileDuration,
+	}
+	for _, c := range collectors {
+		if err := reg.Register(c); err != nil {
+			var alreadyRegistered prometheus.AlreadyRegisteredError
+			if errors.As(err, &alreadyRegistered) {
+				continue
+			}
+			panic(err)
+		}
+	}
+}
+
+// ObserveReconcileDuration records the duration of a reconcile run of the given controller that started at start.
+// It is meant to be deferred at the beginning of a Reconcile function.
+func ObserveReconcileDuration(controller string, start time.Time) {
+	ReconcileDuration.WithLabelValues(controller).Observe(time.Since(start).Seconds())
+}
+
+// RecordInstallationPhaseTransition records the transition of an installation from oldPhase to its current phase.
+// Failures and the duration of the job are recorded if the installation reached a final phase.
+func RecordInstallationPhaseTransition(inst *lsv1alpha1.Installation, oldPhase lsv1alpha1.InstallationPhase) {
+	newPhase := inst.Status.InstallationPhase
+	if oldPhase == newPhase {
+		return
+	}
+	InstallationPhaseTransitions.WithLabelValues(phaseLabel(string(oldPhase)), phaseLabel(string(newPhase))).Inc()
+
+	switch newPhase {
+	case lsv1alpha1.InstallationPhaseFailed, lsv1alpha1.InstallationPhaseDeleteFailed:
+		for _, code := range errorCodeLabels(inst.Status.LastError) {
+			InstallationFailures.WithLabelValues(string(newPhase), code).Inc()
+		}
+		observeJobDuration(InstallationJobDuration.WithLabelValues(string(newPhase)), inst.Status.JobIDGenerationTime)
+	case lsv1alpha1.InstallationPhaseSucceeded:
+		observeJobDuration(InstallationJobDuration.WithLabelValues(string(newPhase)), inst.Status.JobIDGenerationTime)
+	}
+}
+
+// RecordExecutionPhaseTransition records the transition of an execution from oldPhase to its current phase.
+// Failures and the duration of the job are recorded if the execution reached a final phase.
+func RecordExecutionPhaseTransition(exec *lsv1alpha1.Execution, oldPhase lsv1alpha1.ExecPhase) {
+	newPhase := exec.Status.ExecutionPhase
+	if oldPhase == newPhase {
+		return
+	}
+	ExecutionPhaseTransitions.WithLabelValues(phaseLabel(string(oldPhase)), phaseLabel(string(newPhase))).Inc()
+
+	switch newPhase {
+	case lsv1alpha1.ExecPhaseFailed, lsv1alpha1.ExecPhaseDeleteFailed:
+		for _, code := range errorCodeLabels(exec.Status.LastError) {
+			ExecutionFailures.WithLabelValues(string(newPhase), code).Inc()
+		}
+		observeJobDuration(ExecutionJobDuration.WithLabelValues(string(newPhase)), exec.Status.JobIDGenerationTime)
+	case lsv1alpha1.ExecPhaseSucceeded:
+		observeJobDuration(ExecutionJobDuration.WithLabelValues(string(newPhase)), exec.Status.JobIDGenerationTime)
+	}
+}
+
+// RecordDeployItemPhaseTransition records the transition of a deploy item from oldPhase to its current phase.
+// Failures and the duration of the job are recorded if the deploy item reached a final phase.
+func RecordDeployItemPhaseTransition(di *lsv1alpha1.DeployItem, oldPhase lsv1alpha1.DeployItemPhase) {
+	newPhase := di.Status.DeployItemPhase
+	if oldPhase == newPhase {
+		return
+	}
+	diType := string(di.Spec.Type)
+	DeployItemPhaseTransitions.WithLabelValues(diType, phaseLabel(string(oldPhase)), phaseLabel(string(newPhase))).Inc()
+
+	switch newPhase {
+	case lsv1alpha1.DeployItemPhaseFailed:
+		for _, code := range errorCodeLabels(di.Status.GetLastError()) {
+			DeployItemFailures.WithLabelValues(diType, code).Inc()
+		}
+		observeJobDuration(DeployItemJobDuration.WithLabelValues(diType, string(newPhase)), di.Status.JobIDGenerationTime)
+	case lsv1alpha1.DeployItemPhaseSucceeded:
+		observeJobDuration(DeployItemJobDuration.WithLabelValues(diType, string(newPhase)), di.Status.JobIDGenerationTime)
+	}
+}
+
+func observeJobDuration(observer prometheus.Observer, jobIDGenerationTime *metav1.Time) {
+	if jobIDGenerationTime == nil || jobIDGenerationTime.IsZero() {
+		return
+	}
+	observer.Observe(time.Since(jobIDGenerationTime.Time).Seconds())
+}
+
+func phaseLabel(phase string) string {
+	if len(phase) == 0 {
+		return noneLabelValue
+	}
+	return phase
+}
+
+// errorCodeLabels returns the error codes of the given error as label values.
+// An error without codes is reported with a single "None" code.
+func errorCodeLabels(err *lsv1alpha1.Error) []string {
+	if err == nil || len(err.Codes) == 0 {
+		return []string{noneLabelValue}
+	}
+	codes := make([]string, len(err.Codes))
+	for i, code := range err.Codes {
+		codes[i] = string(code)
+	}
+	return codes
+}
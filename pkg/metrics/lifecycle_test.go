@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/metrics"
+)
+
+func counterValue(c prometheus.Counter) float64 {
+	m := &dto.Metric{}
+	Expect(c.Write(m)).To(Succeed())
+	return m.GetCounter().GetValue()
+}
+
+func histogramCount(o prometheus.Observer) uint64 {
+	m := &dto.Metric{}
+	Expect(o.(prometheus.Metric).Write(m)).To(Succeed())
+	return m.GetHistogram().GetSampleCount()
+}
+
+var _ = Describe("Lifecycle Metrics", func() {
+
+	It("should be registrable multiple times", func() {
+		reg := prometheus.NewRegistry()
+		metrics.RegisterLifecycleMetrics(reg)
+		Expect(func() { metrics.RegisterLifecycleMetrics(reg) }).ToNot(Panic())
+	})
+
+	It("should not record anything if the phase has not changed", func() {
+		inst := &lsv1alpha1.Installation{}
+		inst.Status.InstallationPhase = lsv1alpha1.InstallationPhaseProgressing
+		transitions := metrics.InstallationPhaseTransitions.WithLabelValues("Progressing", "Progressing")
+
+		metrics.RecordInstallationPhaseTransition(inst, lsv1alpha1.InstallationPhaseProgressing)
+		Expect(counterValue(transitions)).To(Equal(float64(0)))
+	})
+
+	It("should record failures of an installation by error code and the job duration", func() {
+		start := metav1.NewTime(time.Now().Add(-1 * time.Minute))
+		inst := &lsv1alpha1.Installation{}
+		inst.Status.InstallationPhase = lsv1alpha1.InstallationPhaseFailed
+		inst.Status.JobIDGenerationTime = &start
+		inst.Status.LastError = &lsv1alpha1.Error{
+			Codes: []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorConfigurationProblem, lsv1alpha1.ErrorTimeout},
+		}
+
+		transitions := metrics.InstallationPhaseTransitions.WithLabelValues("Progressing", "Failed")
+		configFailures := metrics.InstallationFailures.WithLabelValues("Failed", string(lsv1alpha1.ErrorConfigurationProblem))
+		timeoutFailures := metrics.InstallationFailures.WithLabelValues("Failed", string(lsv1alpha1.ErrorTimeout))
+		duration := metrics.InstallationJobDuration.WithLabelValues("Failed")
+		oldTransitions, oldConfig, oldTimeout, oldCount := counterValue(transitions), counterValue(configFailures), counterValue(timeoutFailures), histogramCount(duration)
+
+		metrics.RecordInstallationPhaseTransition(inst, lsv1alpha1.InstallationPhaseProgressing)
+		Expect(counterValue(transitions)).To(Equal(oldTransitions + 1))
+		Expect(counterValue(configFailures)).To(Equal(oldConfig + 1))
+		Expect(counterValue(timeoutFailures)).To(Equal(oldTimeout + 1))
+		Expect(histogramCount(duration)).To(Equal(oldCount + 1))
+	})
+
+	It("should label failures of an execution without error codes with None", func() {
+		exec := &lsv1alpha1.Execution{}
+		exec.Status.ExecutionPhase = lsv1alpha1.ExecPhaseDeleteFailed
+		failures := metrics.ExecutionFailures.WithLabelValues("DeleteFailed", "None")
+		old := counterValue(failures)
+
+		metrics.RecordExecutionPhaseTransition(exec, lsv1alpha1.ExecPhaseDeleting)
+		Expect(counterValue(failures)).To(Equal(old + 1))
+	})
+
+	It("should record transitions of deploy items by type", func() {
+		di := &lsv1alpha1.DeployItem{}
+		di.Spec.Type = "landscaper.gardener.cloud/mock"
+		di.Status.DeployItemPhase = lsv1alpha1.DeployItemPhaseProgressing
+		transitions := metrics.DeployItemPhaseTransitions.WithLabelValues("landscaper.gardener.cloud/mock", "None", "Progressing")
+		old := counterValue(transitions)
+
+		metrics.RecordDeployItemPhaseTransition(di, "")
+		Expect(counterValue(transitions)).To(Equal(old + 1))
+	})
+
+})
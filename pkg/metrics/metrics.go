@@ -20,4 +20,5 @@ import (
 func RegisterMetrics(reg prometheus.Registerer) {
 	blueprints.RegisterStoreMetrics(reg)
 	componentcliMetrics.RegisterCacheMetrics(reg)
+	RegisterLifecycleMetrics(reg)
 }
@@ -18,12 +18,12 @@ import (
 	"github.com/gardener/landscaper/apis/core"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
 	"github.com/gardener/landscaper/pkg/landscaper/execution"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
-	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
-	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/templaters"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/subinstallations"
 	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
 )
@@ -116,7 +116,7 @@ func (r *BlueprintRenderer) RenderExportExecutions(input *ResolvedInstallation,
 		return nil, fmt.Errorf("blueprint may not be nil")
 	}
 
-	ctx = context.Background()
+	ctx = logging.NewContextWithDiscard(context.Background())
 	defer ctx.Done()
 
 	if input.ComponentDescriptor != nil && r.componentResolver != nil {
@@ -135,7 +135,7 @@ func (r *BlueprintRenderer) RenderExportExecutions(input *ResolvedInstallation,
 
 	templateStateHandler := template.NewMemoryStateHandler()
 	formatter := template.NewTemplateInputFormatter(true)
-	exports, err := template.New(gotemplate.New(blobResolver, templateStateHandler).WithInputFormatter(formatter), spiff.New(templateStateHandler).WithInputFormatter(formatter)).
+	exports, err := templaters.New(templaters.Options{BlobResolver: blobResolver, State: templateStateHandler, InputFormatter: formatter}).
 		TemplateExportExecutions(template.NewExportExecutionOptions(template.NewBlueprintExecutionOptions(input.Installation, input.Blueprint, input.ComponentDescriptor, r.cdList, imports), values))
 
 	if err != nil {
@@ -152,7 +152,7 @@ func (r *BlueprintRenderer) renderDeployItems(input *ResolvedInstallation, impor
 		ctx          context.Context
 	)
 
-	ctx = context.Background()
+	ctx = logging.NewContextWithDiscard(context.Background())
 	defer ctx.Done()
 
 	if input.ComponentDescriptor != nil && r.componentResolver != nil {
@@ -165,7 +165,7 @@ func (r *BlueprintRenderer) renderDeployItems(input *ResolvedInstallation, impor
 
 	templateStateHandler := template.NewMemoryStateHandler()
 	formatter := template.NewTemplateInputFormatter(true)
-	executions, err := template.New(gotemplate.New(blobResolver, templateStateHandler).WithInputFormatter(formatter), spiff.New(templateStateHandler).WithInputFormatter(formatter)).
+	executions, err := templaters.New(templaters.Options{BlobResolver: blobResolver, State: templateStateHandler, InputFormatter: formatter}).
 		TemplateDeployExecutions(template.NewDeployExecutionOptions(template.NewBlueprintExecutionOptions(
 			input.Installation, input.Blueprint, input.ComponentDescriptor, r.cdList,
 			imports)))
@@ -243,6 +243,7 @@ func (r *BlueprintRenderer) renderDeployItems(input *ResolvedInstallation, impor
 			Labels:        elem.Labels,
 			Configuration: elem.Configuration,
 			DependsOn:     elem.DependsOn,
+			Wave:          elem.Wave,
 		}
 	}
 
@@ -266,7 +267,7 @@ func (r *BlueprintRenderer) renderDeployItems(input *ResolvedInstallation, impor
 
 // renderSubInstallations renders subinstallations.
 func (r *BlueprintRenderer) renderSubInstallations(input *ResolvedInstallation, imports map[string]interface{}) ([]ResolvedInstallation, map[string][]byte, error) {
-	ctx := context.Background()
+	ctx := logging.NewContextWithDiscard(context.Background())
 	defer ctx.Done()
 
 	installationTemplates, err := input.Blueprint.GetSubinstallations()
@@ -283,7 +284,7 @@ func (r *BlueprintRenderer) renderSubInstallations(input *ResolvedInstallation,
 
 	templateStateHandler := template.NewMemoryStateHandler()
 	formatter := template.NewTemplateInputFormatter(true)
-	subInstallationTemplates, err := template.New(gotemplate.New(nil, templateStateHandler).WithInputFormatter(formatter), spiff.New(templateStateHandler).WithInputFormatter(formatter)).
+	subInstallationTemplates, err := templaters.New(templaters.Options{State: templateStateHandler, InputFormatter: formatter}).
 		TemplateSubinstallationExecutions(template.NewDeployExecutionOptions(
 			template.NewBlueprintExecutionOptions(input.Installation, input.Blueprint, input.ComponentDescriptor, r.cdList,
 				imports)))
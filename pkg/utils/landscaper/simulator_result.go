@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package landscaper
+
+import (
+	"path"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// SimulatorResult collects all installations, deploy items, imports, exports and template states
+// that are found during a simulation run.
+// It implements the InstallationSimulatorCallbacks and is meant to be printed as yaml.
+type SimulatorResult struct {
+	// Installations contains the root installation and all rendered subinstallations by their installation path.
+	Installations map[string]*lsv1alpha1.Installation `json:"installations,omitempty"`
+	// Imports contains the imports of all installations by their installation path.
+	Imports map[string]interface{} `json:"imports,omitempty"`
+	// DeployItems contains all rendered deploy items by their installation path and name.
+	DeployItems map[string]*lsv1alpha1.DeployItem `json:"deployItems,omitempty"`
+	// InstallationTemplateState contains the template state of the subinstallation templates by installation path.
+	InstallationTemplateState map[string]map[string]string `json:"installationTemplateState,omitempty"`
+	// DeployItemTemplateState contains the template state of the deploy item templates by installation path.
+	DeployItemTemplateState map[string]map[string]string `json:"deployItemTemplateState,omitempty"`
+	// Exports contains the data object and target exports of all installations by their installation path.
+	Exports map[string]interface{} `json:"exports,omitempty"`
+}
+
+var _ InstallationSimulatorCallbacks = &SimulatorResult{}
+
+// NewSimulatorResult creates a new empty simulator result.
+func NewSimulatorResult() *SimulatorResult {
+	return &SimulatorResult{
+		Installations:             map[string]*lsv1alpha1.Installation{},
+		Imports:                   map[string]interface{}{},
+		DeployItems:               map[string]*lsv1alpha1.DeployItem{},
+		InstallationTemplateState: map[string]map[string]string{},
+		DeployItemTemplateState:   map[string]map[string]string{},
+		Exports:                   map[string]interface{}{},
+	}
+}
+
+func (r *SimulatorResult) OnInstallation(path string, installation *lsv1alpha1.Installation) {
+	r.Installations[path] = installation
+}
+
+func (r *SimulatorResult) OnInstallationTemplateState(path string, state map[string][]byte) {
+	r.InstallationTemplateState[path] = stateToString(state)
+}
+
+func (r *SimulatorResult) OnImports(path string, imports map[string]interface{}) {
+	r.Imports[path] = imports
+}
+
+func (r *SimulatorResult) OnDeployItem(installationPath string, deployItem *lsv1alpha1.DeployItem) {
+	r.DeployItems[path.Join(installationPath, deployItem.Name)] = deployItem
+}
+
+func (r *SimulatorResult) OnDeployItemTemplateState(path string, state map[string][]byte) {
+	r.DeployItemTemplateState[path] = stateToString(state)
+}
+
+func (r *SimulatorResult) OnExports(path string, exports map[string]interface{}) {
+	r.Exports[path] = exports
+}
+
+// stateToString converts the binary template state into strings so that it is readable when printed as yaml.
+func stateToString(state map[string][]byte) map[string]string {
+	res := make(map[string]string, len(state))
+	for key, value := range state {
+		res[key] = string(value)
+	}
+	return res
+}
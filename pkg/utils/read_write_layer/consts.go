@@ -153,6 +153,45 @@ const (
 	W000147 WriteID = "w000147"
 	W000148 WriteID = "w000148"
 	W000149 WriteID = "w000149"
+	W000150 WriteID = "w000150"
+	W000151 WriteID = "w000151"
+	W000152 WriteID = "w000152"
+	W000153 WriteID = "w000153"
+	W000154 WriteID = "w000154"
+	W000155 WriteID = "w000155"
+	W000156 WriteID = "w000156"
+	W000157 WriteID = "w000157"
+	W000158 WriteID = "w000158"
+	W000159 WriteID = "w000159"
+	W000160 WriteID = "w000160"
+	W000161 WriteID = "w000161"
+	W000162 WriteID = "w000162"
+	W000163 WriteID = "w000163"
+	W000164 WriteID = "w000164"
+	W000165 WriteID = "w000165"
+	W000166 WriteID = "w000166"
+	W000167 WriteID = "w000167"
+	W000168 WriteID = "w000168"
+	W000169 WriteID = "w000169"
+	W000170 WriteID = "w000170"
+	W000171 WriteID = "w000171"
+	W000172 WriteID = "w000172"
+	W000173 WriteID = "w000173"
+	W000174 WriteID = "w000174"
+	W000175 WriteID = "w000175"
+	W000176 WriteID = "w000176"
+	W000177 WriteID = "w000177"
+	W000178 WriteID = "w000178"
+	W000179 WriteID = "w000179"
+	W000180 WriteID = "w000180"
+	W000181 WriteID = "w000181"
+	W000182 WriteID = "w000182"
+	W000183 WriteID = "w000183"
+	W000184 WriteID = "w000184"
+	W000185 WriteID = "w000185"
+	W000186 WriteID = "w000186"
+	W000187 WriteID = "w000187"
+	W000188 WriteID = "w000188"
 )
 
 const (
@@ -171,4 +210,5 @@ const (
 	opDIStatus              = "history: deployitem status update"
 	opDIDelete              = "history: deployitem delete"
 	opTargetCreateOrUpdate  = "history: target create or update"
+	opTargetStatus          = "history: target status update"
 )
@@ -44,6 +44,13 @@ func (w *Writer) CreateOrUpdateCoreTarget(ctx context.Context, writeID WriteID,
 	return result, errorWithWriteID(err, writeID)
 }
 
+func (w *Writer) UpdateTargetStatus(ctx context.Context, writeID WriteID, target *lsv1alpha1.Target) error {
+	generationOld, resourceVersionOld := getGenerationAndResourceVersion(target)
+	err := updateStatus(ctx, w.client.Status(), target)
+	w.logTargetUpdate(ctx, writeID, opTargetStatus, target, generationOld, resourceVersionOld, err)
+	return errorWithWriteID(err, writeID)
+}
+
 func (w *Writer) DeleteTarget(ctx context.Context, writeID WriteID, target *lsv1alpha1.Target) error {
 	generationOld, resourceVersionOld := getGenerationAndResourceVersion(target)
 	err := delete(ctx, w.client, target)
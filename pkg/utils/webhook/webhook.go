@@ -37,6 +37,8 @@ func ValidatorFromResourceType(log logging.Logger, kubeClient client.Client, sch
 		val = &ExecutionValidator{abstrVal}
 	case "targets":
 		val = &TargetValidator{abstrVal}
+	case "importgrants":
+		val = &ImportGrantValidator{abstrVal}
 	default:
 		return nil, fmt.Errorf("unable to find validator for resource type %q", resource)
 	}
@@ -226,3 +228,38 @@ func (tv *TargetValidator) handlePrivate(ctx context.Context, req admission.Requ
 
 	return admission.Allowed("Target is valid")
 }
+
+// IMPORT GRANT
+
+// ImportGrantValidator represents a validator for an ImportGrant
+type ImportGrantValidator struct{ abstractValidator }
+
+// Handle handles a request to the webhook
+func (igv *ImportGrantValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	logger := igv.log.WithValues(lc.KeyResourceGroup, req.Kind.Group, lc.KeyResourceKind, req.Kind.Kind, lc.KeyResourceVersion, req.Kind.Version)
+	ctx = logging.NewContext(ctx, logger)
+
+	timeBefore := time.Now()
+	result := igv.handlePrivate(ctx, req)
+
+	logIfDurationExceeded(ctx, timeBefore)
+
+	return result
+}
+
+func (igv *ImportGrantValidator) handlePrivate(ctx context.Context, req admission.Request) admission.Response {
+	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "ImportGrantValidator.handlePrivate"})
+
+	logger.Debug("Received request")
+
+	grant := &lscore.ImportGrant{}
+	if _, _, err := igv.decoder.Decode(req.Object.Raw, nil, grant); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if errs := validation.ValidateImportGrant(grant); len(errs) > 0 {
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+
+	return admission.Allowed("ImportGrant is valid")
+}
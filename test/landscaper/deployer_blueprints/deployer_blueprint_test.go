@@ -204,6 +204,86 @@ var _ = Describe("Blueprint", func() {
 		Expect(di.Spec.Configuration.Raw).To(MatchJSON(expectedConfig))
 	})
 
+	It("JobDeployer", func() {
+		out := RenderBlueprint("job-deployer")
+		Expect(out.DeployItems).To(HaveLen(1))
+		Expect(out.Installations).To(HaveLen(0))
+
+		di := out.DeployItems[0]
+		Expect(di.Spec.Type).To(Equal(helm.Type))
+		expectedConfig := `
+{
+  "apiVersion": "helm.deployer.landscaper.gardener.cloud/v1alpha1",
+  "chart": {
+    "ref": "eu.gcr.io/gardener-project/landscaper/charts/job-deployer-controller:v0.5.3"
+  },
+  "helmDeployment": false,
+  "kind": "ProviderConfiguration",
+  "name": "landscaper-job-deployer",
+  "namespace": "job-deployer",
+  "updateStrategy": "update",
+  "values": {
+    "deployer": {
+      "namespace": "",
+      "oci": {
+        "allowPlainHttp": false,
+        "secrets": {}
+      }
+    },
+    "image": {
+      "pullPolicy": "IfNotPresent",
+      "repository": "eu.gcr.io/gardener-project/landscaper/job-deployer-controller",
+      "tag": "v0.5.3"
+    },
+    "replicaCount": 1
+  }
+}
+`
+		Expect(di.Spec.Configuration.Raw).To(MatchJSON(expectedConfig))
+	})
+
+	It("TerraformDeployer", func() {
+		out := RenderBlueprint("terraform-deployer")
+		Expect(out.DeployItems).To(HaveLen(1))
+		Expect(out.Installations).To(HaveLen(0))
+
+		di := out.DeployItems[0]
+		Expect(di.Spec.Type).To(Equal(helm.Type))
+		expectedConfig := `
+{
+  "apiVersion": "helm.deployer.landscaper.gardener.cloud/v1alpha1",
+  "chart": {
+    "ref": "eu.gcr.io/gardener-project/landscaper/charts/terraform-deployer-controller:v0.5.3"
+  },
+  "helmDeployment": false,
+  "kind": "ProviderConfiguration",
+  "name": "landscaper-terraform-deployer",
+  "namespace": "terraform-deployer",
+  "updateStrategy": "update",
+  "values": {
+    "deployer": {
+      "namespace": "",
+      "oci": {
+        "allowPlainHttp": false,
+        "secrets": {}
+      },
+      "runnerImage": {
+        "repository": "eu.gcr.io/gardener-project/landscaper/terraform-deployer-runner",
+        "tag": "v0.5.3"
+      }
+    },
+    "image": {
+      "pullPolicy": "IfNotPresent",
+      "repository": "eu.gcr.io/gardener-project/landscaper/terraform-deployer-controller",
+      "tag": "v0.5.3"
+    },
+    "replicaCount": 1
+  }
+}
+`
+		Expect(di.Spec.Configuration.Raw).To(MatchJSON(expectedConfig))
+	})
+
 	It("MockDeployer", func() {
 		out := RenderBlueprint("mock-deployer")
 		Expect(out.DeployItems).To(HaveLen(1))